@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	vault "github.com/hashicorp/vault/api"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/joho/godotenv"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/providers"
+)
+
+const (
+	issuerPublishKeyPath                = "ISSUER_PUBLISH_KEY_PATH"
+	issuerKmsPluginLocalStorageFilePath = "ISSUER_KMS_PROVIDER_LOCAL_STORAGE_FILE_PATH"
+	issuerKmsLocalStoragePassphrase     = "ISSUER_KMS_PROVIDER_LOCAL_STORAGE_PASSPHRASE"
+	issuerKmsLocalStorageKeyFile        = "ISSUER_KMS_PROVIDER_LOCAL_STORAGE_KEY_FILE"
+	issuerKeyStoreToken                 = "ISSUER_KEY_STORE_TOKEN"
+	issuerKeyStoreAddress               = "ISSUER_KEY_STORE_ADDRESS"
+	issuerKeyStorePluginIden3MountPath  = "ISSUER_KEY_STORE_PLUGIN_IDEN3_MOUNT_PATH"
+	issuerVaultUserPassAuthEnabled      = "ISSUER_VAULT_USERPASS_AUTH_ENABLED"
+	issuerVaultUserPassAuthPasword      = "ISSUER_VAULT_USERPASS_AUTH_PASSWORD"
+	issuerVaultAuthMethod               = "ISSUER_VAULT_AUTH_METHOD"
+	issuerVaultAuthMountPath            = "ISSUER_VAULT_AUTH_MOUNT_PATH"
+	issuerVaultAppRoleRoleID            = "ISSUER_VAULT_APPROLE_ROLE_ID"
+	issuerVaultAppRoleSecretID          = "ISSUER_VAULT_APPROLE_SECRET_ID"
+	issuerVaultAppRoleSecretIDFile      = "ISSUER_VAULT_APPROLE_SECRET_ID_FILE"
+	issuerVaultKubernetesRole           = "ISSUER_VAULT_KUBERNETES_ROLE"
+	issuerVaultKubernetesTokenPath      = "ISSUER_VAULT_KUBERNETES_TOKEN_PATH"
+	awsAccessKey                        = "ISSUER_KMS_AWS_ACCESS_KEY"
+	awsSecretKey                        = "ISSUER_KMS_AWS_SECRET_KEY"
+	awsRegion                           = "ISSUER_KMS_AWS_REGION"
+	awsURL                              = "ISSUER_KMS_AWS_URL"
+
+	operationBackup  = "backup"
+	operationRestore = "restore"
+
+	pluginFolderPath = "./localstoragekeys"
+	envFile          = ".env-issuer"
+)
+
+// This is a tool to backup the keys of an identity in an encrypted file, whose encryption key is split in
+// Shamir shares, and to restore them in any key provider.
+// The providers are configured with the same environment variables as the issuer node.
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := godotenv.Load(envFile); err != nil {
+		log.Info(ctx, "no .env-issuer file found, using environment variables")
+	}
+
+	fOperation := flag.String("operation", "", "backup or restore")
+	fProvider := flag.String("provider", "", "key provider: localstorage, vault, vault-plugin-iden3, aws-sm or aws-kms")
+	fDID := flag.String("did", "", "identity to backup")
+	fShares := flag.Int("shares", 5, "number of shares of the backup encryption key")       // nolint:mnd
+	fThreshold := flag.Int("threshold", 3, "number of shares needed to restore the backup") // nolint:mnd
+	fBackupFile := flag.String("backup-file", "", "file to write the backup to, or to read it from on restore")
+	fShareList := flag.String("share", "", "comma separated hex encoded shares to restore the backup")
+	flag.Parse()
+
+	provider := kms.MigrationProvider(*fProvider)
+	if !slices.Contains(kms.MigrationProviders(), provider) {
+		log.Error(ctx, "provider is required, supported values are: localstorage, vault, vault-plugin-iden3, aws-sm and aws-kms")
+		os.Exit(1)
+	}
+	if *fBackupFile == "" {
+		log.Error(ctx, "backup-file is required")
+		os.Exit(1)
+	}
+
+	switch *fOperation {
+	case operationBackup:
+		if provider == kms.MigrationProviderAWSKMS {
+			log.Error(ctx, "aws-kms keys can not be exported, the backup has to be made from another provider")
+			os.Exit(1)
+		}
+		identity, err := w3c.ParseDID(*fDID)
+		if err != nil {
+			log.Error(ctx, "invalid did", "err", err)
+			os.Exit(1)
+		}
+		keyStore := openKMS(ctx, provider)
+		if err := backup(ctx, keyStore, *identity, *fShares, *fThreshold, *fBackupFile); err != nil {
+			log.Error(ctx, "cannot backup the keys", "err", err)
+			os.Exit(1)
+		}
+	case operationRestore:
+		keyStore := openKMS(ctx, provider)
+		if err := restore(ctx, keyStore, *fBackupFile, *fShareList); err != nil {
+			log.Error(ctx, "cannot restore the keys", "err", err)
+			os.Exit(1)
+		}
+	default:
+		log.Error(ctx, "operation is required, supported values are: backup and restore")
+		os.Exit(1)
+	}
+}
+
+func backup(ctx context.Context, keyStore *kms.KMS, identity w3c.DID, shares, threshold int, backupFile string) error {
+	keyBackup, keyShares, err := keyStore.BackupIdentityKeys(ctx, identity, shares, threshold)
+	if err != nil {
+		return err
+	}
+	encoded, err := keyBackup.Encode()
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(backupFile, []byte(encoded), 0o600); err != nil { // nolint:mnd
+		return fmt.Errorf("cannot write backup file: %w", err)
+	}
+
+	fmt.Printf("backup of %d keys of %s written to %s\n", keyBackup.Keys, identity.String(), backupFile)
+	fmt.Printf("give every share to a different custodian, %d of them are needed to restore the backup:\n\n", threshold)
+	for i, share := range keyShares {
+		fmt.Printf("share %d: %s\n", i+1, hex.EncodeToString(share))
+	}
+	return nil
+}
+
+func restore(ctx context.Context, keyStore *kms.KMS, backupFile string, shareList string) error {
+	content, err := os.ReadFile(backupFile)
+	if err != nil {
+		return fmt.Errorf("cannot read backup file: %w", err)
+	}
+	keyBackup, err := kms.DecodeKeyBackup(strings.TrimSpace(string(content)))
+	if err != nil {
+		return err
+	}
+
+	shares := make([][]byte, 0)
+	for _, item := range strings.Split(shareList, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		share, err := hex.DecodeString(item)
+		if err != nil {
+			return fmt.Errorf("invalid share: %w", err)
+		}
+		shares = append(shares, share)
+	}
+	if len(shares) < keyBackup.Threshold {
+		return fmt.Errorf("%d shares are needed to restore the backup, got %d", keyBackup.Threshold, len(shares))
+	}
+
+	restored, err := keyStore.RestoreIdentityKeys(ctx, keyBackup, shares)
+	printRestored(restored)
+	if err != nil {
+		return err
+	}
+	log.Info(ctx, "keys restored", "identity", keyBackup.Identity, "keys", len(restored))
+	return nil
+}
+
+// printRestored prints the restored keys. Keys whose id changes have to be updated in the
+// issuer node database, for example the signing keys of the payment options.
+func printRestored(items []kms.RestoredKey) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0) // nolint:mnd
+	_, _ = fmt.Fprintln(w, "TYPE\tBACKUP ID\tRESTORED ID\tEXISTED")
+	changed := 0
+	for _, item := range items {
+		if item.BackupKeyID.ID != item.KeyID.ID {
+			changed++
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%t\n", item.BackupKeyID.Type, item.BackupKeyID.ID, item.KeyID.ID, item.Existed)
+	}
+	_ = w.Flush()
+	if changed > 0 {
+		fmt.Printf("\n%d keys have a new id, update the references to them in the issuer node\n", changed)
+	}
+}
+
+func openKMS(ctx context.Context, provider kms.MigrationProvider) *kms.KMS {
+	config, err := kmsConfig(ctx, provider)
+	if err != nil {
+		log.Error(ctx, "invalid key provider configuration", "err", err)
+		os.Exit(1)
+	}
+	keyStore, err := kms.OpenForMigration(ctx, provider, config)
+	if err != nil {
+		log.Error(ctx, "cannot open key provider", "err", err, "provider", provider)
+		os.Exit(1)
+	}
+	return keyStore
+}
+
+func kmsConfig(ctx context.Context, provider kms.MigrationProvider) (kms.Config, error) {
+	localStoragePath := os.Getenv(issuerKmsPluginLocalStorageFilePath)
+	if localStoragePath == "" {
+		localStoragePath = pluginFolderPath
+	}
+	passphrase := os.Getenv(issuerKmsLocalStoragePassphrase)
+	if keyFile := os.Getenv(issuerKmsLocalStorageKeyFile); keyFile != "" {
+		var err error
+		if passphrase, err = kms.ReadPassphraseFile(keyFile); err != nil {
+			return kms.Config{}, fmt.Errorf("cannot read local storage key file: %w", err)
+		}
+	}
+
+	config := kms.Config{
+		AWSAccessKey:             os.Getenv(awsAccessKey),
+		AWSSecretKey:             os.Getenv(awsSecretKey),
+		AWSRegion:                os.Getenv(awsRegion),
+		AWSURL:                   os.Getenv(awsURL),
+		LocalStoragePath:         localStoragePath,
+		LocalStoragePassphrase:   passphrase,
+		PluginIden3MountPath:     os.Getenv(issuerKeyStorePluginIden3MountPath),
+		IssuerETHTransferKeyPath: os.Getenv(issuerPublishKeyPath),
+	}
+
+	if provider == kms.MigrationProviderVault || provider == kms.MigrationProviderVaultPluginIden3 {
+		vaultCli, err := vaultClient(ctx)
+		if err != nil {
+			return kms.Config{}, err
+		}
+		config.Vault = vaultCli
+	}
+	return config, nil
+}
+
+func vaultClient(ctx context.Context) (*vault.Client, error) {
+	userPassEnabled := false
+	if value := os.Getenv(issuerVaultUserPassAuthEnabled); value != "" {
+		var err error
+		if userPassEnabled, err = strconv.ParseBool(value); err != nil {
+			return nil, fmt.Errorf("cannot parse userpass auth enabled value: %w", err)
+		}
+	}
+	return providers.VaultClient(ctx, providers.Config{
+		UserPassAuthEnabled: userPassEnabled,
+		Pass:                os.Getenv(issuerVaultUserPassAuthPasword),
+		Address:             os.Getenv(issuerKeyStoreAddress),
+		Token:               os.Getenv(issuerKeyStoreToken),
+		AuthMethod:          os.Getenv(issuerVaultAuthMethod),
+		AuthMountPath:       os.Getenv(issuerVaultAuthMountPath),
+		AppRoleRoleID:       os.Getenv(issuerVaultAppRoleRoleID),
+		AppRoleSecretID:     os.Getenv(issuerVaultAppRoleSecretID),
+		AppRoleSecretIDFile: os.Getenv(issuerVaultAppRoleSecretIDFile),
+		KubernetesRole:      os.Getenv(issuerVaultKubernetesRole),
+		KubernetesTokenPath: os.Getenv(issuerVaultKubernetesTokenPath),
+	})
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+
+	"github.com/joho/godotenv"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	issuerKmsPluginLocalStorageFilePath = "ISSUER_KMS_PROVIDER_LOCAL_STORAGE_FILE_PATH"
+	issuerKmsLocalStoragePassphrase     = "ISSUER_KMS_PROVIDER_LOCAL_STORAGE_PASSPHRASE"
+	issuerKmsLocalStorageKeyFile        = "ISSUER_KMS_PROVIDER_LOCAL_STORAGE_KEY_FILE"
+
+	pluginFolderPath = "./localstoragekeys"
+	envFile          = ".env-issuer"
+)
+
+// This is a tool to encrypt the local storage keys file, change its passphrase or decrypt it.
+// The current passphrase is taken from the issuer node configuration.
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := godotenv.Load(envFile); err != nil {
+		log.Info(ctx, "no .env-issuer file found, using environment variables")
+	}
+
+	fNewPassphrase := flag.String("newPassphrase", "", "new passphrase")
+	fNewKeyFile := flag.String("newKeyFile", "", "file containing the new passphrase")
+	fDecrypt := flag.Bool("decrypt", false, "store the keys in plaintext again")
+	flag.Parse()
+
+	folderPath := os.Getenv(issuerKmsPluginLocalStorageFilePath)
+	if folderPath == "" {
+		folderPath = pluginFolderPath
+	}
+	file := filepath.Join(folderPath, kms.LocalStorageFileName)
+
+	oldPassphrase := os.Getenv(issuerKmsLocalStoragePassphrase)
+	if keyFile := os.Getenv(issuerKmsLocalStorageKeyFile); keyFile != "" {
+		var err error
+		if oldPassphrase, err = kms.ReadPassphraseFile(keyFile); err != nil {
+			log.Error(ctx, "cannot read current key file", "err", err)
+			os.Exit(1)
+		}
+	}
+
+	newPassphrase := *fNewPassphrase
+	if *fNewKeyFile != "" {
+		var err error
+		if newPassphrase, err = kms.ReadPassphraseFile(*fNewKeyFile); err != nil {
+			log.Error(ctx, "cannot read new key file", "err", err)
+			os.Exit(1)
+		}
+	}
+
+	if newPassphrase == "" && !*fDecrypt {
+		log.Error(ctx, "a new passphrase or key file is required, use --decrypt to store the keys in plaintext")
+		os.Exit(1)
+	}
+	if newPassphrase != "" && *fDecrypt {
+		log.Error(ctx, "--decrypt can not be used with a new passphrase")
+		os.Exit(1)
+	}
+
+	if err := kms.ChangeLocalStoragePassphrase(ctx, file, oldPassphrase, newPassphrase); err != nil {
+		log.Error(ctx, "cannot change local storage passphrase", "err", err, "file", file)
+		os.Exit(1)
+	}
+
+	if *fDecrypt {
+		log.Info(ctx, "local storage file decrypted, remove the passphrase from the issuer node configuration", "file", file)
+		return
+	}
+	log.Info(ctx, "local storage file encrypted with the new passphrase, update the issuer node configuration", "file", file)
+}
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	vault "github.com/hashicorp/vault/api"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/joho/godotenv"
+
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/providers"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const (
+	issuerDatabaseURL                   = "ISSUER_DATABASE_URL"
+	issuerPublishKeyPath                = "ISSUER_PUBLISH_KEY_PATH"
+	issuerKmsPluginLocalStorageFilePath = "ISSUER_KMS_PROVIDER_LOCAL_STORAGE_FILE_PATH"
+	issuerKmsLocalStoragePassphrase     = "ISSUER_KMS_PROVIDER_LOCAL_STORAGE_PASSPHRASE"
+	issuerKmsLocalStorageKeyFile        = "ISSUER_KMS_PROVIDER_LOCAL_STORAGE_KEY_FILE"
+	issuerKeyStoreToken                 = "ISSUER_KEY_STORE_TOKEN"
+	issuerKeyStoreAddress               = "ISSUER_KEY_STORE_ADDRESS"
+	issuerKeyStorePluginIden3MountPath  = "ISSUER_KEY_STORE_PLUGIN_IDEN3_MOUNT_PATH"
+	issuerVaultUserPassAuthEnabled      = "ISSUER_VAULT_USERPASS_AUTH_ENABLED"
+	issuerVaultUserPassAuthPasword      = "ISSUER_VAULT_USERPASS_AUTH_PASSWORD"
+	issuerVaultAuthMethod               = "ISSUER_VAULT_AUTH_METHOD"
+	issuerVaultAuthMountPath            = "ISSUER_VAULT_AUTH_MOUNT_PATH"
+	issuerVaultAppRoleRoleID            = "ISSUER_VAULT_APPROLE_ROLE_ID"
+	issuerVaultAppRoleSecretID          = "ISSUER_VAULT_APPROLE_SECRET_ID"
+	issuerVaultAppRoleSecretIDFile      = "ISSUER_VAULT_APPROLE_SECRET_ID_FILE"
+	issuerVaultKubernetesRole           = "ISSUER_VAULT_KUBERNETES_ROLE"
+	issuerVaultKubernetesTokenPath      = "ISSUER_VAULT_KUBERNETES_TOKEN_PATH"
+	awsAccessKey                        = "ISSUER_KMS_AWS_ACCESS_KEY"
+	awsSecretKey                        = "ISSUER_KMS_AWS_SECRET_KEY"
+	awsRegion                           = "ISSUER_KMS_AWS_REGION"
+	awsURL                              = "ISSUER_KMS_AWS_URL"
+
+	pluginFolderPath = "./localstoragekeys"
+	envFile          = ".env-issuer"
+)
+
+// This is a tool to migrate the keys of the issuer node from a key provider to another.
+// The providers are configured with the same environment variables as the issuer node.
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := godotenv.Load(envFile); err != nil {
+		log.Info(ctx, "no .env-issuer file found, using environment variables")
+	}
+
+	fFrom := flag.String("from", "", "source key provider: localstorage, vault, vault-plugin-iden3, aws-sm or aws-kms")
+	fTo := flag.String("to", "", "destination key provider: localstorage, vault, vault-plugin-iden3, aws-sm or aws-kms")
+	fDIDs := flag.String("did", "", "comma separated identities to migrate, all the identities in the database if empty")
+	fEthKeys := flag.String("eth-keys", "", "comma separated unbound Ethereum key ids to migrate, ISSUER_PUBLISH_KEY_PATH if empty")
+	fKeyTypes := flag.String("key-types", "", "comma separated key types to migrate (BJJ, ETH, Ed25519, P256), all if empty")
+	fDryRun := flag.Bool("dry-run", false, "show the keys to migrate without changing the destination")
+	flag.Parse()
+
+	from, to := kms.MigrationProvider(*fFrom), kms.MigrationProvider(*fTo)
+	if !slices.Contains(kms.MigrationProviders(), from) || !slices.Contains(kms.MigrationProviders(), to) {
+		log.Error(ctx, "from and to are required, supported values are: localstorage, vault, vault-plugin-iden3, aws-sm and aws-kms")
+		os.Exit(1)
+	}
+	if from == to {
+		log.Error(ctx, "from and to must be different key providers")
+		os.Exit(1)
+	}
+	if from == kms.MigrationProviderAWSKMS {
+		log.Error(ctx, "aws-kms keys can not be exported, it can only be a destination")
+		os.Exit(1)
+	}
+
+	keyTypes, err := parseKeyTypes(*fKeyTypes)
+	if err != nil {
+		log.Error(ctx, "invalid key types", "err", err)
+		os.Exit(1)
+	}
+
+	identities, err := getIdentities(ctx, *fDIDs)
+	if err != nil {
+		log.Error(ctx, "cannot get the identities to migrate", "err", err)
+		os.Exit(1)
+	}
+
+	ethKeys := *fEthKeys
+	if ethKeys == "" {
+		ethKeys = os.Getenv(issuerPublishKeyPath)
+	}
+	unbound := make([]kms.KeyID, 0)
+	for _, keyID := range splitList(ethKeys) {
+		unbound = append(unbound, kms.KeyID{Type: kms.KeyTypeEthereum, ID: keyID})
+	}
+
+	config, err := kmsConfig(ctx, from, to)
+	if err != nil {
+		log.Error(ctx, "invalid key provider configuration", "err", err)
+		os.Exit(1)
+	}
+	fromKMS, err := kms.OpenForMigration(ctx, from, config)
+	if err != nil {
+		log.Error(ctx, "cannot open source key provider", "err", err, "provider", from)
+		os.Exit(1)
+	}
+	toKMS, err := kms.OpenForMigration(ctx, to, config)
+	if err != nil {
+		log.Error(ctx, "cannot open destination key provider", "err", err, "provider", to)
+		os.Exit(1)
+	}
+
+	migrator := kms.NewKeyMigrator(fromKMS, toKMS)
+	plan, err := migrator.Plan(ctx, identities, unbound, keyTypes)
+	if err != nil {
+		log.Error(ctx, "cannot plan the key migration", "err", err)
+		os.Exit(1)
+	}
+
+	if *fDryRun {
+		printMigration(plan)
+		return
+	}
+
+	result, err := migrator.Migrate(ctx, plan)
+	printMigration(result)
+	if err != nil {
+		log.Error(ctx, "key migration finished with errors", "err", err)
+		os.Exit(1)
+	}
+	log.Info(ctx, "key migration finished", "keys", len(result))
+}
+
+// printMigration prints the diff of the migration. Keys whose id changes have to be updated in the
+// issuer node database, for example the signing keys of the payment options.
+func printMigration(items []kms.KeyMigration) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0) // nolint:mnd
+	_, _ = fmt.Fprintln(w, "ACTION\tTYPE\tSOURCE\tDESTINATION\tVERIFIED\tREASON")
+	changed := 0
+	for _, item := range items {
+		if item.IDChanged() {
+			changed++
+		}
+		destination := item.Destination.ID
+		if destination == "" && item.Action == kms.MigrationActionCreate {
+			destination = "<assigned on import>"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%s\n", item.Action, item.Source.Type, item.Source.ID, destination, item.Verified, item.Reason)
+	}
+	_ = w.Flush()
+	if changed > 0 {
+		fmt.Printf("\n%d keys have a new id in the destination, update the references to them in the issuer node\n", changed)
+	}
+}
+
+func getIdentities(ctx context.Context, dids string) ([]w3c.DID, error) {
+	identities := make([]w3c.DID, 0)
+	if dids != "" {
+		for _, did := range splitList(dids) {
+			identity, err := w3c.ParseDID(did)
+			if err != nil {
+				return nil, fmt.Errorf("invalid did %s: %w", did, err)
+			}
+			identities = append(identities, *identity)
+		}
+		return identities, nil
+	}
+
+	storage, err := db.NewStorage(os.Getenv(issuerDatabaseURL))
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to the database: %w", err)
+	}
+	defer func() {
+		if err := storage.Close(); err != nil {
+			log.Error(ctx, "error closing database connection", "err", err)
+		}
+	}()
+
+	all, err := repositories.NewIdentity().Get(ctx, storage.Pgx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range all {
+		identity, err := w3c.ParseDID(item.Identifier)
+		if err != nil {
+			return nil, fmt.Errorf("invalid did %s: %w", item.Identifier, err)
+		}
+		identities = append(identities, *identity)
+	}
+	return identities, nil
+}
+
+func kmsConfig(ctx context.Context, from, to kms.MigrationProvider) (kms.Config, error) {
+	localStoragePath := os.Getenv(issuerKmsPluginLocalStorageFilePath)
+	if localStoragePath == "" {
+		localStoragePath = pluginFolderPath
+	}
+	passphrase := os.Getenv(issuerKmsLocalStoragePassphrase)
+	if keyFile := os.Getenv(issuerKmsLocalStorageKeyFile); keyFile != "" {
+		var err error
+		if passphrase, err = kms.ReadPassphraseFile(keyFile); err != nil {
+			return kms.Config{}, fmt.Errorf("cannot read local storage key file: %w", err)
+		}
+	}
+
+	config := kms.Config{
+		AWSAccessKey:             os.Getenv(awsAccessKey),
+		AWSSecretKey:             os.Getenv(awsSecretKey),
+		AWSRegion:                os.Getenv(awsRegion),
+		AWSURL:                   os.Getenv(awsURL),
+		LocalStoragePath:         localStoragePath,
+		LocalStoragePassphrase:   passphrase,
+		PluginIden3MountPath:     os.Getenv(issuerKeyStorePluginIden3MountPath),
+		IssuerETHTransferKeyPath: os.Getenv(issuerPublishKeyPath),
+	}
+
+	usesVault := func(p kms.MigrationProvider) bool {
+		return p == kms.MigrationProviderVault || p == kms.MigrationProviderVaultPluginIden3
+	}
+	if usesVault(from) || usesVault(to) {
+		vaultCli, err := vaultClient(ctx)
+		if err != nil {
+			return kms.Config{}, err
+		}
+		config.Vault = vaultCli
+	}
+	return config, nil
+}
+
+func vaultClient(ctx context.Context) (*vault.Client, error) {
+	userPassEnabled := false
+	if value := os.Getenv(issuerVaultUserPassAuthEnabled); value != "" {
+		var err error
+		if userPassEnabled, err = strconv.ParseBool(value); err != nil {
+			return nil, fmt.Errorf("cannot parse userpass auth enabled value: %w", err)
+		}
+	}
+	return providers.VaultClient(ctx, providers.Config{
+		UserPassAuthEnabled: userPassEnabled,
+		Pass:                os.Getenv(issuerVaultUserPassAuthPasword),
+		Address:             os.Getenv(issuerKeyStoreAddress),
+		Token:               os.Getenv(issuerKeyStoreToken),
+		AuthMethod:          os.Getenv(issuerVaultAuthMethod),
+		AuthMountPath:       os.Getenv(issuerVaultAuthMountPath),
+		AppRoleRoleID:       os.Getenv(issuerVaultAppRoleRoleID),
+		AppRoleSecretID:     os.Getenv(issuerVaultAppRoleSecretID),
+		AppRoleSecretIDFile: os.Getenv(issuerVaultAppRoleSecretIDFile),
+		KubernetesRole:      os.Getenv(issuerVaultKubernetesRole),
+		KubernetesTokenPath: os.Getenv(issuerVaultKubernetesTokenPath),
+	})
+}
+
+func parseKeyTypes(value string) ([]kms.KeyType, error) {
+	supported := []kms.KeyType{kms.KeyTypeBabyJubJub, kms.KeyTypeEthereum, kms.KeyTypeEd25519, kms.KeyTypeP256}
+	keyTypes := make([]kms.KeyType, 0)
+	for _, kt := range splitList(value) {
+		if !slices.Contains(supported, kms.KeyType(kt)) {
+			return nil, fmt.Errorf("unknown key type %s", kt)
+		}
+		keyTypes = append(keyTypes, kms.KeyType(kt))
+	}
+	return keyTypes, nil
+}
+
+func splitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -33,6 +33,8 @@ const (
 	issuerKMSETHProvider                = "ISSUER_KMS_ETH_PROVIDER"
 	issuerPublishKeyPath                = "ISSUER_PUBLISH_KEY_PATH"
 	issuerKmsPluginLocalStorageFilePath = "ISSUER_KMS_PROVIDER_LOCAL_STORAGE_FILE_PATH"
+	issuerKmsLocalStoragePassphrase     = "ISSUER_KMS_PROVIDER_LOCAL_STORAGE_PASSPHRASE"
+	issuerKmsLocalStorageKeyFile        = "ISSUER_KMS_PROVIDER_LOCAL_STORAGE_KEY_FILE"
 	issuerKeyStoreToken                 = "ISSUER_KEY_STORE_TOKEN"
 	issuerKeyStoreAddress               = "ISSUER_KEY_STORE_ADDRESS"
 	issuerKeyStorePluginIden3MountPath  = "ISSUER_KEY_STORE_PLUGIN_IDEN3_MOUNT_PATH"
@@ -46,6 +48,7 @@ const (
 	jsonKeyPath      = "key_path"
 	jsonKeyType      = "key_type"
 	jsonPrivateKey   = "private_key"
+	jsonKeyData      = "key_data"
 	ethereum         = "ethereum"
 	pluginFolderPath = "./localstoragekeys"
 	envFile          = ".env-issuer"
@@ -91,6 +94,20 @@ func main() {
 	material[jsonKeyType] = ethereum
 
 	if issuerKMSETHProviderToUse == config.LocalStorage {
+		passphrase, err := localStoragePassphrase()
+		if err != nil {
+			log.Error(ctx, "cannot read local storage passphrase", "err", err)
+			return
+		}
+		if passphrase != "" {
+			if err := saveKeyMaterialToEncryptedFile(ctx, issuerKmsPluginLocalStorageFilePath, kms.LocalStorageFileName, passphrase, issuerPublishKeyPathVar, *fPrivateKey); err != nil {
+				log.Error(ctx, "cannot save key material to encrypted file", "err", err)
+				return
+			}
+			log.Info(ctx, "private key saved to encrypted file:", "path:", kms.LocalStorageFileName)
+			return
+		}
+
 		material[jsonPrivateKey] = *fPrivateKey
 		if err := saveKeyMaterialToFile(ctx, issuerKmsPluginLocalStorageFilePath, kms.LocalStorageFileName, material); err != nil {
 			log.Error(ctx, "cannot save key material to file", "err", err)
@@ -337,19 +354,54 @@ func saveKeyMaterialToFile(ctx context.Context, folderPath, file string, keyMate
 	return nil
 }
 
-func readContentFile(ctx context.Context, folderPath, fileName string) ([]localStorageBJJKeyProviderFileContent, error) {
+// saveKeyMaterialToEncryptedFile saves the key using the kms local storage, so the file is kept encrypted
+func saveKeyMaterialToEncryptedFile(ctx context.Context, folderPath, file, passphrase, keyPath, privateKey string) error {
+	filePath, err := createFileIfNotExists(ctx, folderPath, file)
+	if err != nil {
+		return err
+	}
+
+	storageManager, err := kms.NewEncryptedFileStorageManager(ctx, filePath, passphrase)
+	if err != nil {
+		return err
+	}
+
+	exists, err := kms.NewLocalEthKeyProvider(kms.KeyTypeEthereum, storageManager).Exists(ctx, kms.KeyID{Type: kms.KeyTypeEthereum, ID: keyPath})
+	if err != nil {
+		return err
+	}
+	if exists {
+		log.Error(ctx, "private key already exists", "keyPath", keyPath)
+		return errors.New("private key already exists")
+	}
+
+	return storageManager.SaveKeyMaterial(ctx, map[string]string{
+		jsonKeyType: string(kms.KeyTypeEthereum),
+		jsonKeyData: privateKey,
+	}, keyPath)
+}
+
+// localStoragePassphrase returns the passphrase the local storage file is encrypted with, if any
+func localStoragePassphrase() (string, error) {
+	if keyFile := os.Getenv(issuerKmsLocalStorageKeyFile); keyFile != "" {
+		return kms.ReadPassphraseFile(keyFile)
+	}
+	return os.Getenv(issuerKmsLocalStoragePassphrase), nil
+}
+
+func createFileIfNotExists(ctx context.Context, folderPath, fileName string) (string, error) {
 	if err := os.MkdirAll(folderPath, os.ModePerm); err != nil {
-		return nil, fmt.Errorf("error creating folder: %v", err)
+		return "", fmt.Errorf("error creating folder: %v", err)
 	}
 	filePath := filepath.Join(folderPath, fileName)
 	if _, err := os.Stat(filePath); os.IsNotExist(err) {
 		file, err := os.Create(filePath)
 		if err != nil {
-			return nil, fmt.Errorf("error creating file: %v", err)
+			return "", fmt.Errorf("error creating file: %v", err)
 		}
 		fileContent := []byte("[]")
 		if _, err := file.Write(fileContent); err != nil {
-			return nil, fmt.Errorf("error initiliazing file: %v", err)
+			return "", fmt.Errorf("error initiliazing file: %v", err)
 		}
 		defer func(file *os.File) {
 			err := file.Close()
@@ -358,6 +410,14 @@ func readContentFile(ctx context.Context, folderPath, fileName string) ([]localS
 			}
 		}(file)
 	}
+	return filePath, nil
+}
+
+func readContentFile(ctx context.Context, folderPath, fileName string) ([]localStorageBJJKeyProviderFileContent, error) {
+	filePath, err := createFileIfNotExists(ctx, folderPath, fileName)
+	if err != nil {
+		return nil, err
+	}
 
 	fileContent, err := os.ReadFile(filePath)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/iden3/iden3comm/v2"
 	"github.com/iden3/iden3comm/v2/packers"
@@ -19,16 +20,18 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
+	"github.com/polygonid/sh-id-platform/internal/health"
 	httpPkg "github.com/polygonid/sh-id-platform/internal/http"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/metrics"
 	"github.com/polygonid/sh-id-platform/internal/network"
-	"github.com/polygonid/sh-id-platform/internal/providers"
 	"github.com/polygonid/sh-id-platform/internal/pubsub"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/internal/reversehash"
 	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
+	"github.com/polygonid/sh-id-platform/internal/tracing"
 )
 
 var build = buildinfo.Revision()
@@ -47,6 +50,17 @@ func main() {
 
 	log.Config(cfg.Log.Level, cfg.Log.Mode, os.Stdout)
 
+	shutdownTracing, err := tracing.Config(ctx, "issuer-notifications", cfg.Tracing.Endpoint, cfg.Tracing.SampleRatio)
+	if err != nil {
+		log.Error(ctx, "cannot configure tracing", "err", err)
+		return
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Error(ctx, "flushing traces", "err", err)
+		}
+	}()
+
 	cachex, err := cache.NewCacheClient(ctx, *cfg)
 	if err != nil {
 		log.Error(ctx, "cannot initialize cache", "err", err)
@@ -65,24 +79,17 @@ func main() {
 	}
 
 	connectionsRepository := repositories.NewConnection()
+	connectionMessagesRepository := repositories.NewConnectionMessage()
 	claimsRepository := repositories.NewClaim()
 
-	vaultCfg := providers.Config{
-		UserPassAuthEnabled: cfg.KeyStore.VaultUserPassAuthEnabled,
-		Pass:                cfg.KeyStore.VaultUserPassAuthPassword,
-		Address:             cfg.KeyStore.Address,
-		Token:               cfg.KeyStore.Token,
-		TLSEnabled:          cfg.KeyStore.TLSEnabled,
-		CertPath:            cfg.KeyStore.CertPath,
-	}
-
-	keyStore, err := config.KeyStoreConfig(ctx, cfg, vaultCfg)
+	keyStore, err := config.KeyStoreConfig(ctx, cfg, cfg.KeyStore.VaultConfig())
 	if err != nil {
 		log.Error(ctx, "cannot initialize key store", "err", err)
 		return
 	}
+	keyStore.SetSignAuditor(services.NewKeyUsage(keyStore, repositories.NewKeyUsage(), repositories.NewKeyPolicy(), repositories.NewPayment(*storage), []kms.KeyID{{Type: kms.KeyTypeEthereum, ID: cfg.PublishingKeyPath}}, storage))
 
-	connectionsService := services.NewConnection(connectionsRepository, claimsRepository, storage)
+	connectionsService := services.NewConnection(connectionsRepository, claimsRepository, connectionMessagesRepository, storage, ps)
 	credentialsService, err := newCredentialsService(ctx, cfg, storage, cachex, ps, keyStore)
 	if err != nil {
 		log.Error(ctx, "cannot initialize the credential service", "err", err)
@@ -103,17 +110,53 @@ func main() {
 	ps.Subscribe(ctxCancel, event.CreateCredentialEvent, notificationService.SendCreateCredentialNotification)
 	ps.Subscribe(ctxCancel, event.CreateConnectionEvent, notificationService.SendCreateConnectionNotification)
 	ps.Subscribe(ctxCancel, event.CreateStateEvent, notificationService.SendRevokeCredentialNotification)
+	ps.Subscribe(ctxCancel, event.ConnectionMessageEvent, notificationService.SendConnectionMessageNotification)
+	if cfg.AuthSession.WebhookURL != "" {
+		authSessionWebhook := services.NewAuthSessionWebhook(httpPkg.DefaultHTTPClientWithRetry, cfg.AuthSession.WebhookURL)
+		ps.Subscribe(ctxCancel, event.AuthSessionEvent, authSessionWebhook.SendAuthSessionWebhook)
+	}
+
+	webhookService := services.NewWebhook(repositories.NewWebhook(), storage, cachex, cfg.Webhooks)
+	webhookService.Subscribe(ctxCancel, ps)
+	go func(ctx context.Context) {
+		ticker := time.NewTicker(cfg.Webhooks.DeliveryFrequency)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := webhookService.ProcessDeliveries(ctx); err != nil {
+					log.Error(ctx, "error processing webhook deliveries", "err", err)
+				}
+			case <-ctx.Done():
+				log.Info(ctx, "finishing webhook deliveries job")
+				return
+			}
+		}
+	}(ctxCancel)
 
 	gracefulShutdown := make(chan os.Signal, 1)
 	signal.Notify(gracefulShutdown, syscall.SIGINT, syscall.SIGTERM)
 
+	monitors := health.Monitors{
+		"postgres": storage.Ping,
+		"cache":    cache.Ping(cachex),
+	}
+	for name, ping := range keyStore.Pingers() {
+		monitors[name] = ping
+	}
+	notificationsHealth := health.New(monitors, nil)
+	notificationsHealth.Run(ctxCancel, cfg.Health.CheckPeriod)
+
 	go func() {
+		http.Handle("/metrics", metrics.Handler())
 		http.Handle("/status", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			_, err := w.Write([]byte("OK"))
 			if err != nil {
 				log.Error(ctx, "error writing response", "err", err)
 			}
 		}))
+		http.Handle("/health/live", health.LiveHandler())
+		http.Handle("/health/ready", notificationsHealth.ReadyHandler())
 		log.Info(ctx, "Starting server at port 3004")
 		err := http.ListenAndServe(":3004", nil)
 		if err != nil {
@@ -158,8 +201,8 @@ func newCredentialsService(ctx context.Context, cfg *config.Configuration, stora
 		*cfg.MediaTypeManager.Enabled,
 	)
 
-	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, nil, storage, nil, nil, ps, *networkResolver, rhsFactory, revocationStatusResolver, keyRepository)
-	claimsService := services.NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, nil, storage, nil, nil, ps, *networkResolver, rhsFactory, revocationStatusResolver, keyRepository, nil)
+	claimsService := services.NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks, nil)
 
 	return claimsService, nil
 }
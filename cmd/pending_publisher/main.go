@@ -8,6 +8,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/iden3/go-circuits/v2"
 	"github.com/iden3/iden3comm/v2"
 	"github.com/iden3/iden3comm/v2/packers"
 	"github.com/iden3/iden3comm/v2/protocol"
@@ -18,15 +19,19 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/db/schema"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
+	"github.com/polygonid/sh-id-platform/internal/health"
+	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/metrics"
 	"github.com/polygonid/sh-id-platform/internal/network"
-	"github.com/polygonid/sh-id-platform/internal/providers"
 	"github.com/polygonid/sh-id-platform/internal/pubsub"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/internal/reversehash"
 	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
+	"github.com/polygonid/sh-id-platform/internal/tracing"
 	circuitLoaders "github.com/polygonid/sh-id-platform/pkg/loaders"
 )
 
@@ -46,6 +51,17 @@ func main() {
 
 	log.Config(cfg.Log.Level, cfg.Log.Mode, os.Stdout)
 
+	shutdownTracing, err := tracing.Config(ctx, "issuer-pending-publisher", cfg.Tracing.Endpoint, cfg.Tracing.SampleRatio)
+	if err != nil {
+		log.Error(ctx, "cannot configure tracing", "err", err)
+		panic(err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Error(ctx, "flushing traces", "err", err)
+		}
+	}()
+
 	cachex, err := cache.NewCacheClient(ctx, *cfg)
 	if err != nil {
 		log.Error(ctx, "cannot initialize cache", "err", err)
@@ -73,20 +89,13 @@ func main() {
 	// TODO: Cache only if cfg.APIUI.SchemaCache == true
 	schemaLoader := loader.NewDocumentLoader(cfg.IPFS.GatewayURL, cfg.SchemaCache)
 
-	vaultCfg := providers.Config{
-		UserPassAuthEnabled: cfg.KeyStore.VaultUserPassAuthEnabled,
-		Pass:                cfg.KeyStore.VaultUserPassAuthPassword,
-		Address:             cfg.KeyStore.Address,
-		Token:               cfg.KeyStore.Token,
-		TLSEnabled:          cfg.KeyStore.TLSEnabled,
-		CertPath:            cfg.KeyStore.CertPath,
-	}
-
-	keyStore, err := config.KeyStoreConfig(ctx, cfg, vaultCfg)
+	keyStore, err := config.KeyStoreConfig(ctx, cfg, cfg.KeyStore.VaultConfig())
 	if err != nil {
 		log.Error(ctx, "cannot initialize key store", "err", err)
 		return
 	}
+	publishingKey := kms.KeyID{Type: kms.KeyTypeEthereum, ID: cfg.PublishingKeyPath}
+	keyStore.SetSignAuditor(services.NewKeyUsage(keyStore, repositories.NewKeyUsage(), repositories.NewKeyPolicy(), repositories.NewPayment(*storage), []kms.KeyID{publishingKey}, storage))
 
 	reader, err := network.GetReaderFromConfig(cfg, ctx)
 	if err != nil {
@@ -121,8 +130,9 @@ func main() {
 		*cfg.MediaTypeManager.Enabled,
 	)
 
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), *networkResolver, rhsFactory, revocationStatusResolver, keyRepository)
-	claimsService := services.NewClaim(claimsRepo, identityService, qrService, mtService, identityStateRepo, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), *networkResolver, rhsFactory, revocationStatusResolver, keyRepository, nil)
+	claimsService := services.NewClaim(claimsRepo, identityService, qrService, mtService, identityStateRepo, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks, nil)
+	keyService := services.NewKey(keyStore, claimsService, keyRepository, ps, cfg.KeyExpiry)
 
 	circuitsLoaderService := circuitLoaders.NewCircuits(cfg.Circuit.Path)
 	proofService := initProofService(circuitsLoaderService)
@@ -155,13 +165,70 @@ func main() {
 		}
 	}(ctx)
 
+	go func(ctx context.Context) {
+		ticker := time.NewTicker(cfg.KeyExpiry.CheckFrequency)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := keyService.ProcessExpirations(ctx); err != nil {
+					log.Error(ctx, "error processing key expirations", "err", err)
+				}
+			case <-ctx.Done():
+				log.Info(ctx, "finishing key expiration job")
+				return
+			}
+		}
+	}(ctx)
+
+	idempotencyKeyService := services.NewIdempotencyKey(repositories.NewIdempotencyKey(), storage, cfg.Idempotency)
+	go func(ctx context.Context) {
+		ticker := time.NewTicker(cfg.Idempotency.PurgeFrequency)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				deleted, err := idempotencyKeyService.DeleteExpired(ctx)
+				if err != nil {
+					log.Error(ctx, "error deleting expired idempotency keys", "err", err)
+					continue
+				}
+				log.Debug(ctx, "expired idempotency keys deleted", "count", deleted)
+			case <-ctx.Done():
+				log.Info(ctx, "finishing idempotency keys purge job")
+				return
+			}
+		}
+	}(ctx)
+
+	monitors := health.Monitors{
+		"postgres": storage.Ping,
+		"cache":    cache.Ping(cachex),
+		"migrations": func(ctx context.Context) error {
+			return schema.CheckMigrations(ctx, storage.Pgx)
+		},
+		"circuits": func(context.Context) error {
+			return circuitsLoaderService.Check(circuits.StateTransitionCircuitID)
+		},
+	}
+	for name, ping := range keyStore.Pingers() {
+		monitors[name] = ping
+	}
+	// The networks only affect the operations that use them, so they don't make the node unready
+	networkMonitors := networkResolver.HealthChecks(publishingKey, cfg.Health.MaxBlockAge, cfg.Health.MinBalanceWei())
+	publisherHealth := health.New(monitors, networkMonitors)
+	publisherHealth.Run(ctx, cfg.Health.CheckPeriod)
+
 	go func() {
+		http.Handle("/metrics", metrics.Handler())
 		http.Handle("/status", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			_, err := w.Write([]byte("OK"))
 			if err != nil {
 				log.Error(ctx, "error writing response", "err", err)
 			}
 		}))
+		http.Handle("/health/live", health.LiveHandler())
+		http.Handle("/health/ready", publisherHealth.ReadyHandler())
 		log.Info(ctx, "Starting server at port 3005")
 		err := http.ListenAndServe(":3005", nil)
 		if err != nil {
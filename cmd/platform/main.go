@@ -7,10 +7,12 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
+	"github.com/iden3/go-circuits/v2"
 	auth "github.com/iden3/go-iden3-auth/v2"
 	"github.com/iden3/go-iden3-auth/v2/loaders"
 	"github.com/iden3/iden3comm/v2"
@@ -21,21 +23,28 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/buildinfo"
 	"github.com/polygonid/sh-id-platform/internal/cache"
 	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/db/schema"
 	"github.com/polygonid/sh-id-platform/internal/errors"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/health"
+	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/metrics"
 	"github.com/polygonid/sh-id-platform/internal/network"
+	"github.com/polygonid/sh-id-platform/internal/oidc"
 	"github.com/polygonid/sh-id-platform/internal/packagemanager"
 	"github.com/polygonid/sh-id-platform/internal/payments"
-	"github.com/polygonid/sh-id-platform/internal/providers"
 	"github.com/polygonid/sh-id-platform/internal/pubsub"
+	"github.com/polygonid/sh-id-platform/internal/ratelimit"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/internal/reversehash"
 	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
+	"github.com/polygonid/sh-id-platform/internal/tracing"
 	circuitLoaders "github.com/polygonid/sh-id-platform/pkg/loaders"
 )
 
@@ -54,6 +63,17 @@ func main() {
 	}
 	log.Config(cfg.Log.Level, cfg.Log.Mode, os.Stdout)
 
+	shutdownTracing, err := tracing.Config(ctx, "issuer-api", cfg.Tracing.Endpoint, cfg.Tracing.SampleRatio)
+	if err != nil {
+		log.Error(ctx, "cannot configure tracing", "err", err)
+		return
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Error(ctx, "flushing traces", "err", err)
+		}
+	}()
+
 	storage, err := db.NewStorage(cfg.Database.URL)
 	if err != nil {
 		log.Error(ctx, "cannot connect to database", "err", err)
@@ -74,20 +94,14 @@ func main() {
 	// TODO: Cache only if cfg.APIUI.SchemaCache == true
 	schemaLoader := loader.NewDocumentLoader(cfg.IPFS.GatewayURL, cfg.SchemaCache)
 
-	vaultCfg := providers.Config{
-		UserPassAuthEnabled: cfg.KeyStore.VaultUserPassAuthEnabled,
-		Pass:                cfg.KeyStore.VaultUserPassAuthPassword,
-		Address:             cfg.KeyStore.Address,
-		Token:               cfg.KeyStore.Token,
-		TLSEnabled:          cfg.KeyStore.TLSEnabled,
-		CertPath:            cfg.KeyStore.CertPath,
-	}
-
-	keyStore, err := config.KeyStoreConfig(ctx, cfg, vaultCfg)
+	keyStore, err := config.KeyStoreConfig(ctx, cfg, cfg.KeyStore.VaultConfig())
 	if err != nil {
 		log.Error(ctx, "cannot initialize key store", "err", err)
 		return
 	}
+	publishingKey := kms.KeyID{Type: kms.KeyTypeEthereum, ID: cfg.PublishingKeyPath}
+	keyUsageService := services.NewKeyUsage(keyStore, repositories.NewKeyUsage(), repositories.NewKeyPolicy(), repositories.NewPayment(*storage), []kms.KeyID{publishingKey}, storage)
+	keyStore.SetSignAuditor(keyUsageService)
 
 	circuitsLoaderService := circuitLoaders.NewCircuits(cfg.Circuit.Path)
 
@@ -107,19 +121,20 @@ func main() {
 	identityRepository := repositories.NewIdentity()
 	claimsRepository := repositories.NewClaim()
 	connectionsRepository := repositories.NewConnection()
+	connectionMessagesRepository := repositories.NewConnectionMessage()
 	mtRepository := repositories.NewIdentityMerkleTreeRepository()
 	identityStateRepository := repositories.NewIdentityState()
 	revocationRepository := repositories.NewRevocation()
 	schemaRepository := repositories.NewSchema(*storage)
 	linkRepository := repositories.NewLink(*storage)
-	sessionRepository := repositories.NewSessionCached(cachex)
+	sessionRepository := repositories.NewSessionCached(cachex, cfg.AuthSession.Retention)
 	keyRepository := repositories.NewKey(*storage)
 	paymentsRepo := repositories.NewPayment(*storage)
 
 	// services initialization
 	mtService := services.NewIdentityMerkleTrees(mtRepository)
 	qrService := services.NewQrStoreService(cachex)
-	connectionsService := services.NewConnection(connectionsRepository, claimsRepository, storage)
+	connectionsService := services.NewConnection(connectionsRepository, claimsRepository, connectionMessagesRepository, storage, ps)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -155,18 +170,22 @@ func main() {
 	}
 
 	revocationStatusResolver := revocationstatus.NewRevocationStatusResolver(*networkResolver)
-	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, connectionsRepository, storage, verifier, sessionRepository, ps, *networkResolver, rhsFactory, revocationStatusResolver, keyRepository)
-	claimsService := services.NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	tenantRepository := repositories.NewTenant()
+	tenantService := services.NewTenant(tenantRepository, storage)
+	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, connectionsRepository, storage, verifier, sessionRepository, ps, *networkResolver, rhsFactory, revocationStatusResolver, keyRepository, tenantService)
+	claimsService := services.NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks, tenantService)
 	proofService := services.NewProver(circuitsLoaderService)
 	displayMethodService := services.NewDisplayMethod(repositories.NewDisplayMethod(*storage))
 	schemaService := services.NewSchema(schemaRepository, schemaLoader, displayMethodService)
-	linkService := services.NewLinkService(storage, claimsService, qrService, claimsRepository, linkRepository, schemaRepository, schemaLoader, sessionRepository, ps, identityService, *networkResolver, cfg.UniversalLinks)
-	paymentService, err := services.NewPaymentService(paymentsRepo, *networkResolver, schemaService, paymentSettings, keyStore)
+	linkService := services.NewLinkService(storage, claimsService, qrService, claimsRepository, linkRepository, schemaRepository, schemaLoader, sessionRepository, ps, identityService, *networkResolver, cfg.UniversalLinks, tenantService)
+	paymentService, err := services.NewPaymentService(paymentsRepo, *networkResolver, schemaService, paymentSettings, keyStore, ps)
 	if err != nil {
 		log.Error(ctx, "error creating payment service", "err", err)
 		return
 	}
-	keyService := services.NewKey(keyStore, claimsService, keyRepository)
+	keyService := services.NewKey(keyStore, claimsService, keyRepository, ps, cfg.KeyExpiry)
+	proofRequestService := services.NewProofRequest(repositories.NewProofRequest(), connectionsService, qrService, verifier, storage)
+	connectionMergeService := services.NewConnectionMerge(connectionsRepository, repositories.NewConnectionMerge(), claimsRepository, claimsService, qrService, verifier, storage)
 	transactionService, err := gateways.NewTransaction(*networkResolver)
 	discoveryService := services.NewDiscovery(mediaTypeManager, packageManager, mediaTypeManager.GetSupportedProtocolMessages())
 	if err != nil {
@@ -183,25 +202,99 @@ func main() {
 
 	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, networkResolver, ps)
 
-	serverHealth := health.New(health.Monitors{
+	pendingActionOperations := make([]domain.PendingActionType, 0, len(cfg.Approvals.Operations))
+	for _, operation := range cfg.Approvals.Operations {
+		pendingActionOperations = append(pendingActionOperations, domain.PendingActionType(operation))
+	}
+	pendingActionService := services.NewPendingAction(services.PendingActionConfig{
+		RequiredApprovals:   cfg.Approvals.RequiredApprovals,
+		Approvers:           cfg.Approvals.Approvers,
+		ApproverDIDs:        cfg.Approvals.ApproverDIDs,
+		Operations:          pendingActionOperations,
+		RevocationThreshold: cfg.Approvals.RevocationThreshold,
+		Expiration:          cfg.Approvals.Expiration,
+	}, repositories.NewPendingAction(), publisher, claimsService, connectionsService, keyService, paymentService, qrService, verifier, storage)
+	// Approved actions are executed by the approval, this runs the ones the node did not finish executing
+	go func(ctx context.Context) {
+		ticker := time.NewTicker(cfg.Approvals.ExecuteFrequency)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := pendingActionService.ExecuteApproved(ctx); err != nil {
+					log.Error(ctx, "error executing approved actions", "err", err)
+				}
+			case <-ctx.Done():
+				log.Info(ctx, "finishing approved actions job")
+				return
+			}
+		}
+	}(ctx)
+
+	auditLogService := services.NewAuditLog(repositories.NewAuditLog(), storage)
+	webhookService := services.NewWebhook(repositories.NewWebhook(), storage, cachex, cfg.Webhooks)
+	idempotencyKeyService := services.NewIdempotencyKey(repositories.NewIdempotencyKey(), storage, cfg.Idempotency)
+	apiKeyService := services.NewAPIKey(repositories.NewAPIKey(), tenantRepository, storage)
+
+	var oidcVerifier *oidc.Verifier
+	if cfg.OIDC.Enabled() {
+		oidcVerifier, err = oidc.NewVerifier(oidc.Config{
+			IssuerURL:       cfg.OIDC.IssuerURL,
+			Audience:        cfg.OIDC.Audience,
+			JWKSURL:         cfg.OIDC.JWKSURL,
+			JWKSFile:        cfg.OIDC.JWKSFile,
+			CacheTTL:        cfg.OIDC.JWKSCacheTTL,
+			RolesClaim:      cfg.OIDC.RolesClaim,
+			IdentitiesClaim: cfg.OIDC.IdentitiesClaim,
+			TenantClaim:     cfg.OIDC.TenantClaim,
+			AdminRole:       cfg.OIDC.AdminRole,
+			ViewerRole:      cfg.OIDC.ViewerRole,
+		})
+		if err != nil {
+			log.Error(ctx, "error creating the oidc token verifier", "err", err)
+			return
+		}
+	}
+
+	monitors := health.Monitors{
 		"postgres": storage.Ping,
-		//"redis": func(rdb *redis2.Client) health.Pinger {
-		//	return func(ctx context.Context) error { return rdb.Ping(ctx).Err() }
-		//}(rdb),
-	})
-	serverHealth.Run(ctx, health.DefaultPingPeriod)
+		"cache":    cache.Ping(cachex),
+		"migrations": func(ctx context.Context) error {
+			return schema.CheckMigrations(ctx, storage.Pgx)
+		},
+		"circuits": func(context.Context) error {
+			return circuitsLoaderService.Check(circuits.AuthV2CircuitID, circuits.AuthV3CircuitID, circuits.StateTransitionCircuitID)
+		},
+	}
+	for name, ping := range keyStore.Pingers() {
+		monitors[name] = ping
+	}
+	// The networks only affect the operations that use them, so they don't make the node unready
+	networkMonitors := networkResolver.HealthChecks(publishingKey, cfg.Health.MaxBlockAge, cfg.Health.MinBalanceWei())
+	serverHealth := health.New(monitors, networkMonitors)
+	serverHealth.Run(ctx, cfg.Health.CheckPeriod)
+
+	var rateLimiter *ratelimit.Limiter
+	if cfg.RateLimit.Enabled {
+		rateLimitStore := cachex
+		if cfg.RateLimit.Store == config.RateLimitStoreMemory {
+			rateLimitStore = cache.NewMemoryCache()
+		}
+		rateLimiter = ratelimit.New(rateLimitStore)
+	}
 
 	mux := chi.NewRouter()
 
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins:   []string{"localhost", "127.0.0.1", "*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-API-Key", "traceparent", "tracestate"},
 		AllowCredentials: true,
 	})
 
 	mux.Use(
 		chiMiddleware.RequestID,
+		tracing.Middleware("issuer-api"),
 		log.ChiMiddleware(ctx),
 		chiMiddleware.Recoverer,
 		corsMiddleware.Handler,
@@ -210,8 +303,8 @@ func main() {
 
 	api.HandlerWithOptions(
 		api.NewStrictHandlerWithOptions(
-			api.NewServer(cfg, identityService, accountService, connectionsService, claimsService, qrService, publisher, packageManager, *networkResolver, serverHealth, schemaService, linkService, displayMethodService, keyService, paymentService, discoveryService),
-			middlewares(ctx, cfg.HTTPBasicAuth),
+			api.NewServer(cfg, identityService, accountService, connectionsService, claimsService, qrService, publisher, packageManager, *networkResolver, serverHealth, schemaService, linkService, displayMethodService, keyService, paymentService, discoveryService, proofRequestService, connectionMergeService, pendingActionService, keyUsageService, apiKeyService, tenantService, auditLogService, webhookService, rateLimiter),
+			middlewares(ctx, cfg.HTTPBasicAuth, apiKeyService, oidcVerifier, tenantService, auditLogService, idempotencyKeyService, rateLimiter, cfg.RateLimit),
 			api.StrictHTTPServerOptions{
 				RequestErrorHandlerFunc:  errors.RequestErrorHandlerFunc,
 				ResponseErrorHandlerFunc: errors.ResponseErrorHandlerFunc,
@@ -236,13 +329,32 @@ func main() {
 		}
 	}()
 
+	// The metrics are served in their own port, that must not be exposed to the internet
+	if cfg.MetricsPort != 0 {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", metrics.Handler())
+		metricsServer := &http.Server{
+			Addr:    fmt.Sprintf(":%d", cfg.MetricsPort),
+			Handler: metricsMux,
+		}
+		go func() {
+			log.Info(ctx, "metrics server started", "port", cfg.MetricsPort)
+			if err := metricsServer.ListenAndServe(); err != nil {
+				log.Error(ctx, "starting metrics server", "err", err)
+			}
+		}()
+	}
+
 	<-quit
 	log.Info(ctx, "Shutting down")
 }
 
-func middlewares(ctx context.Context, auth config.HTTPBasicAuth) []api.StrictMiddlewareFunc {
+func middlewares(ctx context.Context, auth config.HTTPBasicAuth, apiKeyService ports.APIKeyService, oidcVerifier *oidc.Verifier, tenantService ports.TenantService, auditLogService ports.AuditLogService, idempotencyKeyService ports.IdempotencyKeyService, rateLimiter *ratelimit.Limiter, rateLimit config.RateLimit) []api.StrictMiddlewareFunc {
 	return []api.StrictMiddlewareFunc{
 		api.LogMiddleware(ctx),
-		api.BasicAuthMiddleware(ctx, auth.User, auth.Password),
+		api.IdempotencyMiddleware(ctx, idempotencyKeyService),
+		api.AuthMiddleware(ctx, auth.User, auth.Password, apiKeyService, oidcVerifier, tenantService),
+		api.AuditMiddleware(ctx, auditLogService),
+		api.RateLimitMiddleware(ctx, rateLimiter, rateLimit),
 	}
 }
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	signerKmsLocalStorageFilePath   = "REMOTE_SIGNER_LOCAL_STORAGE_FILE_PATH"
+	signerKmsLocalStoragePassphrase = "REMOTE_SIGNER_LOCAL_STORAGE_PASSPHRASE"
+	signerKmsLocalStorageKeyFile    = "REMOTE_SIGNER_LOCAL_STORAGE_KEY_FILE"
+
+	defaultStorageFolderPath = "./remotesignerkeys"
+	envFile                  = ".env-remote-signer"
+	shutdownTimeout          = 10 * time.Second
+)
+
+// This is a reference implementation of the remote signer contract used by the remote key provider
+// (ISSUER_KMS_*_PROVIDER=remote). The keys are kept in a local storage file and the clients have to present a
+// certificate issued by the client CA.
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := godotenv.Load(envFile); err != nil {
+		log.Info(ctx, "no .env-remote-signer file found, using environment variables")
+	}
+
+	fAddr := flag.String("addr", ":8443", "address to listen on")
+	fCert := flag.String("cert", "", "server certificate file (PEM)")
+	fKey := flag.String("key", "", "server private key file (PEM)")
+	fClientCA := flag.String("client-ca", "", "CA certificates file (PEM) the client certificates have to be issued by")
+	flag.Parse()
+
+	if *fCert == "" || *fKey == "" || *fClientCA == "" {
+		log.Error(ctx, "cert, key and client-ca are required")
+		os.Exit(1)
+	}
+
+	storagePath := os.Getenv(signerKmsLocalStorageFilePath)
+	if storagePath == "" {
+		storagePath = defaultStorageFolderPath
+	}
+	passphrase := os.Getenv(signerKmsLocalStoragePassphrase)
+	keyFile := os.Getenv(signerKmsLocalStorageKeyFile)
+	if keyFile != "" {
+		var err error
+		if passphrase, err = kms.ReadPassphraseFile(keyFile); err != nil {
+			log.Error(ctx, "cannot read local storage key file", "err", err)
+			os.Exit(1)
+		}
+	}
+
+	keyStore, err := kms.OpenForMigration(ctx, kms.MigrationProviderLocalStorage, kms.Config{
+		LocalStoragePath:       storagePath,
+		LocalStoragePassphrase: passphrase,
+		LocalStorageKeyFile:    keyFile,
+	})
+	if err != nil {
+		log.Error(ctx, "cannot open the key storage", "err", err)
+		os.Exit(1)
+	}
+
+	clientCAs, err := kms.LoadCertPool(*fClientCA)
+	if err != nil {
+		log.Error(ctx, "cannot load client CA", "err", err)
+		os.Exit(1)
+	}
+
+	server := &http.Server{
+		Addr:              *fAddr,
+		Handler:           kms.NewRemoteSignerHandler(keyStore),
+		ReadHeaderTimeout: 5 * time.Second,
+		TLSConfig: &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  clientCAs,
+			MinVersion: tls.VersionTLS12,
+		},
+	}
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		log.Info(ctx, "remote signer started", "addr", *fAddr)
+		if err := server.ListenAndServeTLS(*fCert, *fKey); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error(ctx, "starting remote signer", "err", err)
+			os.Exit(1)
+		}
+	}()
+
+	<-quit
+	log.Info(ctx, "Shutting down")
+	shutdownCtx, shutdownCancel := context.WithTimeout(ctx, shutdownTimeout)
+	defer shutdownCancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Error(ctx, "shutting down remote signer", "err", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/metrics"
 )
 
 // Agent is the controller to fetch credentials from mobile
@@ -22,6 +23,10 @@ func (s *Server) Agent(ctx context.Context, request AgentRequestObject) (AgentRe
 		log.Debug(ctx, "agent bad request", "err", err, "body", *request.Body)
 		return Agent400JSONResponse{N400JSONResponse{"cannot proceed with the given request"}}, nil
 	}
+	metrics.AgentMessage(agentMessageType(basicMessage.Type), string(mediatype))
+	if allowed, retryAfter := s.allowHolder(ctx, basicMessage.From, mediatype); !allowed {
+		return Agent429JSONResponse{N429JSONResponse{Body: GenericErrorMessage{Message: "too many requests"}, Headers: N429ResponseHeaders{RetryAfter: retryAfter}}}, nil
+	}
 
 	var response *iden3comm.BasicMessage
 
@@ -72,6 +77,10 @@ func (s *Server) AgentV1(ctx context.Context, request AgentV1RequestObject) (Age
 		log.Debug(ctx, "agent bad request", "err", err, "body", *request.Body)
 		return AgentV1400JSONResponse{N400JSONResponse{"cannot proceed with the given request"}}, nil
 	}
+	metrics.AgentMessage(agentMessageType(basicMessage.Type), string(mediatype))
+	if allowed, retryAfter := s.allowHolder(ctx, basicMessage.From, mediatype); !allowed {
+		return AgentV1429JSONResponse{N429JSONResponse{Body: GenericErrorMessage{Message: "too many requests"}, Headers: N429ResponseHeaders{RetryAfter: retryAfter}}}, nil
+	}
 
 	req, err := ports.NewAgentRequest(basicMessage)
 	if err != nil {
@@ -94,3 +103,13 @@ func (s *Server) AgentV1(ctx context.Context, request AgentV1RequestObject) (Age
 		Type: string(agent.Type),
 	}, nil
 }
+
+// agentMessageType returns the type of the message for the metrics. Types not handled by the agent are grouped, so
+// the callers cannot create new series.
+func agentMessageType(messageType iden3comm.ProtocolMessage) string {
+	switch messageType {
+	case protocol.DiscoverFeatureQueriesMessageType, protocol.CredentialFetchRequestMessageType, protocol.RevocationStatusRequestMessageType:
+		return string(messageType)
+	}
+	return metrics.AgentMessageUnsupported
+}
@@ -0,0 +1,277 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// apiKeyHeader is the header of the requests authenticated with an api key
+const apiKeyHeader = "X-API-Key"
+
+// errForbidden is returned when the caller is not allowed to call an operation
+var errForbidden = errors.New("not allowed to call this operation")
+
+// grant is what an authenticated caller is allowed to do. It is implemented by api keys and by the claims of the
+// bearer tokens.
+type grant interface {
+	HasScope(scope domain.APIKeyScope) bool
+	IsRestricted() bool
+	AllowsIdentity(did string) bool
+}
+
+// operationScopes is the scope an api key or bearer token needs to call each secured operation.
+// Operations that are not listed can only be called with basic auth.
+var operationScopes = map[string]domain.APIKeyScope{
+	"GetApiKeys":   domain.APIKeyScopeAPIKeysAdmin,
+	"CreateApiKey": domain.APIKeyScopeAPIKeysAdmin,
+	"DeleteApiKey": domain.APIKeyScopeAPIKeysAdmin,
+	"GetApiKey":    domain.APIKeyScopeAPIKeysAdmin,
+
+	"GetTenants":        domain.APIKeyScopeTenantsAdmin,
+	"CreateTenant":      domain.APIKeyScopeTenantsAdmin,
+	"GetTenant":         domain.APIKeyScopeTenantsAdmin,
+	"UpdateTenant":      domain.APIKeyScopeTenantsAdmin,
+	"AddTenantIdentity": domain.APIKeyScopeTenantsAdmin,
+
+	"GetAuditLogs":    domain.APIKeyScopeAuditRead,
+	"VerifyAuditLogs": domain.APIKeyScopeAuditRead,
+
+	"GetWebhooks":          domain.APIKeyScopeWebhooksRead,
+	"GetWebhook":           domain.APIKeyScopeWebhooksRead,
+	"GetWebhookDeliveries": domain.APIKeyScopeWebhooksRead,
+	"CreateWebhook":        domain.APIKeyScopeWebhooksWrite,
+	"DeleteWebhook":        domain.APIKeyScopeWebhooksWrite,
+
+	"GetIdentities":        domain.APIKeyScopeIdentitiesRead,
+	"GetIdentityDetails":   domain.APIKeyScopeIdentitiesRead,
+	"GetStateStatus":       domain.APIKeyScopeIdentitiesRead,
+	"GetStateTransactions": domain.APIKeyScopeIdentitiesRead,
+	"CreateIdentity":       domain.APIKeyScopeIdentitiesWrite,
+	"UpdateIdentity":       domain.APIKeyScopeIdentitiesWrite,
+	"PublishIdentityState": domain.APIKeyScopeIdentitiesWrite,
+	"RetryPublishState":    domain.APIKeyScopeIdentitiesWrite,
+
+	"GetCredentials":              domain.APIKeyScopeCredentialsRead,
+	"GetCredential":               domain.APIKeyScopeCredentialsRead,
+	"GetCredentialOffer":          domain.APIKeyScopeCredentialsRead,
+	"GetLinks":                    domain.APIKeyScopeCredentialsRead,
+	"GetLink":                     domain.APIKeyScopeCredentialsRead,
+	"CreateCredential":            domain.APIKeyScopeCredentialsWrite,
+	"DeleteCredential":            domain.APIKeyScopeCredentialsWrite,
+	"OfferConnectionsCredentials": domain.APIKeyScopeCredentialsWrite,
+	"CreateLink":                  domain.APIKeyScopeCredentialsWrite,
+	"DeleteLink":                  domain.APIKeyScopeCredentialsWrite,
+	"ActivateLink":                domain.APIKeyScopeCredentialsWrite,
+
+	"RevokeCredential":             domain.APIKeyScopeRevocationsWrite,
+	"RevokeConnectionCredentials":  domain.APIKeyScopeRevocationsWrite,
+	"RevokeConnectionsCredentials": domain.APIKeyScopeRevocationsWrite,
+
+	"GetConnections":              domain.APIKeyScopeConnectionsRead,
+	"GetConnection":               domain.APIKeyScopeConnectionsRead,
+	"GetConnectionMerges":         domain.APIKeyScopeConnectionsRead,
+	"GetConnectionMessages":       domain.APIKeyScopeConnectionsRead,
+	"GetAuthenticationConnection": domain.APIKeyScopeConnectionsRead,
+	"GetAuthenticationSession":    domain.APIKeyScopeConnectionsRead,
+	"CreateConnection":            domain.APIKeyScopeConnectionsWrite,
+	"UpdateConnection":            domain.APIKeyScopeConnectionsWrite,
+	"DeleteConnection":            domain.APIKeyScopeConnectionsWrite,
+	"DeleteConnectionCredentials": domain.APIKeyScopeConnectionsWrite,
+	"MergeConnections":            domain.APIKeyScopeConnectionsWrite,
+	"SendConnectionMessage":       domain.APIKeyScopeConnectionsWrite,
+
+	"GetSchemas":               domain.APIKeyScopeSchemasRead,
+	"GetSchema":                domain.APIKeyScopeSchemasRead,
+	"GetAllDisplayMethods":     domain.APIKeyScopeSchemasRead,
+	"GetDisplayMethod":         domain.APIKeyScopeSchemasRead,
+	"ImportSchema":             domain.APIKeyScopeSchemasWrite,
+	"UpdateSchema":             domain.APIKeyScopeSchemasWrite,
+	"CreateDisplayMethod":      domain.APIKeyScopeSchemasWrite,
+	"UpdateDisplayMethod":      domain.APIKeyScopeSchemasWrite,
+	"DeleteDisplayMethod":      domain.APIKeyScopeSchemasWrite,
+	"GetKeys":                  domain.APIKeyScopeKeysRead,
+	"GetKey":                   domain.APIKeyScopeKeysRead,
+	"GetKeyPolicy":             domain.APIKeyScopeKeysRead,
+	"GetKeyUsages":             domain.APIKeyScopeKeysRead,
+	"CreateKey":                domain.APIKeyScopeKeysAdmin,
+	"UpdateKey":                domain.APIKeyScopeKeysAdmin,
+	"DeleteKey":                domain.APIKeyScopeKeysAdmin,
+	"CreateKeyBackup":          domain.APIKeyScopeKeysAdmin,
+	"UpdateKeyPolicy":          domain.APIKeyScopeKeysAdmin,
+	"DeleteKeyPolicy":          domain.APIKeyScopeKeysAdmin,
+	"CreateAuthCredential":     domain.APIKeyScopeKeysAdmin,
+	"GetPaymentSettings":       domain.APIKeyScopePaymentsRead,
+	"GetPaymentOptions":        domain.APIKeyScopePaymentsRead,
+	"GetPaymentOption":         domain.APIKeyScopePaymentsRead,
+	"GetPaymentRequests":       domain.APIKeyScopePaymentsRead,
+	"GetPaymentRequest":        domain.APIKeyScopePaymentsRead,
+	"CreatePaymentOption":      domain.APIKeyScopePaymentsWrite,
+	"UpdatePaymentOption":      domain.APIKeyScopePaymentsWrite,
+	"DeletePaymentOption":      domain.APIKeyScopePaymentsWrite,
+	"CreatePaymentRequest":     domain.APIKeyScopePaymentsWrite,
+	"DeletePaymentRequest":     domain.APIKeyScopePaymentsWrite,
+	"VerifyPayment":            domain.APIKeyScopePaymentsWrite,
+	"GetProofRequests":         domain.APIKeyScopeProofRequestsRead,
+	"GetProofRequest":          domain.APIKeyScopeProofRequestsRead,
+	"CreateProofRequest":       domain.APIKeyScopeProofRequestsWrite,
+	"GetPendingActions":        domain.APIKeyScopePendingActionsRead,
+	"GetPendingAction":         domain.APIKeyScopePendingActionsRead,
+	"ApprovePendingAction":     domain.APIKeyScopePendingActionsApprove,
+	"RejectPendingAction":      domain.APIKeyScopePendingActionsApprove,
+	"GetSupportedNetworks":     domain.APIKeyScopeConfigRead,
+	"GetPaymentRequestByNonce": domain.APIKeyScopePaymentsRead,
+}
+
+// identityIndependentOperations can be called by callers restricted to some identities because they don't
+// return data of any identity
+var identityIndependentOperations = map[string]bool{
+	"GetSupportedNetworks": true,
+	"GetPaymentSettings":   true,
+}
+
+// tenantOperations can be called by the callers of a tenant because they only act on the identities of the tenant
+var tenantOperations = map[string]bool{
+	"GetIdentities":  true,
+	"CreateIdentity": true,
+}
+
+// authorize checks that the grant has the scope of the operation and can act on the identity of the request.
+// Restricted grants can only call the operations of an identity, apart from the identity independent ones and, for
+// grants of a tenant, the tenant operations.
+func authorize(g grant, tenantID *uuid.UUID, operationID string, request interface{}) error {
+	scope, ok := operationScopes[operationID]
+	if !ok || !g.HasScope(scope) {
+		return fmt.Errorf("%w: %s requires the %s scope", errForbidden, operationID, scope)
+	}
+	if !g.IsRestricted() || identityIndependentOperations[operationID] {
+		return nil
+	}
+	if tenantID != nil && tenantOperations[operationID] {
+		return nil
+	}
+	identifier, ok := requestIdentifier(request)
+	if !ok || !g.AllowsIdentity(identifier) {
+		return fmt.Errorf("%w: the identity is not allowed", errForbidden)
+	}
+	return nil
+}
+
+// requestIdentifier returns the identity path parameter of a strict request object
+func requestIdentifier(request interface{}) (string, bool) {
+	v := reflect.ValueOf(request)
+	if v.Kind() != reflect.Struct {
+		return "", false
+	}
+	field := v.FieldByName("Identifier")
+	if !field.IsValid() {
+		return "", false
+	}
+	switch identifier := field.Interface().(type) {
+	case string:
+		return identifier, true
+	case Identity:
+		if identifier.did() == nil {
+			return "", false
+		}
+		return identifier.did().String(), true
+	}
+	return "", false
+}
+
+// GetApiKeys is the handler for the GET /v2/api-keys endpoint.
+func (s *Server) GetApiKeys(ctx context.Context, _ GetApiKeysRequestObject) (GetApiKeysResponseObject, error) {
+	keys, err := s.apiKeyService.GetAll(ctx)
+	if err != nil {
+		log.Error(ctx, "getting api keys", "err", err)
+		return GetApiKeys500JSONResponse{N500JSONResponse{Message: "There was an error getting the api keys"}}, nil
+	}
+	resp := make(GetApiKeys200JSONResponse, 0, len(keys))
+	for i := range keys {
+		resp = append(resp, apiKeyResponse(&keys[i]))
+	}
+	return resp, nil
+}
+
+// CreateApiKey is the handler for the POST /v2/api-keys endpoint.
+func (s *Server) CreateApiKey(ctx context.Context, request CreateApiKeyRequestObject) (CreateApiKeyResponseObject, error) {
+	req := ports.APIKeyRequest{
+		Name:      request.Body.Name,
+		Scopes:    make([]domain.APIKeyScope, 0, len(request.Body.Scopes)),
+		ExpiresAt: convertKeyExpirationFromRequest(request.Body.ExpiresAt),
+	}
+	for _, scope := range request.Body.Scopes {
+		req.Scopes = append(req.Scopes, domain.APIKeyScope(scope))
+	}
+	if request.Body.Identities != nil {
+		req.Identities = *request.Body.Identities
+	}
+	req.TenantID = request.Body.TenantId
+
+	apiKey, token, err := s.apiKeyService.Create(ctx, req)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidAPIKeyRequest) {
+			return CreateApiKey400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "creating api key", "err", err)
+		return CreateApiKey500JSONResponse{N500JSONResponse{Message: "There was an error creating the api key"}}, nil
+	}
+	return CreateApiKey201JSONResponse{ApiKey: apiKeyResponse(apiKey), Token: token}, nil
+}
+
+// GetApiKey is the handler for the GET /v2/api-keys/{id} endpoint.
+func (s *Server) GetApiKey(ctx context.Context, request GetApiKeyRequestObject) (GetApiKeyResponseObject, error) {
+	apiKey, err := s.apiKeyService.Get(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrAPIKeyNotFound) {
+			return GetApiKey404JSONResponse{N404JSONResponse{Message: "api key not found"}}, nil
+		}
+		log.Error(ctx, "getting api key", "err", err, "id", request.Id)
+		return GetApiKey500JSONResponse{N500JSONResponse{Message: "There was an error getting the api key"}}, nil
+	}
+	return GetApiKey200JSONResponse(apiKeyResponse(apiKey)), nil
+}
+
+// DeleteApiKey is the handler for the DELETE /v2/api-keys/{id} endpoint. It revokes the key.
+func (s *Server) DeleteApiKey(ctx context.Context, request DeleteApiKeyRequestObject) (DeleteApiKeyResponseObject, error) {
+	if err := s.apiKeyService.Revoke(ctx, request.Id); err != nil {
+		if errors.Is(err, services.ErrAPIKeyNotFound) {
+			return DeleteApiKey404JSONResponse{N404JSONResponse{Message: "api key not found"}}, nil
+		}
+		log.Error(ctx, "revoking api key", "err", err, "id", request.Id)
+		return DeleteApiKey500JSONResponse{N500JSONResponse{Message: "There was an error revoking the api key"}}, nil
+	}
+	return DeleteApiKey200JSONResponse{Message: "api key revoked"}, nil
+}
+
+func apiKeyResponse(apiKey *domain.APIKey) ApiKey {
+	scopes := make([]string, 0, len(apiKey.Scopes))
+	for _, scope := range apiKey.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+	identities := apiKey.Identities
+	if identities == nil {
+		identities = []string{}
+	}
+	return ApiKey{
+		Id:         apiKey.ID,
+		Name:       apiKey.Name,
+		Scopes:     scopes,
+		Identities: identities,
+		TenantId:   apiKey.TenantID,
+		CreatedAt:  TimeUTC(apiKey.CreatedAt),
+		ExpiresAt:  convertKeyExpirationToResponse(apiKey.ExpiresAt),
+		RevokedAt:  convertKeyExpirationToResponse(apiKey.RevokedAt),
+		LastUsedAt: convertKeyExpirationToResponse(apiKey.LastUsedAt),
+		Active:     apiKey.IsActive(time.Now()),
+	}
+}
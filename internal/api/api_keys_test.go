@@ -0,0 +1,189 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+)
+
+func TestServer_ApiKeys(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "http://issuer-node", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	otherIden, err := server.Services.identity.Create(ctx, "http://issuer-node", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+
+	var created CreateApiKey201JSONResponse
+	t.Run("create", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			auth     func() (string, string)
+			body     CreateApiKeyRequest
+			httpCode int
+		}{
+			{
+				name:     "no auth header",
+				auth:     authWrong,
+				body:     CreateApiKeyRequest{Name: "ci", Scopes: []string{string(domain.APIKeyScopeCredentialsRead)}},
+				httpCode: http.StatusUnauthorized,
+			},
+			{
+				name:     "should get an error - unknown scope",
+				auth:     authOk,
+				body:     CreateApiKeyRequest{Name: "ci", Scopes: []string{"everything"}},
+				httpCode: http.StatusBadRequest,
+			},
+			{
+				name:     "should get an error - admin scope restricted to identities",
+				auth:     authOk,
+				body:     CreateApiKeyRequest{Name: "ci", Scopes: []string{string(domain.APIKeyScopeAPIKeysAdmin)}, Identities: &[]string{iden.Identifier}},
+				httpCode: http.StatusBadRequest,
+			},
+			{
+				name:     "should create the key",
+				auth:     authOk,
+				body:     CreateApiKeyRequest{Name: "ci", Scopes: []string{string(domain.APIKeyScopeCredentialsRead)}, Identities: &[]string{iden.Identifier}},
+				httpCode: http.StatusCreated,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				rr := httptest.NewRecorder()
+				req, err := http.NewRequest(http.MethodPost, "/v2/api-keys", tests.JSONBody(t, tc.body))
+				require.NoError(t, err)
+				req.SetBasicAuth(tc.auth())
+				handler.ServeHTTP(rr, req)
+				require.Equal(t, tc.httpCode, rr.Code)
+				if tc.httpCode == http.StatusCreated {
+					require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &created))
+					assert.NotEmpty(t, created.Token)
+					assert.Equal(t, "ci", created.ApiKey.Name)
+					assert.Equal(t, []string{iden.Identifier}, created.ApiKey.Identities)
+					assert.True(t, created.ApiKey.Active)
+				}
+			})
+		}
+	})
+	require.NotEmpty(t, created.Token)
+
+	t.Run("should get the key without the token", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/api-keys/%s", created.ApiKey.Id), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response GetApiKey200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, created.ApiKey.Id, response.Id)
+		assert.Equal(t, []string{string(domain.APIKeyScopeCredentialsRead)}, response.Scopes)
+		assert.NotContains(t, rr.Body.String(), created.Token)
+	})
+
+	t.Run("authorization", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			token    string
+			url      string
+			httpCode int
+		}{
+			{
+				name:     "should list the credentials of the allowed identity",
+				token:    created.Token,
+				url:      fmt.Sprintf("/v2/identities/%s/credentials", iden.Identifier),
+				httpCode: http.StatusOK,
+			},
+			{
+				name:     "should not list the credentials of another identity",
+				token:    created.Token,
+				url:      fmt.Sprintf("/v2/identities/%s/credentials", otherIden.Identifier),
+				httpCode: http.StatusForbidden,
+			},
+			{
+				name:     "should not call operations out of its scopes",
+				token:    created.Token,
+				url:      fmt.Sprintf("/v2/identities/%s/connections", iden.Identifier),
+				httpCode: http.StatusForbidden,
+			},
+			{
+				name:     "should not call operations without identity",
+				token:    created.Token,
+				url:      "/v2/identities",
+				httpCode: http.StatusForbidden,
+			},
+			{
+				name:     "should not manage api keys",
+				token:    created.Token,
+				url:      "/v2/api-keys",
+				httpCode: http.StatusForbidden,
+			},
+			{
+				name:     "should reject an invalid key",
+				token:    created.Token + "x",
+				url:      fmt.Sprintf("/v2/identities/%s/credentials", iden.Identifier),
+				httpCode: http.StatusUnauthorized,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				rr := httptest.NewRecorder()
+				req, err := http.NewRequest(http.MethodGet, tc.url, nil)
+				require.NoError(t, err)
+				req.Header.Set(apiKeyHeader, tc.token)
+				handler.ServeHTTP(rr, req)
+				assert.Equal(t, tc.httpCode, rr.Code)
+			})
+		}
+	})
+
+	t.Run("should revoke the key", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/v2/api-keys/%s", created.ApiKey.Id), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		rr = httptest.NewRecorder()
+		req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/credentials", iden.Identifier), nil)
+		require.NoError(t, err)
+		req.Header.Set(apiKeyHeader, created.Token)
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+
+		keys, err := server.Services.apiKeys.GetAll(ctx)
+		require.NoError(t, err)
+		for _, key := range keys {
+			if key.ID == created.ApiKey.Id {
+				assert.NotNil(t, key.RevokedAt)
+			}
+		}
+	})
+
+	t.Run("should get an error - key not found", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/api-keys/%s", uuid.New()), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
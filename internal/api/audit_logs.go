@@ -0,0 +1,213 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/pagination"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	apiErrors "github.com/polygonid/sh-id-platform/internal/errors"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/metrics"
+)
+
+const (
+	anonymousActor              = "anonymous"
+	defaultAuditLogsMaxResults  = 50
+	auditLogsMaxResultsMinValue = 10
+	auditRecordAttempts         = 3
+	auditRecordRetryDelay       = 100 * time.Millisecond
+)
+
+// auditedOperations are recorded in the audit log even if they are public or their security changes, because they
+// decide on the execution of other operations, like the approvals of the pending actions
+var auditedOperations = map[string]bool{
+	"ApprovePendingAction":  true,
+	"RejectPendingAction":   true,
+	"PendingActionCallback": true,
+}
+
+type auditActorContextKey struct{}
+
+// auditActor is filled by the auth middleware, or by the handlers of the public audited operations, with the caller
+// of the audited request and, if it is not in the request, the identity it acted on
+type auditActor struct {
+	name       string
+	identifier *string
+}
+
+// setAuditActor records the caller of the request when it is audited
+func setAuditActor(ctx context.Context, name string) {
+	if actor, ok := ctx.Value(auditActorContextKey{}).(*auditActor); ok {
+		actor.name = name
+	}
+}
+
+// setAuditIdentifier records the identity the request acted on when it is audited
+func setAuditIdentifier(ctx context.Context, identifier string) {
+	if actor, ok := ctx.Value(auditActorContextKey{}).(*auditActor); ok {
+		actor.identifier = &identifier
+	}
+}
+
+// AuditMiddleware returns a middleware that records the secured operations that change data and the audited
+// operations in the audit log, with the caller, the identity, the request ID and the outcome. It must be the
+// outermost middleware, after the auth one in the list, to record the rejected requests too.
+// The entries that can't be recorded are retried and, if they still fail, counted in a metric.
+func AuditMiddleware(ctx context.Context, auditLogService ports.AuditLogService) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			audited := auditedOperations[operationID] ||
+				(ctxReq.Value(BasicAuthScopes) != nil && r.Method != http.MethodGet && r.Method != http.MethodHead)
+			if !audited {
+				return f(ctxReq, w, r, args)
+			}
+			actor := &auditActor{name: anonymousActor}
+			response, err := f(context.WithValue(ctxReq, auditActorContextKey{}, actor), w, r, args)
+
+			identifier := actor.identifier
+			if id, ok := requestIdentifier(args); ok {
+				identifier = &id
+			}
+			entry := domain.NewAuditLog(actor.name, operationID, identifier, r.Method+" "+r.URL.Path, middleware.GetReqID(ctxReq), auditStatusCode(operationID, response, err))
+			recordAuditLog(ctx, auditLogService, entry)
+			return response, err
+		}
+	}
+}
+
+// recordAuditLog records the entry, retrying when the audit log can't be written
+func recordAuditLog(ctx context.Context, auditLogService ports.AuditLogService, entry *domain.AuditLog) {
+	var err error
+	for attempt := 1; attempt <= auditRecordAttempts; attempt++ {
+		if err = auditLogService.Record(ctx, entry); err == nil {
+			return
+		}
+		log.Warn(ctx, "recording audit log", "err", err, "action", entry.Action, "req-id", entry.RequestID, "attempt", attempt)
+		if attempt < auditRecordAttempts {
+			time.Sleep(time.Duration(attempt) * auditRecordRetryDelay)
+		}
+	}
+	metrics.AuditLogRecordFailed()
+	log.Error(ctx, "audit log entry lost", "err", err, "actor", entry.Actor, "action", entry.Action, "req-id", entry.RequestID)
+}
+
+// auditStatusCode returns the http status of the response. The generated response types are named after the
+// operation and the status, like CreateIdentity201JSONResponse.
+func auditStatusCode(operationID string, response interface{}, err error) int {
+	if err != nil {
+		var authErr apiErrors.AuthError
+		var forbiddenErr apiErrors.ForbiddenError
+		var idempotencyErr apiErrors.IdempotencyError
+		switch {
+		case errors.As(err, &authErr):
+			return http.StatusUnauthorized
+		case errors.As(err, &forbiddenErr):
+			return http.StatusForbidden
+		case errors.As(err, &idempotencyErr):
+			return idempotencyErr.Status
+		}
+		return http.StatusInternalServerError
+	}
+	if response == nil {
+		return http.StatusOK
+	}
+	if replay, ok := response.(idempotentReplay); ok {
+		return replay.statusCode
+	}
+	name := strings.TrimPrefix(reflect.TypeOf(response).Name(), operationID)
+	if len(name) < 3 {
+		return http.StatusOK
+	}
+	code, convErr := strconv.Atoi(name[:3])
+	if convErr != nil {
+		return http.StatusOK
+	}
+	return code
+}
+
+// GetAuditLogs is the handler for the GET /v2/audit-logs endpoint.
+func (s *Server) GetAuditLogs(ctx context.Context, request GetAuditLogsRequestObject) (GetAuditLogsResponseObject, error) {
+	if request.Params.Page != nil && *request.Params.Page == 0 {
+		return GetAuditLogs400JSONResponse{N400JSONResponse{Message: "page must be greater than 0"}}, nil
+	}
+	maxResults := uint(defaultAuditLogsMaxResults)
+	if request.Params.MaxResults != nil {
+		maxResults = max(*request.Params.MaxResults, auditLogsMaxResultsMinValue)
+	}
+	filter := &ports.AuditLogFilter{
+		Actor:      request.Params.Actor,
+		Action:     request.Params.Action,
+		Identifier: request.Params.Identifier,
+		From:       request.Params.From,
+		To:         request.Params.To,
+		Pagination: *pagination.NewFilter(&maxResults, request.Params.Page),
+	}
+	if request.Params.Outcome != nil {
+		filter.Outcome = common.ToPointer(domain.AuditOutcome(*request.Params.Outcome))
+	}
+
+	entries, total, err := s.auditLogService.Find(ctx, filter)
+	if err != nil {
+		log.Error(ctx, "getting audit logs", "err", err)
+		return GetAuditLogs500JSONResponse{N500JSONResponse{Message: "There was an error getting the audit logs"}}, nil
+	}
+	resp := AuditLogsPaginated{
+		Items: make([]AuditLog, 0, len(entries)),
+		Meta:  PaginatedMetadata{MaxResults: maxResults, Page: 1, Total: total},
+	}
+	if request.Params.Page != nil {
+		resp.Meta.Page = *request.Params.Page
+	}
+	for i := range entries {
+		resp.Items = append(resp.Items, auditLogResponse(&entries[i]))
+	}
+	return GetAuditLogs200JSONResponse(resp), nil
+}
+
+// VerifyAuditLogs is the handler for the GET /v2/audit-logs/verify endpoint.
+func (s *Server) VerifyAuditLogs(ctx context.Context, _ VerifyAuditLogsRequestObject) (VerifyAuditLogsResponseObject, error) {
+	verification, err := s.auditLogService.Verify(ctx)
+	if err != nil {
+		log.Error(ctx, "verifying audit logs", "err", err)
+		return VerifyAuditLogs500JSONResponse{N500JSONResponse{Message: "There was an error verifying the audit logs"}}, nil
+	}
+	if !verification.Valid {
+		log.Warn(ctx, "audit log chain is broken", "sequence", *verification.BrokenAt)
+	}
+	return VerifyAuditLogs200JSONResponse{
+		Valid:    verification.Valid,
+		Entries:  verification.Entries,
+		BrokenAt: verification.BrokenAt,
+	}, nil
+}
+
+func auditLogResponse(entry *domain.AuditLog) AuditLog {
+	resp := AuditLog{
+		Id:         entry.ID,
+		Sequence:   entry.Sequence,
+		Actor:      entry.Actor,
+		Action:     entry.Action,
+		Identifier: entry.Identifier,
+		Resource:   entry.Resource,
+		RequestId:  entry.RequestID,
+		StatusCode: entry.StatusCode,
+		Outcome:    AuditLogOutcome(entry.Outcome),
+		CreatedAt:  TimeUTC(entry.CreatedAt),
+		Hash:       hex.EncodeToString(entry.Hash),
+	}
+	if entry.PreviousHash != nil {
+		resp.PreviousHash = common.ToPointer(hex.EncodeToString(entry.PreviousHash))
+	}
+	return resp
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+)
+
+func TestServer_AuditLogs(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "http://issuer-node", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+
+	apiKey, token, err := server.Services.apiKeys.Create(ctx, ports.APIKeyRequest{
+		Name:       "audited",
+		Scopes:     []domain.APIKeyScope{domain.APIKeyScopeConnectionsRead},
+		Identities: []string{iden.Identifier},
+	})
+	require.NoError(t, err)
+
+	t.Run("should record the actions", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/schemas", iden.Identifier), tests.JSONBody(t, ImportSchemaRequest{}))
+		require.NoError(t, err)
+		req.Header.Set(apiKeyHeader, token)
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusForbidden, rr.Code)
+
+		rr = httptest.NewRecorder()
+		req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/connections", iden.Identifier), nil)
+		require.NoError(t, err)
+		req.Header.Set(apiKeyHeader, token)
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should export the actions of the identity", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/v2/audit-logs?identifier="+url.QueryEscape(iden.Identifier), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response GetAuditLogs200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.Items, 1)
+		entry := response.Items[0]
+		assert.Equal(t, "apikey:"+apiKey.ID.String(), entry.Actor)
+		assert.Equal(t, "ImportSchema", entry.Action)
+		assert.Equal(t, http.StatusForbidden, entry.StatusCode)
+		assert.Equal(t, AuditLogOutcomeFailure, entry.Outcome)
+		assert.NotEmpty(t, entry.Hash)
+		assert.Equal(t, uint(1), response.Meta.Total)
+	})
+
+	t.Run("should verify the chain", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/v2/audit-logs/verify", nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response VerifyAuditLogs200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.True(t, response.Valid)
+		assert.Nil(t, response.BrokenAt)
+		assert.GreaterOrEqual(t, response.Entries, 1)
+	})
+
+	t.Run("should not export without the audit scope", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/v2/audit-logs", nil)
+		require.NoError(t, err)
+		req.Header.Set(apiKeyHeader, token)
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
+	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/log"
 )
@@ -19,7 +20,13 @@ func (s *Server) AuthCallback(ctx context.Context, request AuthCallbackRequestOb
 
 	_, err := s.identityService.Authenticate(ctx, *request.Body, request.Params.SessionID, s.cfg.ServerUrl)
 	if err != nil {
-		log.Error(ctx, "error authenticating", err.Error())
+		log.Error(ctx, "error authenticating", "err", err, "sessionID", request.Params.SessionID)
+		switch {
+		case errors.Is(err, services.ErrAuthSessionNotFound):
+			return AuthCallback404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrAuthSessionExpired), errors.Is(err, services.ErrAuthSessionAlreadyAuthenticated):
+			return AuthCallback400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
 		return AuthCallback500JSONResponse{}, nil
 	}
 
@@ -33,7 +40,7 @@ func (s *Server) Authentication(ctx context.Context, req AuthenticationRequestOb
 		log.Error(ctx, "parsing issuer did", "err", err)
 		return Authentication400JSONResponse{N400JSONResponse{"Invalid issuer did"}}, nil
 	}
-	resp, err := s.identityService.CreateAuthenticationQRCode(ctx, s.cfg.ServerUrl, *did)
+	resp, err := s.identityService.CreateAuthenticationQRCode(ctx, s.cfg.ServerUrl, *did, s.cfg.AuthSession.TTL)
 	if err != nil {
 		log.Error(ctx, "creating qr code", "err", err)
 		return Authentication500JSONResponse{N500JSONResponse{"Unexpected error while creating qr code"}}, nil
@@ -47,11 +54,13 @@ func (s *Server) Authentication(ctx context.Context, req AuthenticationRequestOb
 		return Authentication200JSONResponse{
 			Message:   string(body),
 			SessionID: resp.SessionID.String(),
+			ExpiresAt: common.ToPointer(TimeUTC(resp.ExpiresAt)),
 		}, nil
 	}
 	return Authentication200JSONResponse{
 		Message:   resp.QRCodeURL,
 		SessionID: resp.SessionID.String(),
+		ExpiresAt: common.ToPointer(TimeUTC(resp.ExpiresAt)),
 	}, nil
 }
 
@@ -76,3 +85,23 @@ func (s *Server) GetAuthenticationConnection(ctx context.Context, req GetAuthent
 		},
 	}, nil
 }
+
+// GetAuthenticationSession returns the status of an authentication session
+func (s *Server) GetAuthenticationSession(ctx context.Context, req GetAuthenticationSessionRequestObject) (GetAuthenticationSessionResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(req.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", req.Identifier)
+		return GetAuthenticationSession400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	session, err := s.identityService.GetAuthSession(ctx, *issuerDID, req.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrAuthSessionNotFound) {
+			return GetAuthenticationSession404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "get authentication session", "err", err, "id", req.Id)
+		return GetAuthenticationSession500JSONResponse{N500JSONResponse{"Unexpected error while getting authentication session"}}, nil
+	}
+
+	return GetAuthenticationSession200JSONResponse(authenticationSessionResponse(session)), nil
+}
@@ -35,8 +35,14 @@ func TestServer_AuthCallback(t *testing.T) {
 		name      string
 		expected  expected
 		sessionID *uuid.UUID
+		body      string
 	}
 
+	ctx := context.Background()
+	expiredSession := domain.NewAuthSession(uuid.New(), protocol.AuthorizationRequestMessage{From: "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ"}, time.Minute)
+	expiredSession.ExpiresAt = time.Now().Add(-time.Second)
+	require.NoError(t, server.Repos.sessions.Set(ctx, expiredSession.ID.String(), expiredSession))
+
 	for _, tc := range []testConfig{
 		{
 			name:      "should get an error no body",
@@ -46,6 +52,24 @@ func TestServer_AuthCallback(t *testing.T) {
 				message:  "Cannot proceed with empty body",
 			},
 		},
+		{
+			name:      "should get an error with a non existing session",
+			sessionID: common.ToPointer(uuid.New()),
+			body:      "jwz-token",
+			expected: expected{
+				httpCode: http.StatusNotFound,
+				message:  services.ErrAuthSessionNotFound.Error(),
+			},
+		},
+		{
+			name:      "should get an error with an expired session",
+			sessionID: &expiredSession.ID,
+			body:      "jwz-token",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  services.ErrAuthSessionExpired.Error(),
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			rr := httptest.NewRecorder()
@@ -54,7 +78,7 @@ func TestServer_AuthCallback(t *testing.T) {
 				url += "?sessionID=" + tc.sessionID.String()
 			}
 
-			req, err := http.NewRequest("POST", url, strings.NewReader(``))
+			req, err := http.NewRequest("POST", url, strings.NewReader(tc.body))
 			require.NoError(t, err)
 
 			handler.ServeHTTP(rr, req)
@@ -65,11 +89,116 @@ func TestServer_AuthCallback(t *testing.T) {
 				var response AuthCallback400JSONResponse
 				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
 				assert.Equal(t, tc.expected.message, response.Message)
+			case http.StatusNotFound:
+				var response AuthCallback404JSONResponse
+				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
 			default:
 				t.Fail()
 			}
 		})
 	}
+
+	session, err := server.Repos.sessions.Get(ctx, expiredSession.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, domain.AuthSessionStatusExpired, session.Status)
+	assert.NotNil(t, session.CompletedAt)
+}
+
+func TestServer_GetAuthenticationSession(t *testing.T) {
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+
+	qrCode, err := server.Services.identity.CreateAuthenticationQRCode(ctx, cfg.ServerUrl, *issuerDID, time.Minute)
+	require.NoError(t, err)
+
+	failedSession := domain.NewAuthSession(uuid.New(), protocol.AuthorizationRequestMessage{From: issuerDID.String()}, time.Minute)
+	failedSession.Failed("proof is not valid")
+	require.NoError(t, server.Repos.sessions.Set(ctx, failedSession.ID.String(), failedSession))
+
+	otherSession := domain.NewAuthSession(uuid.New(), protocol.AuthorizationRequestMessage{From: "did:polygonid:polygon:mumbai:2qKDJmySKNi4GD4vYdqfLb37MSTSijg77NoRZaKfDX"}, time.Minute)
+	require.NoError(t, server.Repos.sessions.Set(ctx, otherSession.ID.String(), otherSession))
+
+	type expected struct {
+		httpCode      int
+		status        domain.AuthSessionStatus
+		failureReason *string
+	}
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		id       uuid.UUID
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name: "Not authorized",
+			auth: authWrong,
+			id:   uuid.New(),
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name: "Session not found",
+			auth: authOk,
+			id:   uuid.New(),
+			expected: expected{
+				httpCode: http.StatusNotFound,
+			},
+		},
+		{
+			name: "Session of another identity",
+			auth: authOk,
+			id:   otherSession.ID,
+			expected: expected{
+				httpCode: http.StatusNotFound,
+			},
+		},
+		{
+			name: "Pending session",
+			auth: authOk,
+			id:   qrCode.SessionID,
+			expected: expected{
+				httpCode: http.StatusOK,
+				status:   domain.AuthSessionStatusPending,
+			},
+		},
+		{
+			name: "Failed session with the failure reason",
+			auth: authOk,
+			id:   failedSession.ID,
+			expected: expected{
+				httpCode:      http.StatusOK,
+				status:        domain.AuthSessionStatusFailed,
+				failureReason: common.ToPointer("proof is not valid"),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/authentication/sessions/%s/status", issuerDID, tc.id), nil)
+			req.SetBasicAuth(tc.auth())
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			if tc.expected.httpCode == http.StatusOK {
+				var response GetAuthenticationSession200JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.id.String(), response.Id)
+				assert.Equal(t, issuerDID.String(), response.IssuerID)
+				assert.Equal(t, string(tc.expected.status), response.Status)
+				assert.Equal(t, tc.expected.failureReason, response.FailureReason)
+				assert.True(t, time.Time(response.ExpiresAt).After(time.Now()))
+			}
+		})
+	}
 }
 
 func TestServer_GetAuthenticationConnection(t *testing.T) {
@@ -264,6 +393,8 @@ func TestServer_AuthQRCode(t *testing.T) {
 				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
 				require.NotEmpty(t, resp.Message)
 				require.NotEmpty(t, resp.SessionID)
+				require.NotNil(t, resp.ExpiresAt)
+				assert.True(t, time.Time(*resp.ExpiresAt).After(time.Now()))
 
 				realQR := protocol.AuthorizationRequestMessage{}
 				if tc.expected.qrWithLink {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"math"
 	"strings"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	jsonSuite "github.com/iden3/go-schema-processor/v2/json"
 	"github.com/iden3/go-schema-processor/verifiable"
 
+	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
@@ -116,6 +118,24 @@ func (s *Server) DeleteConnection(ctx context.Context, request DeleteConnectionR
 		return DeleteConnection400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
 	}
 	req := ports.NewDeleteRequest(request.Id, request.Params.DeleteCredentials, request.Params.RevokeCredentials)
+	if req.RevokeCredentials && s.pendingActionService.IsRequired(domain.PendingActionTypeDeleteConnection, math.MaxInt) {
+		nonces, err := s.connectionRevocationNonces(ctx, *issuerDID, req.ConnID)
+		if err != nil {
+			if errors.Is(err, services.ErrConnectionDoesNotExist) {
+				return DeleteConnection400JSONResponse{N400JSONResponse{"The given connection does not exist"}}, nil
+			}
+			log.Error(ctx, "delete connection, getting credentials", "err", err, "req", request.Id.String())
+			return DeleteConnection500JSONResponse{N500JSONResponse{"There was an error revoking the credentials of the given connection"}}, nil
+		}
+		payload := domain.DeleteConnectionPayload{ConnectionID: req.ConnID, DeleteCredentials: req.DeleteCredentials, Nonces: nonces}
+		held, err := s.holdAction(ctx, *issuerDID, domain.PendingActionTypeDeleteConnection, len(nonces), payload)
+		if err != nil {
+			return DeleteConnection500JSONResponse{N500JSONResponse{"There was an error revoking the credentials of the given connection"}}, nil
+		}
+		if held != nil {
+			return *held, nil
+		}
+	}
 	if req.RevokeCredentials {
 		err := s.claimService.RevokeAllFromConnection(ctx, req.ConnID, *issuerDID)
 		if err != nil {
@@ -153,20 +173,37 @@ func (s *Server) GetConnection(ctx context.Context, request GetConnectionRequest
 		return GetConnection500JSONResponse{N500JSONResponse{"There was an error retrieving the connection"}}, nil
 	}
 
-	filter := &ports.ClaimsFilter{
-		Subject: conn.UserDID.String(),
-	}
-	credentials, _, err := s.claimService.GetAll(ctx, *issuerDID, filter)
-	if err != nil && !errors.Is(err, services.ErrCredentialNotFound) {
-		log.Debug(ctx, "get connection internal server error retrieving credentials", "err", err, "req", request)
+	mergedUserDIDs, err := s.connectionMergeService.GetMergedUserDIDs(ctx, *issuerDID, conn.ID)
+	if err != nil {
+		log.Debug(ctx, "get connection internal server error retrieving merged identities", "err", err, "req", request)
 		return GetConnection500JSONResponse{N500JSONResponse{"There was an error retrieving the connection"}}, nil
 	}
 
+	// the credentials issued to the identities merged into the connection are part of it too
+	var credentials []*domain.Claim
+	for _, userDID := range append([]w3c.DID{conn.UserDID}, mergedUserDIDs...) {
+		filter := &ports.ClaimsFilter{
+			Subject: userDID.String(),
+		}
+		userCredentials, _, err := s.claimService.GetAll(ctx, *issuerDID, filter)
+		if err != nil && !errors.Is(err, services.ErrCredentialNotFound) {
+			log.Debug(ctx, "get connection internal server error retrieving credentials", "err", err, "req", request)
+			return GetConnection500JSONResponse{N500JSONResponse{"There was an error retrieving the connection"}}, nil
+		}
+		credentials = append(credentials, userCredentials...)
+	}
+
+	mergedUserIDs := make([]string, len(mergedUserDIDs))
+	for i := range mergedUserDIDs {
+		mergedUserIDs[i] = mergedUserDIDs[i].String()
+	}
+
 	resp, err := connectionResponse(conn, credentials)
 	if err != nil {
 		log.Error(ctx, "get connection internal server error converting credentials to w3c", "err", err)
 		return GetConnection500JSONResponse{N500JSONResponse{"There was an error parsing the credential of the given connection"}}, nil
 	}
+	resp.MergedUserIDs = &mergedUserIDs
 
 	return GetConnection200JSONResponse(resp), nil
 }
@@ -193,6 +230,25 @@ func (s *Server) RevokeConnectionCredentials(ctx context.Context, request Revoke
 		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
 		return RevokeConnectionCredentials400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
 	}
+
+	if s.pendingActionService.IsRequired(domain.PendingActionTypeRevokeCredentials, math.MaxInt) {
+		nonces, err := s.connectionRevocationNonces(ctx, *issuerDID, request.Id)
+		if err != nil {
+			if errors.Is(err, services.ErrConnectionDoesNotExist) {
+				return RevokeConnectionCredentials400JSONResponse{N400JSONResponse{"The given connection does not exist"}}, nil
+			}
+			log.Error(ctx, "revoke connection credentials, getting credentials", "err", err, "req", request)
+			return RevokeConnectionCredentials500JSONResponse{N500JSONResponse{"There was an error revoking the credentials of the given connection"}}, nil
+		}
+		held, err := s.holdAction(ctx, *issuerDID, domain.PendingActionTypeRevokeCredentials, len(nonces), domain.RevokeCredentialsPayload{Nonces: nonces})
+		if err != nil {
+			return RevokeConnectionCredentials500JSONResponse{N500JSONResponse{"There was an error revoking the credentials of the given connection"}}, nil
+		}
+		if held != nil {
+			return *held, nil
+		}
+	}
+
 	if err := s.claimService.RevokeAllFromConnection(ctx, request.Id, *issuerDID); err != nil {
 		log.Error(ctx, "revoke connection credentials", "err", err, "req", request)
 		return RevokeConnectionCredentials500JSONResponse{N500JSONResponse{"There was an error revoking the credentials of the given connection"}}, nil
@@ -201,6 +257,343 @@ func (s *Server) RevokeConnectionCredentials(ctx context.Context, request Revoke
 	return RevokeConnectionCredentials202JSONResponse{Message: "Credentials revocation request sent"}, nil
 }
 
+// UpdateConnection updates the issuer defined metadata of a connection
+func (s *Server) UpdateConnection(ctx context.Context, request UpdateConnectionRequestObject) (UpdateConnectionResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return UpdateConnection400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	req := &ports.UpdateConnectionRequest{
+		Tags:  request.Body.Tags,
+		Notes: request.Body.Notes,
+	}
+	if err := s.connectionsService.Update(ctx, request.Id, *issuerDID, req); err != nil {
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return UpdateConnection404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+		}
+		log.Error(ctx, "update connection", "err", err, "req", request.Id.String())
+		return UpdateConnection500JSONResponse{N500JSONResponse{"There was an error updating the connection"}}, nil
+	}
+
+	return UpdateConnection200JSONResponse{Message: "Connection successfully updated"}, nil
+}
+
+// GetConnectionMessages returns the messages sent to a connection
+func (s *Server) GetConnectionMessages(ctx context.Context, request GetConnectionMessagesRequestObject) (GetConnectionMessagesResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetConnectionMessages400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	messages, err := s.connectionsService.GetMessages(ctx, request.Id, *issuerDID)
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return GetConnectionMessages404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+		}
+		log.Error(ctx, "get connection messages", "err", err, "req", request.Id.String())
+		return GetConnectionMessages500JSONResponse{N500JSONResponse{"There was an error retrieving the connection messages"}}, nil
+	}
+
+	resp := make(GetConnectionMessages200JSONResponse, 0, len(messages))
+	for i := range messages {
+		resp = append(resp, connectionMessageResponse(&messages[i]))
+	}
+
+	return resp, nil
+}
+
+// SendConnectionMessage sends an iden3comm message to a connection
+func (s *Server) SendConnectionMessage(ctx context.Context, request SendConnectionMessageRequestObject) (SendConnectionMessageResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return SendConnectionMessage400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	req := &ports.SendConnectionMessageRequest{
+		Type: request.Body.Type,
+	}
+	if request.Body.ThreadID != nil {
+		req.ThreadID = *request.Body.ThreadID
+	}
+	if request.Body.Body != nil {
+		req.Body, err = json.Marshal(request.Body.Body)
+		if err != nil {
+			return SendConnectionMessage400JSONResponse{N400JSONResponse{Message: "invalid body"}}, nil
+		}
+	}
+
+	message, err := s.connectionsService.SendMessage(ctx, request.Id, *issuerDID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrConnectionDoesNotExist):
+			return SendConnectionMessage404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+		case errors.Is(err, services.ErrInvalidConnectionMessage), errors.Is(err, services.ErrConnectionWithoutPushService):
+			return SendConnectionMessage400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "send connection message", "err", err, "req", request.Id.String())
+		return SendConnectionMessage500JSONResponse{N500JSONResponse{"There was an error sending the message"}}, nil
+	}
+
+	return SendConnectionMessage201JSONResponse(connectionMessageResponse(message)), nil
+}
+
+// GetConnectionMerges returns the merges of a connection
+func (s *Server) GetConnectionMerges(ctx context.Context, request GetConnectionMergesRequestObject) (GetConnectionMergesResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetConnectionMerges400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	merges, err := s.connectionMergeService.GetByConnectionID(ctx, *issuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return GetConnectionMerges404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+		}
+		log.Error(ctx, "get connection merges", "err", err, "req", request.Id.String())
+		return GetConnectionMerges500JSONResponse{N500JSONResponse{"There was an error retrieving the connection merges"}}, nil
+	}
+
+	resp := make(GetConnectionMerges200JSONResponse, 0, len(merges))
+	for i := range merges {
+		resp = append(resp, connectionMergeResponse(&merges[i]))
+	}
+
+	return resp, nil
+}
+
+// MergeConnections merges another holder identity into a connection
+func (s *Server) MergeConnections(ctx context.Context, request MergeConnectionsRequestObject) (MergeConnectionsResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return MergeConnections400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	req := &ports.MergeConnectionsRequest{
+		ConnectionID:       request.Body.ConnectionID,
+		Reason:             request.Body.Reason,
+		ReissueCredentials: request.Body.ReissueCredentials != nil && *request.Body.ReissueCredentials,
+	}
+
+	merge, err := s.connectionMergeService.Merge(ctx, *issuerDID, request.Id, req, s.cfg.ServerUrl)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrConnectionDoesNotExist):
+			return MergeConnections404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+		case errors.Is(err, services.ErrInvalidConnectionMerge):
+			return MergeConnections400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrConnectionMergeAlreadyExists):
+			return MergeConnections409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "merge connections", "err", err, "req", request.Id.String())
+		return MergeConnections500JSONResponse{N500JSONResponse{"There was an error merging the connections"}}, nil
+	}
+
+	return MergeConnections201JSONResponse(connectionMergeResponse(merge)), nil
+}
+
+// ConnectionMergeCallback receives the holder answer to a connection merge request
+func (s *Server) ConnectionMergeCallback(ctx context.Context, request ConnectionMergeCallbackRequestObject) (ConnectionMergeCallbackResponseObject, error) {
+	if request.Body == nil || *request.Body == "" {
+		log.Debug(ctx, "empty request body connection-merge-callback request")
+		return ConnectionMergeCallback400JSONResponse{N400JSONResponse{"Cannot proceed with empty body"}}, nil
+	}
+
+	if _, err := s.connectionMergeService.Verify(ctx, request.Params.Id, *request.Body); err != nil {
+		switch {
+		case errors.Is(err, services.ErrConnectionMergeDoesNotExist):
+			return ConnectionMergeCallback404JSONResponse{N404JSONResponse{"The given connection merge does not exist"}}, nil
+		case errors.Is(err, services.ErrConnectionMergeAlreadyProcessed), errors.Is(err, services.ErrConnectionMergeVerificationFailed):
+			return ConnectionMergeCallback400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrConnectionMergeAlreadyExists):
+			return ConnectionMergeCallback409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "verifying connection merge", "err", err, "id", request.Params.Id)
+		return ConnectionMergeCallback500JSONResponse{N500JSONResponse{"There was an error merging the connections"}}, nil
+	}
+
+	return ConnectionMergeCallback200Response{}, nil
+}
+
+// RevokeConnectionsCredentials revokes the non revoked credentials of all the connections matching the filter
+func (s *Server) RevokeConnectionsCredentials(ctx context.Context, request RevokeConnectionsCredentialsRequestObject) (RevokeConnectionsCredentialsResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return RevokeConnectionsCredentials400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	if isEmptyConnectionsFilter(request.Body) {
+		return RevokeConnectionsCredentials400JSONResponse{N400JSONResponse{Message: "at least one filter must be provided"}}, nil
+	}
+
+	nConns, credentials, err := s.getConnectionsCredentials(ctx, *issuerDID, request.Body)
+	if err != nil {
+		log.Error(ctx, "revoke connections credentials, getting credentials", "err", err)
+		return RevokeConnectionsCredentials500JSONResponse{N500JSONResponse{"There was an error retrieving the credentials of the connections"}}, nil
+	}
+
+	nonces := make([]uint64, 0)
+	for _, connCredentials := range credentials {
+		for _, credential := range connCredentials {
+			nonces = append(nonces, uint64(credential.RevNonce))
+		}
+	}
+	held, err := s.holdAction(ctx, *issuerDID, domain.PendingActionTypeRevokeCredentials, len(nonces), domain.RevokeCredentialsPayload{Nonces: nonces})
+	if err != nil {
+		return RevokeConnectionsCredentials500JSONResponse{N500JSONResponse{"There was an error revoking the credentials of the connections"}}, nil
+	}
+	if held != nil {
+		return *held, nil
+	}
+
+	resp := ConnectionsBulkActionResponse{Connections: nConns, Failed: []ConnectionsBulkActionFailure{}}
+	for _, connCredentials := range credentials {
+		for _, credential := range connCredentials {
+			if err := s.claimService.Revoke(ctx, *issuerDID, uint64(credential.RevNonce), ""); err != nil {
+				log.Error(ctx, "revoke connections credentials", "err", err, "credential", credential.ID)
+				resp.Failed = append(resp.Failed, ConnectionsBulkActionFailure{CredentialID: credential.ID, Message: "There was an error revoking the credential"})
+				continue
+			}
+			resp.Credentials++
+		}
+	}
+
+	return RevokeConnectionsCredentials202JSONResponse(resp), nil
+}
+
+// OfferConnectionsCredentials sends an offer of the non revoked credentials of all the connections matching the filter
+func (s *Server) OfferConnectionsCredentials(ctx context.Context, request OfferConnectionsCredentialsRequestObject) (OfferConnectionsCredentialsResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return OfferConnectionsCredentials400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	if isEmptyConnectionsFilter(request.Body) {
+		return OfferConnectionsCredentials400JSONResponse{N400JSONResponse{Message: "at least one filter must be provided"}}, nil
+	}
+
+	nConns, credentials, err := s.getConnectionsCredentials(ctx, *issuerDID, request.Body)
+	if err != nil {
+		log.Error(ctx, "offer connections credentials, getting credentials", "err", err)
+		return OfferConnectionsCredentials500JSONResponse{N500JSONResponse{"There was an error retrieving the credentials of the connections"}}, nil
+	}
+
+	resp := ConnectionsBulkActionResponse{Connections: nConns, Failed: []ConnectionsBulkActionFailure{}}
+	for _, connCredentials := range credentials {
+		ids := make([]uuid.UUID, len(connCredentials))
+		for i := range connCredentials {
+			ids[i] = connCredentials[i].ID
+		}
+		if err := s.claimService.SendCredentialOffer(ctx, *issuerDID, ids); err != nil {
+			log.Error(ctx, "offer connections credentials", "err", err, "subject", connCredentials[0].OtherIdentifier)
+			for _, id := range ids {
+				resp.Failed = append(resp.Failed, ConnectionsBulkActionFailure{CredentialID: id, Message: "There was an error sending the credential offer"})
+			}
+			continue
+		}
+		resp.Credentials += len(ids)
+	}
+
+	return OfferConnectionsCredentials202JSONResponse(resp), nil
+}
+
+// getConnectionsCredentials returns the number of connections matching the filter and, for each of them,
+// the non revoked credentials that match the credential part of the filter. All the matching connections are read,
+// a page at a time, with one credentials query per page.
+func (s *Server) getConnectionsCredentials(ctx context.Context, issuerDID w3c.DID, body *ConnectionsFilter) (int, [][]*domain.Claim, error) {
+	credentialsFilter := &ports.ConnectionCredentialsFilter{
+		Revoked: body.CredentialRevoked,
+	}
+	if body.CredentialSchemaType != nil {
+		credentialsFilter.SchemaType = *body.CredentialSchemaType
+	}
+	if body.CredentialAttributes != nil {
+		credentialsFilter.Attributes = *body.CredentialAttributes
+	}
+
+	nConns := 0
+	resp := make([][]*domain.Claim, 0)
+	for page := uint(1); ; page++ {
+		filter := ports.NewGetAllRequest(nil, body.Query, common.ToPointer(page), nil, sqltools.OrderByFilters{})
+		if body.Tags != nil {
+			filter.Tags = *body.Tags
+		}
+		filter.CredentialsFilter = credentialsFilter
+
+		conns, total, err := s.connectionsService.GetAllByIssuerID(ctx, issuerDID, filter)
+		if err != nil {
+			return 0, nil, err
+		}
+		if len(conns) == 0 {
+			break
+		}
+		nConns += len(conns)
+
+		subjects := make([]string, len(conns))
+		for i := range conns {
+			subjects[i] = conns[i].UserDID.String()
+		}
+		credentials, _, err := s.claimService.GetAll(ctx, issuerDID, &ports.ClaimsFilter{
+			Subjects:   subjects,
+			SchemaType: credentialsFilter.SchemaType,
+			Attributes: credentialsFilter.Attributes,
+			Revoked:    common.ToPointer(false),
+		})
+		if err != nil && !errors.Is(err, services.ErrCredentialNotFound) {
+			return 0, nil, err
+		}
+		resp = append(resp, groupCredentialsBySubject(credentials)...)
+
+		if uint(nConns) >= total {
+			break
+		}
+	}
+	return nConns, resp, nil
+}
+
+// groupCredentialsBySubject groups the credentials by their subject, keeping their order
+func groupCredentialsBySubject(credentials []*domain.Claim) [][]*domain.Claim {
+	groups := make([][]*domain.Claim, 0)
+	index := make(map[string]int)
+	for _, credential := range credentials {
+		i, ok := index[credential.OtherIdentifier]
+		if !ok {
+			i = len(groups)
+			index[credential.OtherIdentifier] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], credential)
+	}
+	return groups
+}
+
+// connectionRevocationNonces returns the revocation nonces of the non revoked credentials of the given connection
+func (s *Server) connectionRevocationNonces(ctx context.Context, issuerDID w3c.DID, connectionID uuid.UUID) ([]uint64, error) {
+	conn, err := s.connectionsService.GetByIDAndIssuerID(ctx, connectionID, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, _, err := s.claimService.GetAll(ctx, issuerDID, &ports.ClaimsFilter{
+		Subject: conn.UserDID.String(),
+		Revoked: common.ToPointer(false),
+	})
+	if err != nil && !errors.Is(err, services.ErrCredentialNotFound) {
+		return nil, err
+	}
+
+	nonces := make([]uint64, 0, len(credentials))
+	for _, credential := range credentials {
+		nonces = append(nonces, uint64(credential.RevNonce))
+	}
+	return nonces, nil
+}
+
 func getConnectionsFilter(req GetConnectionsRequestObject) (*ports.NewGetAllConnectionsRequest, error) {
 	if req.Params.Page != nil && *req.Params.Page <= 0 {
 		return nil, errors.New("page must be greater than 0")
@@ -223,7 +616,37 @@ func getConnectionsFilter(req GetConnectionsRequestObject) (*ports.NewGetAllConn
 			}
 		}
 	}
-	return ports.NewGetAllRequest(req.Params.Credentials, req.Params.Query, req.Params.Page, req.Params.MaxResults, orderBy), nil
+	filter := ports.NewGetAllRequest(req.Params.Credentials, req.Params.Query, req.Params.Page, req.Params.MaxResults, orderBy)
+	if req.Params.Tags != nil {
+		filter.Tags = *req.Params.Tags
+	}
+	credentialsFilter := &ports.ConnectionCredentialsFilter{Revoked: req.Params.CredentialRevoked}
+	if req.Params.CredentialSchemaType != nil {
+		credentialsFilter.SchemaType = *req.Params.CredentialSchemaType
+	}
+	if req.Params.CredentialAttributes != nil {
+		credentialsFilter.Attributes = make(map[string]string, len(*req.Params.CredentialAttributes))
+		for _, attr := range *req.Params.CredentialAttributes {
+			field, value, found := strings.Cut(attr, ":")
+			if !found || strings.TrimSpace(field) == "" {
+				return nil, errors.New("wrong credentialAttributes value, expected field:value")
+			}
+			credentialsFilter.Attributes[strings.TrimSpace(field)] = value
+		}
+	}
+	if !credentialsFilter.IsEmpty() {
+		filter.CredentialsFilter = credentialsFilter
+	}
+	return filter, nil
+}
+
+// isEmptyConnectionsFilter returns true if the filter has no conditions, which would select all the connections.
+func isEmptyConnectionsFilter(filter *ConnectionsFilter) bool {
+	return (filter.Query == nil || strings.TrimSpace(*filter.Query) == "") &&
+		(filter.Tags == nil || len(*filter.Tags) == 0) &&
+		(filter.CredentialSchemaType == nil || *filter.CredentialSchemaType == "") &&
+		(filter.CredentialAttributes == nil || len(*filter.CredentialAttributes) == 0) &&
+		filter.CredentialRevoked == nil
 }
 
 func checkJSONIsNotNull(message []byte) bool {
@@ -14,12 +14,15 @@ import (
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-schema-processor/verifiable"
+	"github.com/iden3/iden3comm/v2/protocol"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 )
 
@@ -530,6 +533,145 @@ func TestServer_RevokeConnectionCredentials(t *testing.T) {
 	}
 }
 
+func TestServer_ConnectionsCredentialsBulkActions(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	issuerDID, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	fixture := repositories.NewFixture(storage)
+	tag := uuid.NewString()
+	for i, user := range []string{
+		"did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5",
+		"did:polygonid:polygon:mumbai:2qFVUasb8QZ1XAmD71b3NA8bzQhGs92VQEPgELYnpk",
+	} {
+		userDID, err := w3c.ParseDID(user)
+		require.NoError(t, err)
+		fixture.CreateConnection(t, &domain.Connection{
+			ID:         uuid.New(),
+			IssuerDID:  *issuerDID,
+			UserDID:    *userDID,
+			CreatedAt:  time.Now(),
+			ModifiedAt: time.Now(),
+			Tags:       []string{tag},
+		})
+		_ = fixture.CreateClaim(t, &domain.Claim{
+			ID:              uuid.New(),
+			Identifier:      common.ToPointer(issuerDID.String()),
+			Issuer:          issuerDID.String(),
+			SchemaHash:      "ca938857241db9451ea329256b9c06e5",
+			SchemaURL:       "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/kyc-v3.json-ld",
+			SchemaType:      "KYCAgeCredential",
+			OtherIdentifier: userDID.String(),
+			RevNonce:        domain.RevNonceUint64(uint64(1000 + i)),
+			CoreClaim:       domain.CoreClaim{},
+		})
+	}
+
+	type expected struct {
+		httpCode    int
+		connections int
+		credentials int
+	}
+
+	type testConfig struct {
+		name     string
+		action   string
+		auth     func() (string, string)
+		body     ConnectionsFilter
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name:     "No auth header",
+			action:   "offer",
+			auth:     authWrong,
+			body:     ConnectionsFilter{Tags: &[]string{tag}},
+			expected: expected{httpCode: http.StatusUnauthorized},
+		},
+		{
+			name:     "should reject an empty filter",
+			action:   "revoke",
+			auth:     authOk,
+			expected: expected{httpCode: http.StatusBadRequest},
+		},
+		{
+			name:     "should not match other tags",
+			action:   "offer",
+			auth:     authOk,
+			body:     ConnectionsFilter{Tags: &[]string{uuid.NewString()}},
+			expected: expected{httpCode: http.StatusAccepted},
+		},
+		{
+			name:     "should offer the credentials of all the matching connections",
+			action:   "offer",
+			auth:     authOk,
+			body:     ConnectionsFilter{Tags: &[]string{tag}},
+			expected: expected{httpCode: http.StatusAccepted, connections: 2, credentials: 2},
+		},
+		{
+			name:     "should revoke the credentials of all the matching connections",
+			action:   "revoke",
+			auth:     authOk,
+			body:     ConnectionsFilter{Tags: &[]string{tag}, CredentialSchemaType: common.ToPointer("KYCAgeCredential")},
+			expected: expected{httpCode: http.StatusAccepted, connections: 2, credentials: 2},
+		},
+		{
+			name:     "should not revoke the revoked credentials again",
+			action:   "revoke",
+			auth:     authOk,
+			body:     ConnectionsFilter{Tags: &[]string{tag}},
+			expected: expected{httpCode: http.StatusAccepted, connections: 2},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			body, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+			url := fmt.Sprintf("/v2/identities/%s/connections/credentials/%s", issuerDID, tc.action)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.SetBasicAuth(tc.auth())
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			if tc.expected.httpCode == http.StatusAccepted {
+				var response ConnectionsBulkActionResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.connections, response.Connections)
+				assert.Equal(t, tc.expected.credentials, response.Credentials)
+				assert.Empty(t, response.Failed)
+			}
+		})
+	}
+}
+
+func TestGroupCredentialsBySubject(t *testing.T) {
+	credentials := []*domain.Claim{
+		{ID: uuid.New(), OtherIdentifier: "did:a"},
+		{ID: uuid.New(), OtherIdentifier: "did:b"},
+		{ID: uuid.New(), OtherIdentifier: "did:a"},
+	}
+	groups := groupCredentialsBySubject(credentials)
+	require.Len(t, groups, 2)
+	assert.Equal(t, []*domain.Claim{credentials[0], credentials[2]}, groups[0])
+	assert.Equal(t, []*domain.Claim{credentials[1]}, groups[1])
+	assert.Empty(t, groupCredentialsBySubject(nil))
+}
+
 func TestServer_GetConnectionsDefaultSort(t *testing.T) {
 	const (
 		method     = "polygonid"
@@ -715,3 +857,438 @@ func createConnections(t *testing.T, issuerDID *w3c.DID, fixture *repositories.F
 	}
 	return connections
 }
+
+func TestServer_UpdateConnection(t *testing.T) {
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	fixture := repositories.NewFixture(storage)
+
+	issuerDID, err := w3c.ParseDID("did:iden3:polygon:mumbai:wzokvZ6kMoocKJuSbftdZxTD6qvayGpJb3m4FVXth")
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5")
+	require.NoError(t, err)
+
+	conn := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	type expected struct {
+		httpCode int
+		tags     []string
+		notes    *string
+	}
+
+	type testConfig struct {
+		name     string
+		connID   uuid.UUID
+		auth     func() (string, string)
+		body     UpdateConnectionRequest
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name: "No auth header",
+			auth: authWrong,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:   "non existing connection",
+			connID: uuid.New(),
+			auth:   authOk,
+			body:   UpdateConnectionRequest{Tags: &[]string{"gold"}},
+			expected: expected{
+				httpCode: http.StatusNotFound,
+			},
+		},
+		{
+			name:   "should set tags and notes, removing duplicates",
+			connID: conn,
+			auth:   authOk,
+			body:   UpdateConnectionRequest{Tags: &[]string{"gold", " gold ", "newsletter", ""}, Notes: common.ToPointer("some notes")},
+			expected: expected{
+				httpCode: http.StatusOK,
+				tags:     []string{"gold", "newsletter"},
+				notes:    common.ToPointer("some notes"),
+			},
+		},
+		{
+			name:   "should keep the tags if only notes are updated",
+			connID: conn,
+			auth:   authOk,
+			body:   UpdateConnectionRequest{Notes: common.ToPointer("other notes")},
+			expected: expected{
+				httpCode: http.StatusOK,
+				tags:     []string{"gold", "newsletter"},
+				notes:    common.ToPointer("other notes"),
+			},
+		},
+		{
+			name:   "should clear tags and notes",
+			connID: conn,
+			auth:   authOk,
+			body:   UpdateConnectionRequest{Tags: &[]string{}, Notes: common.ToPointer("")},
+			expected: expected{
+				httpCode: http.StatusOK,
+				tags:     []string{},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v2/identities/%s/connections/%s", issuerDID, tc.connID.String())
+			req, err := http.NewRequest(http.MethodPatch, url, tests.JSONBody(t, tc.body))
+			req.SetBasicAuth(tc.auth())
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			if tc.expected.httpCode == http.StatusOK {
+				connection, err := server.connectionsService.GetByIDAndIssuerID(ctx, tc.connID, *issuerDID)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected.tags, connection.Tags)
+				assert.Equal(t, tc.expected.notes, connection.Notes)
+			}
+		})
+	}
+}
+
+func TestServer_SendConnectionMessage(t *testing.T) {
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	fixture := repositories.NewFixture(storage)
+
+	issuerDID, err := w3c.ParseDID("did:iden3:polygon:mumbai:wzokvZ6kMoocKJuSbftdZxTD6qvayGpJb3m4FVXth")
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qHgCmGW1wDH5ShTH94SssR4eN8XW4xyHLfop2Qoqm")
+	require.NoError(t, err)
+	userWithoutPushDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5")
+	require.NoError(t, err)
+
+	conn := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDID,
+		IssuerDoc:  nil,
+		UserDoc:    json.RawMessage(`{"id": "did:polygonid:polygon:mumbai:2qHgCmGW1wDH5ShTH94SssR4eN8XW4xyHLfop2Qoqm", "service": [{"id": "did:polygonid:polygon:mumbai:2qHgCmGW1wDH5ShTH94SssR4eN8XW4xyHLfop2Qoqm#push", "type": "push-notification", "metadata": {"devices": [{"alg": "RSA-OAEP-512", "ciphertext": "someToken"}]}, "serviceEndpoint": "https://someURL.com/api/v1"}], "@context": ["https://www.w3.org/ns/did/v1"]}`),
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+	connWithoutPush := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  *issuerDID,
+		UserDID:    *userWithoutPushDID,
+		UserDoc:    json.RawMessage(`{"id": "did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5", "@context": ["https://www.w3.org/ns/did/v1"]}`),
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	type expected struct {
+		httpCode int
+		events   int
+	}
+
+	type testConfig struct {
+		name     string
+		connID   uuid.UUID
+		auth     func() (string, string)
+		body     SendConnectionMessageRequest
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name: "No auth header",
+			auth: authWrong,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:   "non existing connection",
+			connID: uuid.New(),
+			auth:   authOk,
+			body:   SendConnectionMessageRequest{Type: string(protocol.ProofGenerationRequestMessageType)},
+			expected: expected{
+				httpCode: http.StatusNotFound,
+			},
+		},
+		{
+			name:   "empty message type",
+			connID: conn,
+			auth:   authOk,
+			body:   SendConnectionMessageRequest{Type: " "},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:   "connection without push service",
+			connID: connWithoutPush,
+			auth:   authOk,
+			body:   SendConnectionMessageRequest{Type: string(protocol.ProofGenerationRequestMessageType)},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:   "should store the message as pending and publish the event",
+			connID: conn,
+			auth:   authOk,
+			body: SendConnectionMessageRequest{
+				Type:     string(protocol.ProofGenerationRequestMessageType),
+				ThreadID: common.ToPointer("some-thread"),
+				Body:     &map[string]interface{}{"reason": "please verify again"},
+			},
+			expected: expected{
+				httpCode: http.StatusCreated,
+				events:   1,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			server.Infra.pubSub.Clear(event.ConnectionMessageEvent)
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v2/identities/%s/connections/%s/messages", issuerDID, tc.connID.String())
+			req, err := http.NewRequest(http.MethodPost, url, tests.JSONBody(t, tc.body))
+			req.SetBasicAuth(tc.auth())
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			assert.Equal(t, tc.expected.events, len(server.Infra.pubSub.AllPublishedEvents(event.ConnectionMessageEvent)))
+			if tc.expected.httpCode == http.StatusCreated {
+				var response SendConnectionMessage201JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.connID.String(), response.ConnectionID)
+				assert.Equal(t, tc.body.Type, response.Type)
+				assert.Equal(t, "some-thread", response.ThreadID)
+				assert.Equal(t, string(domain.ConnectionMessageStatusPending), response.Status)
+				assert.Equal(t, "please verify again", response.Body["reason"])
+
+				rr = httptest.NewRecorder()
+				req, err = http.NewRequest(http.MethodGet, url, nil)
+				req.SetBasicAuth(authOk())
+				require.NoError(t, err)
+				handler.ServeHTTP(rr, req)
+				require.Equal(t, http.StatusOK, rr.Code)
+
+				var messages GetConnectionMessages200JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &messages))
+				require.NotEmpty(t, messages)
+				assert.Equal(t, response.Id, messages[0].Id)
+			}
+		})
+	}
+}
+
+func TestServer_MergeConnections(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	issuerDID, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	fixture := repositories.NewFixture(storage)
+
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5")
+	require.NoError(t, err)
+	duplicatedDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qNytPv6dKKhfqopjBdXJU1vSVb3Lbgcidved32R64")
+	require.NoError(t, err)
+
+	conn := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+	duplicated := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  *issuerDID,
+		UserDID:    *duplicatedDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	require.NoError(t, server.Repos.connection.UpdateTagsAndNotes(ctx, storage.Pgx, &domain.Connection{ID: conn, IssuerDID: *issuerDID, Tags: []string{"gold"}, ModifiedAt: time.Now()}))
+	require.NoError(t, server.Repos.connection.UpdateTagsAndNotes(ctx, storage.Pgx, &domain.Connection{ID: duplicated, IssuerDID: *issuerDID, Tags: []string{"gold", "newsletter"}, ModifiedAt: time.Now()}))
+
+	type expected struct {
+		httpCode int
+		status   string
+		method   string
+		qrCode   bool
+	}
+
+	type testConfig struct {
+		name     string
+		connID   uuid.UUID
+		auth     func() (string, string)
+		body     MergeConnectionsRequest
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name: "No auth header",
+			auth: authWrong,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:   "non existing connection",
+			connID: uuid.New(),
+			auth:   authOk,
+			body:   MergeConnectionsRequest{ConnectionID: &duplicated},
+			expected: expected{
+				httpCode: http.StatusNotFound,
+			},
+		},
+		{
+			name:   "non existing connection to merge",
+			connID: conn,
+			auth:   authOk,
+			body:   MergeConnectionsRequest{ConnectionID: common.ToPointer(uuid.New())},
+			expected: expected{
+				httpCode: http.StatusNotFound,
+			},
+		},
+		{
+			name:   "merge a connection into itself",
+			connID: conn,
+			auth:   authOk,
+			body:   MergeConnectionsRequest{ConnectionID: &conn},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:   "should create a pending merge with a QR code for the holder",
+			connID: conn,
+			auth:   authOk,
+			body:   MergeConnectionsRequest{Reason: common.ToPointer("wallet reinstalled")},
+			expected: expected{
+				httpCode: http.StatusCreated,
+				status:   string(domain.ConnectionMergeStatusPending),
+				method:   string(domain.ConnectionMergeMethodProof),
+				qrCode:   true,
+			},
+		},
+		{
+			name:   "should merge the duplicated connection",
+			connID: conn,
+			auth:   authOk,
+			body:   MergeConnectionsRequest{ConnectionID: &duplicated, Reason: common.ToPointer("same member")},
+			expected: expected{
+				httpCode: http.StatusCreated,
+				status:   string(domain.ConnectionMergeStatusCompleted),
+				method:   string(domain.ConnectionMergeMethodAdmin),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v2/identities/%s/connections/%s/merges", issuerDID, tc.connID.String())
+			req, err := http.NewRequest(http.MethodPost, url, tests.JSONBody(t, tc.body))
+			req.SetBasicAuth(tc.auth())
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			if tc.expected.httpCode != http.StatusCreated {
+				return
+			}
+			var response MergeConnections201JSONResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			assert.Equal(t, tc.connID.String(), response.ConnectionID)
+			assert.Equal(t, tc.expected.status, response.Status)
+			assert.Equal(t, tc.expected.method, response.Method)
+			assert.Equal(t, tc.expected.qrCode, response.QrCode != nil)
+			assert.Equal(t, tc.body.Reason, response.Reason)
+		})
+	}
+
+	t.Run("should move the merged connection into the connection", func(t *testing.T) {
+		_, err := server.Repos.connection.GetByIDAndIssuerID(ctx, storage.Pgx, duplicated, *issuerDID)
+		assert.ErrorIs(t, err, repositories.ErrConnectionDoesNotExist)
+
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/connections/%s", issuerDID, conn.String()), nil)
+		req.SetBasicAuth(authOk())
+		require.NoError(t, err)
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response GetConnection200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.NotNil(t, response.MergedUserIDs)
+		assert.Equal(t, []string{duplicatedDID.String()}, *response.MergedUserIDs)
+		assert.ElementsMatch(t, []string{"gold", "newsletter"}, response.Tags)
+
+		rr = httptest.NewRecorder()
+		req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/connections/%s/merges", issuerDID, conn.String()), nil)
+		req.SetBasicAuth(authOk())
+		require.NoError(t, err)
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var merges GetConnectionMerges200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &merges))
+		require.Len(t, merges, 2)
+		assert.Equal(t, duplicated.String(), *merges[0].MergedConnectionID)
+		assert.Equal(t, duplicatedDID.String(), *merges[0].MergedUserID)
+	})
+
+	t.Run("should not merge the same identity again", func(t *testing.T) {
+		reconnected := fixture.CreateConnection(t, &domain.Connection{
+			ID:         uuid.New(),
+			IssuerDID:  *issuerDID,
+			UserDID:    *duplicatedDID,
+			CreatedAt:  time.Now(),
+			ModifiedAt: time.Now(),
+		})
+
+		rr := httptest.NewRecorder()
+		url := fmt.Sprintf("/v2/identities/%s/connections/%s/merges", issuerDID, conn.String())
+		req, err := http.NewRequest(http.MethodPost, url, tests.JSONBody(t, MergeConnectionsRequest{ConnectionID: &reconnected}))
+		req.SetBasicAuth(authOk())
+		require.NoError(t, err)
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusConflict, rr.Code)
+
+		_, err = server.Repos.connection.GetByIDAndIssuerID(ctx, storage.Pgx, reconnected, *issuerDID)
+		assert.NoError(t, err, "the connection is not merged")
+	})
+
+	t.Run("callback for a non existing merge", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/connections/merges/callback?id=%s", uuid.New()), bytes.NewBufferString("jwz-token"))
+		require.NoError(t, err)
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -102,6 +102,9 @@ func (s *Server) CreateCredential(ctx context.Context, request CreateCredentialR
 
 	resp, err := s.claimService.Save(ctx, req)
 	if err != nil {
+		if errors.Is(err, services.ErrTenantQuotaExceeded) {
+			return CreateCredential403JSONResponse{N403JSONResponse{Message: err.Error()}}, nil
+		}
 		if errors.Is(err, services.ErrLoadingSchema) {
 			return CreateCredential422JSONResponse{N422JSONResponse{Message: err.Error()}}, nil
 		}
@@ -141,6 +144,14 @@ func (s *Server) RevokeCredential(ctx context.Context, request RevokeCredentialR
 		return RevokeCredential400JSONResponse{N400JSONResponse{err.Error()}}, nil
 	}
 
+	held, err := s.holdAction(ctx, *did, domain.PendingActionTypeRevokeCredentials, 1, domain.RevokeCredentialsPayload{Nonces: []uint64{uint64(request.Nonce)}})
+	if err != nil {
+		return RevokeCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	if held != nil {
+		return *held, nil
+	}
+
 	if err := s.claimService.Revoke(ctx, *did, uint64(request.Nonce), ""); err != nil {
 		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
 			return RevokeCredential404JSONResponse{N404JSONResponse{
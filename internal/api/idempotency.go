@@ -0,0 +1,220 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	apiErrors "github.com/polygonid/sh-id-platform/internal/errors"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	// IdempotencyKeyHeader is the header with the idempotency key of a request
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set in the responses replayed from a previous request with the same key
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	idempotencyKeyMaxLength = 255
+	idempotencyKeyAttempts  = 3
+	idempotencyKeyRetryWait = 100 * time.Millisecond
+)
+
+// idempotentResponseWriters render the responses of the idempotent operations
+var idempotentResponseWriters = map[string]func(w http.ResponseWriter, response interface{}) (bool, error){
+	"CreateCredential": func(w http.ResponseWriter, response interface{}) (bool, error) {
+		r, ok := response.(CreateCredentialResponseObject)
+		if !ok {
+			return false, nil
+		}
+		return true, r.VisitCreateCredentialResponse(w)
+	},
+	"CreateLink": func(w http.ResponseWriter, response interface{}) (bool, error) {
+		r, ok := response.(CreateLinkResponseObject)
+		if !ok {
+			return false, nil
+		}
+		return true, r.VisitCreateLinkResponse(w)
+	},
+	"CreatePaymentRequest": func(w http.ResponseWriter, response interface{}) (bool, error) {
+		r, ok := response.(CreatePaymentRequestResponseObject)
+		if !ok {
+			return false, nil
+		}
+		return true, r.VisitCreatePaymentRequestResponse(w)
+	},
+	"PublishIdentityState": func(w http.ResponseWriter, response interface{}) (bool, error) {
+		r, ok := response.(PublishIdentityStateResponseObject)
+		if !ok {
+			return false, nil
+		}
+		return true, r.VisitPublishIdentityStateResponse(w)
+	},
+}
+
+// IdempotencyMiddleware returns a middleware that makes the retries of the mutating operations safe. When a request
+// comes with an Idempotency-Key header, its response is stored and a retry with the same key and the same request
+// gets the stored response instead of running the operation again. A key reused with a different request is answered
+// with a 422, and a key whose request is still in progress with a 409. Failed requests release the key, and so do the
+// answers that can change if the request is retried, like a 409, a 429 or an exceeded quota, see storableStatus.
+func IdempotencyMiddleware(_ context.Context, service ports.IdempotencyKeyService) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		writeResponse, ok := idempotentResponseWriters[operationID]
+		if !ok || service == nil {
+			return f
+		}
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" {
+				return f(ctxReq, w, r, args)
+			}
+			if len(key) > idempotencyKeyMaxLength {
+				return nil, apiErrors.IdempotencyError{Status: http.StatusBadRequest, Err: errors.New("the idempotency key is too long")}
+			}
+			identifier, ok := requestIdentifier(args)
+			if !ok {
+				return f(ctxReq, w, r, args)
+			}
+			request, err := json.Marshal(args)
+			if err != nil {
+				log.Error(ctxReq, "encoding idempotent request", "err", err, "operation", operationID)
+				return nil, err
+			}
+
+			idempotencyKey, err := service.Begin(ctxReq, identifier, operationID, key, request)
+			switch {
+			case errors.Is(err, services.ErrIdempotencyKeyReused):
+				return nil, apiErrors.IdempotencyError{Status: http.StatusUnprocessableEntity, Err: err}
+			case errors.Is(err, services.ErrIdempotencyKeyInProgress):
+				return nil, apiErrors.IdempotencyError{Status: http.StatusConflict, Err: err}
+			case err != nil:
+				return nil, err
+			}
+			if idempotencyKey.Completed() {
+				log.Info(ctxReq, "replaying idempotent response", "operation", operationID, "key", key)
+				return newIdempotentReplay(idempotencyKey), nil
+			}
+
+			response, err := f(ctxReq, w, r, args)
+			if err != nil {
+				releaseIdempotencyKey(ctxReq, service, idempotencyKey)
+				return response, err
+			}
+			recorder := newResponseRecorder()
+			rendered, renderErr := writeResponse(recorder, response)
+			if !rendered || renderErr != nil || !storableStatus(recorder.statusCode) {
+				releaseIdempotencyKey(ctxReq, service, idempotencyKey)
+				return response, nil
+			}
+			// The operation already ran, so the response is returned even if it can't be stored. The retries get a 409
+			// until the lock of the key expires.
+			if err := retryIdempotencyKey(func() error {
+				return service.Complete(ctxReq, idempotencyKey, recorder.statusCode, recorder.body.Bytes())
+			}); err != nil {
+				log.Error(ctxReq, "storing idempotent response", "err", err, "operation", operationID, "key", key)
+			}
+			return response, nil
+		}
+	}
+}
+
+// storableStatus tells whether a response is the final answer to the request. The successful responses and the
+// invalid requests are, but a conflict, a rate limit, a missing resource or a forbidden request, like an exceeded
+// quota, can change and the key is released so the request can be retried with it.
+func storableStatus(statusCode int) bool {
+	return statusCode < http.StatusBadRequest ||
+		statusCode == http.StatusBadRequest ||
+		statusCode == http.StatusUnprocessableEntity
+}
+
+// releaseIdempotencyKey releases the key of a request that can be retried. A key that can't be released stays locked
+// until its lock expires.
+func releaseIdempotencyKey(ctx context.Context, service ports.IdempotencyKeyService, key *domain.IdempotencyKey) {
+	if err := retryIdempotencyKey(func() error { return service.Release(ctx, key) }); err != nil {
+		log.Error(ctx, "releasing idempotency key, it stays locked", "err", err, "key", key.Key)
+	}
+}
+
+func retryIdempotencyKey(f func() error) error {
+	var err error
+	for attempt := 1; attempt <= idempotencyKeyAttempts; attempt++ {
+		if err = f(); err == nil {
+			return nil
+		}
+		if attempt < idempotencyKeyAttempts {
+			time.Sleep(time.Duration(attempt) * idempotencyKeyRetryWait)
+		}
+	}
+	return err
+}
+
+// idempotentReplay is the stored response of a previous request with the same idempotency key
+type idempotentReplay struct {
+	statusCode int
+	body       []byte
+}
+
+func newIdempotentReplay(key *domain.IdempotencyKey) idempotentReplay {
+	statusCode := http.StatusOK
+	if key.StatusCode != nil {
+		statusCode = *key.StatusCode
+	}
+	return idempotentReplay{statusCode: statusCode, body: key.Response}
+}
+
+func (response idempotentReplay) visit(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(response.statusCode)
+	_, err := w.Write(response.body)
+	return err
+}
+
+// VisitCreateCredentialResponse writes the stored response
+func (response idempotentReplay) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+// VisitCreateLinkResponse writes the stored response
+func (response idempotentReplay) VisitCreateLinkResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+// VisitCreatePaymentRequestResponse writes the stored response
+func (response idempotentReplay) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+// VisitPublishIdentityStateResponse writes the stored response
+func (response idempotentReplay) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+// responseRecorder keeps the status and the body of a rendered response
+type responseRecorder struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+func newResponseRecorder() *responseRecorder {
+	return &responseRecorder{header: make(http.Header), statusCode: http.StatusOK}
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/errors"
+)
+
+// memoryIdempotencyKeys is an in memory idempotency key service for the middleware tests. The first completeErrors
+// calls to Complete fail.
+type memoryIdempotencyKeys struct {
+	mu             sync.Mutex
+	keys           map[string]*domain.IdempotencyKey
+	completeErrors int
+}
+
+func (m *memoryIdempotencyKeys) Begin(_ context.Context, identifier, operation, key string, request []byte) (*domain.IdempotencyKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	newKey := domain.NewIdempotencyKey(identifier, operation, key, request, time.Minute)
+	existing, ok := m.keys[identifier+operation+key]
+	if !ok {
+		m.keys[identifier+operation+key] = newKey
+		return newKey, nil
+	}
+	if existing.RequestHash != newKey.RequestHash {
+		return nil, services.ErrIdempotencyKeyReused
+	}
+	if !existing.Completed() {
+		return nil, services.ErrIdempotencyKeyInProgress
+	}
+	return existing, nil
+}
+
+func (m *memoryIdempotencyKeys) Complete(_ context.Context, key *domain.IdempotencyKey, statusCode int, response []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.completeErrors > 0 {
+		m.completeErrors--
+		return stderrors.New("database unavailable")
+	}
+	key.Complete(statusCode, response, time.Hour)
+	return nil
+}
+
+func (m *memoryIdempotencyKeys) Release(_ context.Context, key *domain.IdempotencyKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.keys, key.Identifier+key.Operation+key.Key)
+	return nil
+}
+
+func (m *memoryIdempotencyKeys) DeleteExpired(context.Context) (int64, error) {
+	return 0, nil
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	ctx := context.Background()
+	keys := &memoryIdempotencyKeys{keys: make(map[string]*domain.IdempotencyKey)}
+	middleware := IdempotencyMiddleware(ctx, keys)
+	const did = "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"
+
+	calls := 0
+	var response interface{} = CreateCredential201JSONResponse{Id: "credential-1"}
+	handler := middleware(func(_ context.Context, _ http.ResponseWriter, _ *http.Request, _ interface{}) (interface{}, error) {
+		calls++
+		return response, nil
+	}, "CreateCredential")
+
+	call := func(key string, body map[string]interface{}) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/", nil)
+		if key != "" {
+			r.Header.Set(IdempotencyKeyHeader, key)
+		}
+		rr := httptest.NewRecorder()
+		res, err := handler(ctx, rr, r, CreateCredentialRequestObject{Identifier: did, Body: &CreateCredentialJSONRequestBody{CredentialSubject: body}})
+		if err != nil {
+			errors.ResponseErrorHandlerFunc(rr, r, err)
+			return rr
+		}
+		visitor, ok := res.(CreateCredentialResponseObject)
+		require.True(t, ok)
+		require.NoError(t, visitor.VisitCreateCredentialResponse(rr))
+		return rr
+	}
+
+	t.Run("should run the requests without key", func(t *testing.T) {
+		assert.Equal(t, http.StatusCreated, call("", map[string]interface{}{"a": 1}).Code)
+		assert.Equal(t, http.StatusCreated, call("", map[string]interface{}{"a": 1}).Code)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should replay the response of a key", func(t *testing.T) {
+		calls = 0
+		first := call("key-1", map[string]interface{}{"a": 1})
+		require.Equal(t, http.StatusCreated, first.Code)
+		assert.Empty(t, first.Header().Get(IdempotentReplayedHeader))
+
+		response = CreateCredential201JSONResponse{Id: "credential-2"}
+		retry := call("key-1", map[string]interface{}{"a": 1})
+		require.Equal(t, http.StatusCreated, retry.Code)
+		assert.Equal(t, "true", retry.Header().Get(IdempotentReplayedHeader))
+		assert.JSONEq(t, first.Body.String(), retry.Body.String())
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("should reject a key reused with a different request", func(t *testing.T) {
+		rr := call("key-1", map[string]interface{}{"a": 2})
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	t.Run("should release the key of a failed request", func(t *testing.T) {
+		calls = 0
+		response = CreateCredential500JSONResponse{N500JSONResponse{Message: "error"}}
+		assert.Equal(t, http.StatusInternalServerError, call("key-2", map[string]interface{}{"a": 1}).Code)
+		response = CreateCredential201JSONResponse{Id: "credential-3"}
+		rr := call("key-2", map[string]interface{}{"a": 1})
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Empty(t, rr.Header().Get(IdempotentReplayedHeader))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should release the key of an answer that can change", func(t *testing.T) {
+		calls = 0
+		response = CreateCredential403JSONResponse{N403JSONResponse{Message: "credentials quota exceeded"}}
+		assert.Equal(t, http.StatusForbidden, call("key-3", map[string]interface{}{"a": 1}).Code)
+		response = CreateCredential201JSONResponse{Id: "credential-4"}
+		rr := call("key-3", map[string]interface{}{"a": 1})
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Empty(t, rr.Header().Get(IdempotentReplayedHeader))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should retry storing the response", func(t *testing.T) {
+		calls = 0
+		keys.completeErrors = 2
+		response = CreateCredential201JSONResponse{Id: "credential-5"}
+		assert.Equal(t, http.StatusCreated, call("key-4", map[string]interface{}{"a": 1}).Code)
+		rr := call("key-4", map[string]interface{}{"a": 1})
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Equal(t, "true", rr.Header().Get(IdempotentReplayedHeader))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("should reject too long keys", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, call(strings.Repeat("k", 256), map[string]interface{}{"a": 1}).Code)
+	})
+}
@@ -57,6 +57,26 @@ func (s *Server) CreateIdentity(ctx context.Context, request CreateIdentityReque
 		return CreateIdentity400JSONResponse{N400JSONResponse{Message: fmt.Sprintf("error getting reverse hash service settings: %s", err.Error())}}, nil
 	}
 
+	tenantID := request.Body.TenantId
+	if callerTenantID := tenantFromContext(ctx); callerTenantID != nil {
+		if tenantID != nil && *tenantID != *callerTenantID {
+			return CreateIdentity403JSONResponse{N403JSONResponse{Message: "the identity can only be assigned to the tenant of the caller"}}, nil
+		}
+		tenantID = callerTenantID
+	}
+	if tenantID != nil {
+		if err := s.tenantService.CheckIdentityQuota(ctx, *tenantID); err != nil {
+			switch {
+			case errors.Is(err, services.ErrTenantQuotaExceeded):
+				return CreateIdentity403JSONResponse{N403JSONResponse{Message: err.Error()}}, nil
+			case errors.Is(err, services.ErrTenantNotFound):
+				return CreateIdentity400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			}
+			log.Error(ctx, "checking the identities quota", "err", err, "tenant", tenantID)
+			return CreateIdentity500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+		}
+	}
+
 	if !s.networkResolver.IsCredentialStatusTypeSupported(rhsSettings.Mode, *credentialStatusType) {
 		log.Warn(ctx, "unsupported credential status type", "req", request)
 		return CreateIdentity400JSONResponse{N400JSONResponse{Message: fmt.Sprintf("Credential Status Type '%s' is not supported by the issuer", *credentialStatusType)}}, nil
@@ -69,6 +89,7 @@ func (s *Server) CreateIdentity(ctx context.Context, request CreateIdentityReque
 		KeyType:              kms.KeyType(keyType),
 		AuthCredentialStatus: *credentialStatusType,
 		DisplayName:          request.Body.DisplayName,
+		TenantID:             tenantID,
 	})
 	if err != nil {
 		if errors.Is(err, services.ErrWrongDIDMetada) {
@@ -93,6 +114,9 @@ func (s *Server) CreateIdentity(ctx context.Context, request CreateIdentityReque
 				},
 			}, nil
 		}
+		if errors.Is(err, services.ErrTenantQuotaExceeded) {
+			return CreateIdentity403JSONResponse{N403JSONResponse{Message: err.Error()}}, nil
+		}
 		if errors.Is(err, services.ErrIdentityDisplayNameDuplicated) {
 			return CreateIdentity409JSONResponse{
 				N409JSONResponse{
@@ -186,7 +210,7 @@ func (s *Server) UpdateIdentity(ctx context.Context, request UpdateIdentityReque
 func (s *Server) GetIdentities(ctx context.Context, request GetIdentitiesRequestObject) (GetIdentitiesResponseObject, error) {
 	var err error
 	var response GetIdentities200JSONResponse
-	identities, err := s.identityService.Get(ctx)
+	identities, err := s.identityService.Get(ctx, tenantFromContext(ctx))
 	if err != nil {
 		return GetIdentities500JSONResponse{N500JSONResponse{
 			Message: err.Error(),
@@ -413,3 +437,27 @@ func (s *Server) CreateAuthCredential(ctx context.Context, request CreateAuthCre
 		Id: autCredentialID,
 	}, nil
 }
+
+// GetDIDDocument is the controller to get the DID document of an identity
+func (s *Server) GetDIDDocument(ctx context.Context, request GetDIDDocumentRequestObject) (GetDIDDocumentResponseObject, error) {
+	if _, err := s.identityService.GetByDID(ctx, *request.Identifier.did()); err != nil {
+		if errors.Is(err, repositories.ErrIdentityNotFound) {
+			return GetDIDDocument404JSONResponse{N404JSONResponse{Message: "identity not found"}}, nil
+		}
+		log.Error(ctx, "get did document. Getting identity", "err", err)
+		return GetDIDDocument500JSONResponse{N500JSONResponse{Message: "there was an error getting the did document"}}, nil
+	}
+
+	didDoc, err := s.identityService.GetDIDDocument(ctx, *request.Identifier.did(), s.cfg.ServerUrl)
+	if err != nil {
+		log.Error(ctx, "get did document", "err", err)
+		return GetDIDDocument500JSONResponse{N500JSONResponse{Message: "there was an error getting the did document"}}, nil
+	}
+
+	response, err := toDIDDocument(didDoc)
+	if err != nil {
+		log.Error(ctx, "get did document. Converting document", "err", err)
+		return GetDIDDocument500JSONResponse{N500JSONResponse{Message: "there was an error getting the did document"}}, nil
+	}
+	return GetDIDDocument200JSONResponse(response), nil
+}
@@ -524,7 +524,7 @@ func TestServer_CreateAuthCredential(t *testing.T) {
 	})
 
 	t.Run("should create an auth credential with default values", func(t *testing.T) {
-		key, err := server.keyService.Create(ctx, issuerDID, kms.KeyTypeBabyJubJub, uuid.New().String())
+		key, err := server.keyService.Create(ctx, issuerDID, kms.KeyTypeBabyJubJub, uuid.New().String(), nil)
 		require.NoError(t, err)
 
 		body := CreateAuthCredentialRequest{
@@ -543,7 +543,7 @@ func TestServer_CreateAuthCredential(t *testing.T) {
 	})
 
 	t.Run("should get an error - duplicated auth credential", func(t *testing.T) {
-		key, err := server.keyService.Create(ctx, issuerDID, kms.KeyTypeBabyJubJub, uuid.New().String())
+		key, err := server.keyService.Create(ctx, issuerDID, kms.KeyTypeBabyJubJub, uuid.New().String(), nil)
 		require.NoError(t, err)
 
 		body := CreateAuthCredentialRequest{
@@ -573,7 +573,7 @@ func TestServer_CreateAuthCredential(t *testing.T) {
 	})
 
 	t.Run("should get an error - credential status type not supported", func(t *testing.T) {
-		key, err := server.keyService.Create(ctx, issuerDID, kms.KeyTypeBabyJubJub, uuid.New().String())
+		key, err := server.keyService.Create(ctx, issuerDID, kms.KeyTypeBabyJubJub, uuid.New().String(), nil)
 		require.NoError(t, err)
 
 		body := CreateAuthCredentialRequest{
@@ -592,7 +592,7 @@ func TestServer_CreateAuthCredential(t *testing.T) {
 	})
 
 	t.Run("should create an auth credential", func(t *testing.T) {
-		key, err := server.keyService.Create(ctx, issuerDID, kms.KeyTypeBabyJubJub, uuid.New().String())
+		key, err := server.keyService.Create(ctx, issuerDID, kms.KeyTypeBabyJubJub, uuid.New().String(), nil)
 		require.NoError(t, err)
 
 		authCredentialExpiration := time.Now().UTC().Unix()
@@ -632,3 +632,47 @@ func TestServer_CreateAuthCredential(t *testing.T) {
 		assert.Equal(t, authCredentialExpiration, response2.Vc.Expiration.UTC().Unix())
 	})
 }
+
+func TestServer_GetDIDDocument(t *testing.T) {
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(context.Background(), server)
+
+	identity, err := server.identityService.Create(ctx, cfg.ServerUrl, &ports.DIDCreationOptions{
+		Method:     "polygonid",
+		Blockchain: "polygon",
+		Network:    "amoy",
+		KeyType:    "BJJ",
+	})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name     string
+		did      string
+		httpCode int
+	}{
+		{
+			name:     "should get the did document without auth",
+			did:      identity.Identifier,
+			httpCode: http.StatusOK,
+		},
+		{
+			name:     "should get an error - identity not hosted by the issuer node",
+			did:      "did:polygonid:polygon:amoy:2qE1ZT16aqEWhh9mX9aqM2pe2ZwV995dTkReeKwCaQ",
+			httpCode: http.StatusNotFound,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/did-document", tc.did), nil)
+			require.NoError(t, err)
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, tc.httpCode, rr.Code)
+			if tc.httpCode == http.StatusOK {
+				var response GetDIDDocument200JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, identity.Identifier, response["id"])
+			}
+		})
+	}
+}
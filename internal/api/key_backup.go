@@ -0,0 +1,43 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// CreateKeyBackup is the handler for the POST /keys/backup endpoint.
+func (s *Server) CreateKeyBackup(ctx context.Context, request CreateKeyBackupRequestObject) (CreateKeyBackupResponseObject, error) {
+	backup, shares, err := s.keyService.Backup(ctx, request.Identifier.did(), request.Body.Shares, request.Body.Threshold)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidBackupShares):
+			return CreateKeyBackup400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrNoKeysToBackup):
+			return CreateKeyBackup404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "creating key backup", "err", err)
+		return CreateKeyBackup500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	encoded, err := backup.Encode()
+	if err != nil {
+		log.Error(ctx, "encoding key backup", "err", err)
+		return CreateKeyBackup500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	resp := CreateKeyBackup201JSONResponse{
+		Backup:    encoded,
+		CreatedAt: TimeUTC(backup.CreatedAt),
+		Keys:      backup.Keys,
+		Shares:    make([]string, 0, len(shares)),
+		Threshold: backup.Threshold,
+	}
+	for _, share := range shares {
+		resp.Shares = append(resp.Shares, hex.EncodeToString(share))
+	}
+	return resp, nil
+}
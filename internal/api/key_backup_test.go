@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+func TestServer_CreateKeyBackup(t *testing.T) {
+	const (
+		method     = "iden3"
+		blockchain = "privado"
+		network    = "main"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+
+	iden, err := server.Services.identity.Create(ctx, "http://issuer-node", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	handler := getHandler(ctx, server)
+
+	type expected struct {
+		httpCode int
+		keys     int
+	}
+
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		did      string
+		body     KeyBackupRequest
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name: "no auth header",
+			auth: authWrong,
+			did:  did.String(),
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name: "should get an error - threshold greater than shares",
+			auth: authOk,
+			did:  did.String(),
+			body: KeyBackupRequest{Shares: 2, Threshold: 3},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "should get an error - threshold of one share",
+			auth: authOk,
+			did:  did.String(),
+			body: KeyBackupRequest{Shares: 3, Threshold: 1},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "should create a backup",
+			auth: authOk,
+			did:  did.String(),
+			body: KeyBackupRequest{Shares: 5, Threshold: 3},
+			expected: expected{
+				httpCode: http.StatusCreated,
+				keys:     1,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v2/identities/%s/keys/backup", tc.did)
+			req, err := http.NewRequest(http.MethodPost, url, tests.JSONBody(t, tc.body))
+			req.SetBasicAuth(tc.auth())
+			require.NoError(t, err)
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+
+			if tc.expected.httpCode == http.StatusCreated {
+				var response CreateKeyBackup201JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.keys, response.Keys)
+				assert.Equal(t, tc.body.Threshold, response.Threshold)
+				require.Len(t, response.Shares, tc.body.Shares)
+
+				backup, err := kms.DecodeKeyBackup(response.Backup)
+				require.NoError(t, err)
+				assert.Equal(t, did.String(), backup.Identity)
+
+				shares := make([][]byte, 0, tc.body.Threshold)
+				for _, share := range response.Shares[:tc.body.Threshold] {
+					decoded, err := hex.DecodeString(share)
+					require.NoError(t, err)
+					shares = append(shares, decoded)
+				}
+				restored, err := keyStore.RestoreIdentityKeys(ctx, backup, shares)
+				require.NoError(t, err)
+				require.Len(t, restored, tc.expected.keys)
+				assert.True(t, restored[0].Existed)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// GetKeyPolicy is the handler for the GET /keys/{keyID}/policy endpoint.
+func (s *Server) GetKeyPolicy(ctx context.Context, request GetKeyPolicyRequestObject) (GetKeyPolicyResponseObject, error) {
+	decodedKeyID, err := b64.StdEncoding.DecodeString(request.Id)
+	if err != nil {
+		log.Error(ctx, "the key id can not be decoded from base64", "err", err)
+		return GetKeyPolicy400JSONResponse{N400JSONResponse{Message: "the key id can not be decoded from base64"}}, nil
+	}
+
+	policy, err := s.keyUsageService.GetPolicy(ctx, *request.Identifier.did(), string(decodedKeyID))
+	if err != nil {
+		if errors.Is(err, services.ErrKeyPolicyNotFound) {
+			return GetKeyPolicy404JSONResponse{N404JSONResponse{Message: "key policy not found"}}, nil
+		}
+		log.Error(ctx, "getting key policy", "err", err)
+		return GetKeyPolicy500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return GetKeyPolicy200JSONResponse(keyPolicyResponse(policy)), nil
+}
+
+// UpdateKeyPolicy is the handler for the PUT /keys/{keyID}/policy endpoint.
+func (s *Server) UpdateKeyPolicy(ctx context.Context, request UpdateKeyPolicyRequestObject) (UpdateKeyPolicyResponseObject, error) {
+	decodedKeyID, err := b64.StdEncoding.DecodeString(request.Id)
+	if err != nil {
+		log.Error(ctx, "the key id can not be decoded from base64", "err", err)
+		return UpdateKeyPolicy400JSONResponse{N400JSONResponse{Message: "the key id can not be decoded from base64"}}, nil
+	}
+
+	req := ports.KeyPolicyRequest{AllowedPurposes: make([]kms.SignPurpose, 0, len(request.Body.AllowedPurposes))}
+	for _, purpose := range request.Body.AllowedPurposes {
+		req.AllowedPurposes = append(req.AllowedPurposes, kms.SignPurpose(purpose))
+	}
+	if request.Body.RateLimit != nil {
+		req.RateLimit = *request.Body.RateLimit
+	}
+	if request.Body.RateLimitWindow != nil {
+		if req.RateLimitWindow, err = time.ParseDuration(*request.Body.RateLimitWindow); err != nil {
+			return UpdateKeyPolicy400JSONResponse{N400JSONResponse{Message: "invalid rate limit window"}}, nil
+		}
+	}
+
+	policy, err := s.keyUsageService.SetPolicy(ctx, *request.Identifier.did(), string(decodedKeyID), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidKeyPolicy), errors.Is(err, services.ErrInvalidKeyType):
+			return UpdateKeyPolicy400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrKeyNotFound):
+			return UpdateKeyPolicy404JSONResponse{N404JSONResponse{Message: "key not found"}}, nil
+		}
+		log.Error(ctx, "updating key policy", "err", err)
+		return UpdateKeyPolicy500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return UpdateKeyPolicy200JSONResponse(keyPolicyResponse(policy)), nil
+}
+
+// DeleteKeyPolicy is the handler for the DELETE /keys/{keyID}/policy endpoint.
+func (s *Server) DeleteKeyPolicy(ctx context.Context, request DeleteKeyPolicyRequestObject) (DeleteKeyPolicyResponseObject, error) {
+	decodedKeyID, err := b64.StdEncoding.DecodeString(request.Id)
+	if err != nil {
+		log.Error(ctx, "the key id can not be decoded from base64", "err", err)
+		return DeleteKeyPolicy400JSONResponse{N400JSONResponse{Message: "the key id can not be decoded from base64"}}, nil
+	}
+
+	if err := s.keyUsageService.DeletePolicy(ctx, *request.Identifier.did(), string(decodedKeyID)); err != nil {
+		if errors.Is(err, services.ErrKeyPolicyNotFound) {
+			return DeleteKeyPolicy404JSONResponse{N404JSONResponse{Message: "key policy not found"}}, nil
+		}
+		log.Error(ctx, "deleting key policy", "err", err)
+		return DeleteKeyPolicy500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return DeleteKeyPolicy200JSONResponse{Message: "key policy deleted"}, nil
+}
+
+// GetKeyUsages is the handler for the GET /keys/{keyID}/usages endpoint.
+func (s *Server) GetKeyUsages(ctx context.Context, request GetKeyUsagesRequestObject) (GetKeyUsagesResponseObject, error) {
+	const (
+		defaultMaxResults = 50
+		defaultPage       = 1
+		minimumMaxResults = 10
+	)
+
+	decodedKeyID, err := b64.StdEncoding.DecodeString(request.Id)
+	if err != nil {
+		log.Error(ctx, "the key id can not be decoded from base64", "err", err)
+		return GetKeyUsages400JSONResponse{N400JSONResponse{Message: "the key id can not be decoded from base64"}}, nil
+	}
+
+	filter := ports.KeyUsageFilter{
+		MaxResults: defaultMaxResults,
+		Page:       defaultPage,
+	}
+	if request.Params.Purpose != nil {
+		purpose := kms.SignPurpose(*request.Params.Purpose)
+		if !slices.Contains(kms.SignPurposes(), purpose) {
+			return GetKeyUsages400JSONResponse{N400JSONResponse{Message: "invalid purpose"}}, nil
+		}
+		filter.Purpose = &purpose
+	}
+	if request.Params.Status != nil {
+		status := kms.SignStatus(*request.Params.Status)
+		if !slices.Contains([]kms.SignStatus{kms.SignStatusSigned, kms.SignStatusDenied, kms.SignStatusFailed}, status) {
+			return GetKeyUsages400JSONResponse{N400JSONResponse{Message: "invalid status"}}, nil
+		}
+		filter.Status = &status
+	}
+	if request.Params.MaxResults != nil {
+		filter.MaxResults = max(*request.Params.MaxResults, minimumMaxResults)
+	}
+	if request.Params.Page != nil && *request.Params.Page > 0 {
+		filter.Page = *request.Params.Page
+	}
+
+	usages, total, err := s.keyUsageService.GetUsages(ctx, *request.Identifier.did(), string(decodedKeyID), filter)
+	if err != nil {
+		log.Error(ctx, "getting key usages", "err", err)
+		return GetKeyUsages500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return GetKeyUsages200JSONResponse{
+		Items: keyUsagesResponse(usages),
+		Meta: PaginatedMetadata{
+			Page:       filter.Page,
+			MaxResults: filter.MaxResults,
+			Total:      total,
+		},
+	}, nil
+}
+
+func keyPolicyResponse(policy *domain.KeyPolicy) KeyPolicy {
+	purposes := make([]string, 0, len(policy.AllowedPurposes))
+	for _, purpose := range policy.AllowedPurposes {
+		purposes = append(purposes, string(purpose))
+	}
+	return KeyPolicy{
+		AllowedPurposes: purposes,
+		RateLimit:       policy.RateLimit,
+		RateLimitWindow: policy.RateLimitWindow.String(),
+		CreatedAt:       TimeUTC(policy.CreatedAt),
+		UpdatedAt:       TimeUTC(policy.UpdatedAt),
+	}
+}
+
+func keyUsagesResponse(usages []domain.KeyUsage) []KeyUsage {
+	items := make([]KeyUsage, 0, len(usages))
+	for _, usage := range usages {
+		items = append(items, KeyUsage{
+			Id:        usage.ID.String(),
+			Purpose:   string(usage.Purpose),
+			Digest:    usage.Digest,
+			Status:    string(usage.Status),
+			Reason:    usage.Reason,
+			CreatedAt: TimeUTC(usage.CreatedAt),
+		})
+	}
+	return items
+}
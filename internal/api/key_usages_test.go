@@ -0,0 +1,186 @@
+package api
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+func TestServer_KeyPolicy(t *testing.T) {
+	const (
+		method     = "iden3"
+		blockchain = "privado"
+		network    = "main"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+
+	iden, err := server.Services.identity.Create(ctx, "http://issuer-node", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	keyID, err := server.Services.keyService.Create(ctx, did, kms.KeyTypeEthereum, "payments", nil)
+	require.NoError(t, err)
+	missingKeyID := b64.StdEncoding.EncodeToString([]byte(did.String() + "/ETH:0x03aa"))
+
+	handler := getHandler(ctx, server)
+
+	type expected struct {
+		httpCode int
+		message  string
+	}
+
+	for _, tc := range []struct {
+		name     string
+		auth     func() (string, string)
+		keyID    string
+		body     KeyPolicyRequest
+		expected expected
+	}{
+		{
+			name:     "no auth header",
+			auth:     authWrong,
+			keyID:    keyID.ID,
+			expected: expected{httpCode: http.StatusUnauthorized},
+		},
+		{
+			name:     "should get an error - invalid purpose",
+			auth:     authOk,
+			keyID:    keyID.ID,
+			body:     KeyPolicyRequest{AllowedPurposes: []string{"anything"}},
+			expected: expected{httpCode: http.StatusBadRequest, message: "invalid key policy: unknown purpose anything"},
+		},
+		{
+			name:     "should get an error - rate limit without window",
+			auth:     authOk,
+			keyID:    keyID.ID,
+			body:     KeyPolicyRequest{AllowedPurposes: []string{}, RateLimit: common.ToPointer(10)},
+			expected: expected{httpCode: http.StatusBadRequest, message: "invalid key policy: the rate limit needs a positive window"},
+		},
+		{
+			name:     "should get an error - key not found",
+			auth:     authOk,
+			keyID:    missingKeyID,
+			body:     KeyPolicyRequest{AllowedPurposes: []string{string(kms.SignPurposePaymentRequest)}},
+			expected: expected{httpCode: http.StatusNotFound, message: "key not found"},
+		},
+		{
+			name:     "should set the policy",
+			auth:     authOk,
+			keyID:    keyID.ID,
+			body:     KeyPolicyRequest{AllowedPurposes: []string{string(kms.SignPurposePaymentRequest)}, RateLimit: common.ToPointer(100), RateLimitWindow: common.ToPointer("1h")},
+			expected: expected{httpCode: http.StatusOK},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v2/identities/%s/keys/%s/policy", did, tc.keyID)
+			req, err := http.NewRequest(http.MethodPut, url, tests.JSONBody(t, tc.body))
+			require.NoError(t, err)
+			req.SetBasicAuth(tc.auth())
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+
+			switch tc.expected.httpCode {
+			case http.StatusOK:
+				var response UpdateKeyPolicy200JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, []string{string(kms.SignPurposePaymentRequest)}, response.AllowedPurposes)
+				assert.Equal(t, 100, response.RateLimit)
+				assert.Equal(t, "1h0m0s", response.RateLimitWindow)
+			case http.StatusBadRequest, http.StatusNotFound:
+				var response N400JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			}
+		})
+	}
+
+	t.Run("should get the policy", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/keys/%s/policy", did, keyID.ID), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response GetKeyPolicy200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, []string{string(kms.SignPurposePaymentRequest)}, response.AllowedPurposes)
+	})
+
+	t.Run("should get the key usages", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/keys/%s/usages?purpose=paymentRequest", did, keyID.ID), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response GetKeyUsages200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Empty(t, response.Items)
+		assert.Equal(t, uint(0), response.Meta.Total)
+	})
+
+	t.Run("should delete the policy", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/v2/identities/%s/keys/%s/policy", did, keyID.ID), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		rr = httptest.NewRecorder()
+		req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/keys/%s/policy", did, keyID.ID), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("should set the policy of a payment key that is not bound to the identity", func(t *testing.T) {
+		paymentKeyID, err := keyStore.CreateKey(kms.KeyTypeEthereum, nil)
+		require.NoError(t, err)
+		encodedKeyID := b64.StdEncoding.EncodeToString([]byte(paymentKeyID.ID))
+		body := KeyPolicyRequest{AllowedPurposes: []string{string(kms.SignPurposePaymentRequest)}}
+
+		setPolicy := func(t *testing.T, issuerDID *w3c.DID) int {
+			t.Helper()
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/v2/identities/%s/keys/%s/policy", issuerDID, encodedKeyID), tests.JSONBody(t, body))
+			require.NoError(t, err)
+			req.SetBasicAuth(authOk())
+			handler.ServeHTTP(rr, req)
+			return rr.Code
+		}
+
+		require.Equal(t, http.StatusNotFound, setPolicy(t, did))
+
+		_, err = server.Repos.payments.SavePaymentOption(ctx, domain.NewPaymentOption(*did, "payments", "payments", &domain.PaymentOptionConfig{
+			PaymentOptions: []domain.PaymentOptionConfigItem{{PaymentOptionID: 1, Recipient: "0x0", SigningKeyID: encodedKeyID}},
+		}))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, setPolicy(t, did))
+
+		other, err := server.Services.identity.Create(ctx, "http://issuer-node", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+		require.NoError(t, err)
+		otherDID, err := w3c.ParseDID(other.Identifier)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, setPolicy(t, otherDID))
+	})
+}
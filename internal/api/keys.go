@@ -4,8 +4,10 @@ import (
 	"context"
 	b64 "encoding/base64"
 	"errors"
+	"time"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/kms"
@@ -15,11 +17,11 @@ import (
 
 // CreateKey is the handler for the POST /keys endpoint.
 func (s *Server) CreateKey(ctx context.Context, request CreateKeyRequestObject) (CreateKeyResponseObject, error) {
-	if string(request.Body.KeyType) != string(KeyKeyTypeBabyjubJub) && string(request.Body.KeyType) != string(KeyKeyTypeSecp256k1) && string(request.Body.KeyType) != string(KeyKeyTypeEd25519) {
-		log.Error(ctx, "invalid key type. babyjubJub, secp256k1 and ed25519 keys are supported")
+	if string(request.Body.KeyType) != string(KeyKeyTypeBabyjubJub) && string(request.Body.KeyType) != string(KeyKeyTypeSecp256k1) && string(request.Body.KeyType) != string(KeyKeyTypeEd25519) && string(request.Body.KeyType) != string(KeyKeyTypeP256) {
+		log.Error(ctx, "invalid key type. babyjubJub, secp256k1, ed25519 and p256 keys are supported")
 		return CreateKey400JSONResponse{
 			N400JSONResponse{
-				Message: "invalid key type. babyjubJub, secp256k1 and ed25519 keys are supported",
+				Message: "invalid key type. babyjubJub, secp256k1, ed25519 and p256 keys are supported",
 			},
 		}, nil
 	}
@@ -33,9 +35,35 @@ func (s *Server) CreateKey(ctx context.Context, request CreateKeyRequestObject)
 		}, nil
 	}
 
-	keyID, err := s.keyService.Create(ctx, request.Identifier.did(), convertKeyTypeFromRequest(request.Body.KeyType), request.Body.Name)
+	expiresAt := convertKeyExpirationFromRequest(request.Body.ExpiresAt)
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		log.Error(ctx, "invalid key expiration", "expiresAt", expiresAt)
+		return CreateKey400JSONResponse{
+			N400JSONResponse{
+				Message: services.ErrInvalidKeyExpiration.Error(),
+			},
+		}, nil
+	}
+
+	payload := domain.CreateKeyPayload{KeyType: convertKeyTypeFromRequest(request.Body.KeyType), Name: request.Body.Name, ExpiresAt: expiresAt}
+	held, err := s.holdAction(ctx, *request.Identifier.did(), domain.PendingActionTypeCreateKey, 0, payload)
+	if err != nil {
+		return CreateKey500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	if held != nil {
+		return *held, nil
+	}
+
+	keyID, err := s.keyService.Create(ctx, request.Identifier.did(), payload.KeyType, payload.Name, payload.ExpiresAt)
 	if err != nil {
 		log.Error(ctx, "creating key", "err", err)
+		if errors.Is(err, services.ErrInvalidKeyExpiration) {
+			return CreateKey400JSONResponse{
+				N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
 		if errors.Is(err, repositories.ErrDuplicateKeyName) || errors.Is(err, services.ErrDuplicateKeyName) {
 			log.Error(ctx, "duplicate key name", "err", err)
 			return CreateKey400JSONResponse{
@@ -76,9 +104,16 @@ func (s *Server) UpdateKey(ctx context.Context, request UpdateKeyRequestObject)
 		}, nil
 	}
 
-	err = s.keyService.Update(ctx, request.Identifier.did(), string(decodedKeyID), request.Body.Name)
+	err = s.keyService.Update(ctx, request.Identifier.did(), string(decodedKeyID), request.Body.Name, request.Body.Published, convertKeyExpirationFromRequest(request.Body.ExpiresAt))
 	if err != nil {
 		log.Error(ctx, "updating key", "err", err)
+		if errors.Is(err, services.ErrKeyNotPublishable) || errors.Is(err, services.ErrInvalidKeyExpiration) {
+			return UpdateKey400JSONResponse{
+				N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
 		if errors.Is(err, services.ErrKeyNotFound) {
 			log.Error(ctx, "key not found", "err", err)
 			return UpdateKey404JSONResponse{
@@ -150,6 +185,9 @@ func (s *Server) GetKey(ctx context.Context, request GetKeyRequestObject) (GetKe
 		PublicKey:        key.PublicKey,
 		IsAuthCredential: key.HasAssociatedAuthCredential,
 		Name:             key.Name,
+		Published:        key.Published,
+		ExpiresAt:        convertKeyExpirationToResponse(key.ExpiresAt),
+		Expired:          key.Expired,
 	}, nil
 }
 
@@ -166,11 +204,11 @@ func (s *Server) GetKeys(ctx context.Context, request GetKeysRequestObject) (Get
 	}
 
 	if request.Params.Type != nil {
-		if string(*request.Params.Type) != string(KeyKeyTypeBabyjubJub) && string(*request.Params.Type) != string(KeyKeyTypeSecp256k1) && string(*request.Params.Type) != string(KeyKeyTypeEd25519) {
-			log.Error(ctx, "invalid key type. babyjubJub, secp256k1 and ed25519	 keys are supported")
+		if string(*request.Params.Type) != string(KeyKeyTypeBabyjubJub) && string(*request.Params.Type) != string(KeyKeyTypeSecp256k1) && string(*request.Params.Type) != string(KeyKeyTypeEd25519) && string(*request.Params.Type) != string(KeyKeyTypeP256) {
+			log.Error(ctx, "invalid key type. babyjubJub, secp256k1, ed25519 and p256 keys are supported")
 			return GetKeys400JSONResponse{
 				N400JSONResponse{
-					Message: "invalid key type. babyjubJub, secp256k1 and ed25519 keys are supported",
+					Message: "invalid key type. babyjubJub, secp256k1, ed25519 and p256 keys are supported",
 				},
 			}, nil
 		}
@@ -178,6 +216,8 @@ func (s *Server) GetKeys(ctx context.Context, request GetKeysRequestObject) (Get
 			filter.KeyType = common.ToPointer(kms.KeyTypeBabyJubJub)
 		} else if string(*request.Params.Type) == string(KeyKeyTypeEd25519) {
 			filter.KeyType = common.ToPointer(kms.KeyTypeEd25519)
+		} else if string(*request.Params.Type) == string(KeyKeyTypeP256) {
+			filter.KeyType = common.ToPointer(kms.KeyTypeP256)
 		} else {
 			filter.KeyType = common.ToPointer(kms.KeyTypeEthereum)
 		}
@@ -214,6 +254,9 @@ func (s *Server) GetKeys(ctx context.Context, request GetKeysRequestObject) (Get
 			PublicKey:        key.PublicKey,
 			IsAuthCredential: key.HasAssociatedAuthCredential,
 			Name:             key.Name,
+			Published:        key.Published,
+			ExpiresAt:        convertKeyExpirationToResponse(key.ExpiresAt),
+			Expired:          key.Expired,
 		})
 	}
 	return GetKeys200JSONResponse{
@@ -238,6 +281,14 @@ func (s *Server) DeleteKey(ctx context.Context, request DeleteKeyRequestObject)
 		}, nil
 	}
 
+	held, err := s.holdAction(ctx, *request.Identifier.did(), domain.PendingActionTypeDeleteKey, 0, domain.DeleteKeyPayload{KeyID: string(decodedKeyID)})
+	if err != nil {
+		return DeleteKey500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	if held != nil {
+		return *held, nil
+	}
+
 	err = s.keyService.Delete(ctx, request.Identifier.did(), string(decodedKeyID))
 	if err != nil {
 		if errors.Is(err, services.ErrAuthCredentialNotRevoked) {
@@ -287,6 +338,9 @@ func convertKeyTypeToResponse(keyType kms.KeyType) KeyKeyType {
 	if keyType == "Ed25519" {
 		return KeyKeyTypeEd25519
 	}
+	if keyType == "P256" {
+		return KeyKeyTypeP256
+	}
 	return KeyKeyTypeSecp256k1
 }
 
@@ -297,5 +351,22 @@ func convertKeyTypeFromRequest(keyType CreateKeyRequestKeyType) kms.KeyType {
 	if string(keyType) == string(KeyKeyTypeEd25519) {
 		return "Ed25519"
 	}
+	if string(keyType) == string(KeyKeyTypeP256) {
+		return "P256"
+	}
 	return "ETH"
 }
+
+func convertKeyExpirationFromRequest(expiresAt *TimeUTC) *time.Time {
+	if expiresAt == nil {
+		return nil
+	}
+	return common.ToPointer(time.Time(*expiresAt))
+}
+
+func convertKeyExpirationToResponse(expiresAt *time.Time) *TimeUTC {
+	if expiresAt == nil {
+		return nil
+	}
+	return common.ToPointer(TimeUTC(*expiresAt))
+}
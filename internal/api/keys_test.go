@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/iden3/go-iden3-core/v2/w3c"
@@ -15,6 +16,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/db/tests"
 	"github.com/polygonid/sh-id-platform/internal/kms"
@@ -88,7 +90,7 @@ func TestServer_CreateKey(t *testing.T) {
 				httpCode: http.StatusBadRequest,
 				response: CreateKey400JSONResponse{
 					N400JSONResponse: N400JSONResponse{
-						Message: "invalid key type. babyjubJub, secp256k1 and ed25519 keys are supported",
+						Message: "invalid key type. babyjubJub, secp256k1, ed25519 and p256 keys are supported",
 					},
 				},
 			},
@@ -122,6 +124,37 @@ func TestServer_CreateKey(t *testing.T) {
 				httpCode: http.StatusCreated,
 			},
 		},
+		{
+			name: "should create a bjj key with an expiration",
+			auth: authOk,
+			did:  did.String(),
+			body: CreateKeyRequest{
+				KeyType:   CreateKeyRequestKeyType(KeyKeyTypeBabyjubJub),
+				Name:      "my-expiring-bjj-key",
+				ExpiresAt: common.ToPointer(TimeUTC(time.Now().Add(365 * 24 * time.Hour))),
+			},
+			expected: expected{
+				httpCode: http.StatusCreated,
+			},
+		},
+		{
+			name: "should get an error - expiration in the past",
+			auth: authOk,
+			did:  did.String(),
+			body: CreateKeyRequest{
+				KeyType:   CreateKeyRequestKeyType(KeyKeyTypeBabyjubJub),
+				Name:      "my-expired-bjj-key",
+				ExpiresAt: common.ToPointer(TimeUTC(time.Now().Add(-time.Hour))),
+			},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				response: CreateKey400JSONResponse{
+					N400JSONResponse: N400JSONResponse{
+						Message: "the expiration of the key must be in the future",
+					},
+				},
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			rr := httptest.NewRecorder()
@@ -161,7 +194,7 @@ func TestServer_GetKey(t *testing.T) {
 	did, err := w3c.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 
-	keyID, err := server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, "my-key")
+	keyID, err := server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, "my-key", nil)
 	require.NoError(t, err)
 
 	handler := getHandler(ctx, server)
@@ -297,7 +330,7 @@ func TestServer_GetKeys(t *testing.T) {
 	t.Run("should get the keys for bjj identity with pagination", func(t *testing.T) {
 		for i := 0; i < 20; i++ {
 			name := fmt.Sprintf("z-key-%s", string('A'+rune(i+1)))
-			_, err = server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, name)
+			_, err = server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, name, nil)
 			require.NoError(t, err)
 		}
 
@@ -363,7 +396,7 @@ func TestServer_GetKeys(t *testing.T) {
 
 	t.Run("should get the keys for eth identity with pagination", func(t *testing.T) {
 		for i := 0; i < 20; i++ {
-			_, err = server.keyService.Create(ctx, didETH, kms.KeyTypeBabyJubJub, fmt.Sprintf("my-key-%d", i))
+			_, err = server.keyService.Create(ctx, didETH, kms.KeyTypeBabyJubJub, fmt.Sprintf("my-key-%d", i), nil)
 			require.NoError(t, err)
 		}
 
@@ -446,13 +479,13 @@ func TestServer_DeleteKey(t *testing.T) {
 		idenETHETHKey = idenETHKeys[0].KeyID
 	}
 
-	keyETHIDToDelete, err := server.keyService.Create(ctx, didETH, kms.KeyTypeEthereum, "key-eth-to-delete")
+	keyETHIDToDelete, err := server.keyService.Create(ctx, didETH, kms.KeyTypeEthereum, "key-eth-to-delete", nil)
 	require.NoError(t, err)
 
-	keyID, err := server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, "key-bjj-to-delete")
+	keyID, err := server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, "key-bjj-to-delete", nil)
 	require.NoError(t, err)
 
-	keyIDForAuthCoreClaimID, err := server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, "key-bjj-for-auth-core-claim-id")
+	keyIDForAuthCoreClaimID, err := server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, "key-bjj-for-auth-core-claim-id", nil)
 	require.NoError(t, err)
 
 	keyIDForAuthCoreClaimIDASByteArr, err := b64.StdEncoding.DecodeString(keyIDForAuthCoreClaimID.ID)
@@ -627,7 +660,7 @@ func TestServer_UpdateKey(t *testing.T) {
 	})
 
 	t.Run("should update a key", func(t *testing.T) {
-		keyID, err := server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, "my-key")
+		keyID, err := server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, "my-key", nil)
 		require.NoError(t, err)
 
 		rr := httptest.NewRecorder()
@@ -645,7 +678,7 @@ func TestServer_UpdateKey(t *testing.T) {
 	})
 
 	t.Run("should get an error - duplicate key name", func(t *testing.T) {
-		keyID, err := server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, "my-key")
+		keyID, err := server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, "my-key", nil)
 		require.NoError(t, err)
 		rr := httptest.NewRecorder()
 		url := fmt.Sprintf("/v2/identities/%s/keys/%s", did, keyID.ID)
@@ -662,7 +695,7 @@ func TestServer_UpdateKey(t *testing.T) {
 	})
 
 	t.Run("should get an error - name is required", func(t *testing.T) {
-		keyID, err := server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, "my-key-to-not-update")
+		keyID, err := server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, "my-key-to-not-update", nil)
 		require.NoError(t, err)
 		rr := httptest.NewRecorder()
 		url := fmt.Sprintf("/v2/identities/%s/keys/%s", did, keyID.ID)
@@ -679,7 +712,7 @@ func TestServer_UpdateKey(t *testing.T) {
 	})
 
 	t.Run("should get an error - key not found", func(t *testing.T) {
-		keyID, err := server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, "my-key-to-not-update-2")
+		keyID, err := server.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, "my-key-to-not-update-2", nil)
 		require.NoError(t, err)
 
 		decodedKeyID, err := b64.StdEncoding.DecodeString(keyID.ID)
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/hashicorp/vault/api"
@@ -122,6 +123,7 @@ func TestMain(m *testing.M) {
 	cfg.ServerUrl = "https://testing.env"
 	cfg.Ethereum = cfgForTesting.Ethereum
 	cfg.UniversalLinks = config.UniversalLinks{BaseUrl: "https://testing.env"}
+	cfg.AuthSession = config.AuthSession{TTL: 5 * time.Minute, Retention: time.Hour}
 	schemaLoader = loader.NewDocumentLoader(ipfsGatewayURL, false)
 	m.Run()
 }
@@ -132,7 +134,7 @@ func getHandler(ctx context.Context, server StrictServerInterface) http.Handler
 	return HandlerWithOptions(
 		NewStrictHandlerWithOptions(
 			server,
-			middlewares(ctx),
+			middlewares(ctx, server),
 			StrictHTTPServerOptions{
 				RequestErrorHandlerFunc:  errors.RequestErrorHandlerFunc,
 				ResponseErrorHandlerFunc: errors.ResponseErrorHandlerFunc,
@@ -144,11 +146,19 @@ func getHandler(ctx context.Context, server StrictServerInterface) http.Handler
 		})
 }
 
-func middlewares(ctx context.Context) []StrictMiddlewareFunc {
+func middlewares(ctx context.Context, server StrictServerInterface) []StrictMiddlewareFunc {
 	usr, pass := authOk()
+	s, ok := server.(*testServer)
+	if !ok {
+		return []StrictMiddlewareFunc{
+			LogMiddleware(ctx),
+			AuthMiddleware(ctx, usr, pass, nil, nil, nil),
+		}
+	}
 	return []StrictMiddlewareFunc{
 		LogMiddleware(ctx),
-		BasicAuthMiddleware(ctx, usr, pass),
+		AuthMiddleware(ctx, usr, pass, s.apiKeyService, nil, s.tenantService),
+		AuditMiddleware(ctx, s.auditLogService),
 	}
 }
 
@@ -229,18 +239,28 @@ func NewLinkMock() ports.LinkService {
 }
 
 type repos struct {
-	claims         ports.ClaimRepository
-	connection     ports.ConnectionRepository
-	identity       ports.IdentityRepository
-	idenMerkleTree ports.IdentityMerkleTreeRepository
-	identityState  ports.IdentityStateRepository
-	links          ports.LinkRepository
-	payments       ports.PaymentRepository
-	schemas        ports.SchemaRepository
-	sessions       ports.SessionRepository
-	revocation     ports.RevocationRepository
-	displayMethod  ports.DisplayMethodRepository
-	keyRepository  ports.KeyRepository
+	claims             ports.ClaimRepository
+	connection         ports.ConnectionRepository
+	connectionMessages ports.ConnectionMessageRepository
+	proofRequests      ports.ProofRequestRepository
+	connectionMerges   ports.ConnectionMergeRepository
+	pendingActions     ports.PendingActionRepository
+	keyUsages          ports.KeyUsageRepository
+	keyPolicies        ports.KeyPolicyRepository
+	apiKeys            ports.APIKeyRepository
+	tenants            ports.TenantRepository
+	auditLogs          ports.AuditLogRepository
+	webhooks           ports.WebhookRepository
+	identity           ports.IdentityRepository
+	idenMerkleTree     ports.IdentityMerkleTreeRepository
+	identityState      ports.IdentityStateRepository
+	links              ports.LinkRepository
+	payments           ports.PaymentRepository
+	schemas            ports.SchemaRepository
+	sessions           ports.SessionRepository
+	revocation         ports.RevocationRepository
+	displayMethod      ports.DisplayMethodRepository
+	keyRepository      ports.KeyRepository
 }
 
 type servicex struct {
@@ -252,6 +272,10 @@ type servicex struct {
 	qrs           ports.QrStoreService
 	displayMethod ports.DisplayMethodService
 	keyService    ports.KeyService
+	apiKeys       ports.APIKeyService
+	tenants       ports.TenantService
+	auditLogs     ports.AuditLogService
+	webhooks      ports.WebhookService
 }
 
 type infra struct {
@@ -272,18 +296,28 @@ func newTestServer(t *testing.T, st *db.Storage) *testServer {
 		st = storage
 	}
 	repos := repos{
-		claims:         repositories.NewClaim(),
-		connection:     repositories.NewConnection(),
-		identity:       repositories.NewIdentity(),
-		idenMerkleTree: repositories.NewIdentityMerkleTreeRepository(),
-		identityState:  repositories.NewIdentityState(),
-		links:          repositories.NewLink(*st),
-		payments:       repositories.NewPayment(*st),
-		sessions:       repositories.NewSessionCached(cachex),
-		schemas:        repositories.NewSchema(*st),
-		revocation:     repositories.NewRevocation(),
-		displayMethod:  repositories.NewDisplayMethod(*st),
-		keyRepository:  repositories.NewKey(*st),
+		claims:             repositories.NewClaim(),
+		connection:         repositories.NewConnection(),
+		connectionMessages: repositories.NewConnectionMessage(),
+		proofRequests:      repositories.NewProofRequest(),
+		connectionMerges:   repositories.NewConnectionMerge(),
+		pendingActions:     repositories.NewPendingAction(),
+		keyUsages:          repositories.NewKeyUsage(),
+		keyPolicies:        repositories.NewKeyPolicy(),
+		apiKeys:            repositories.NewAPIKey(),
+		tenants:            repositories.NewTenant(),
+		auditLogs:          repositories.NewAuditLog(),
+		webhooks:           repositories.NewWebhook(),
+		identity:           repositories.NewIdentity(),
+		idenMerkleTree:     repositories.NewIdentityMerkleTreeRepository(),
+		identityState:      repositories.NewIdentityState(),
+		links:              repositories.NewLink(*st),
+		payments:           repositories.NewPayment(*st),
+		sessions:           repositories.NewSessionCached(cachex, cfg.AuthSession.Retention),
+		schemas:            repositories.NewSchema(*st),
+		revocation:         repositories.NewRevocation(),
+		displayMethod:      repositories.NewDisplayMethod(*st),
+		keyRepository:      repositories.NewKey(*st),
 	}
 
 	pubSub := pubsub.NewMock()
@@ -351,11 +385,12 @@ func newTestServer(t *testing.T, st *db.Storage) *testServer {
 	mtService := services.NewIdentityMerkleTrees(repos.idenMerkleTree)
 	qrService := services.NewQrStoreService(cachex)
 	rhsFactory := reversehash.NewFactory(*networkResolver, reversehash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, repos.identity, repos.idenMerkleTree, repos.identityState, mtService, qrService, repos.claims, repos.revocation, repos.connection, st, nil, repos.sessions, pubSub, *networkResolver, rhsFactory, revocationStatusResolver, repos.keyRepository)
-	connectionService := services.NewConnection(repos.connection, repos.claims, st)
+	tenantService := services.NewTenant(repos.tenants, st)
+	identityService := services.NewIdentity(keyStore, repos.identity, repos.idenMerkleTree, repos.identityState, mtService, qrService, repos.claims, repos.revocation, repos.connection, st, nil, repos.sessions, pubSub, *networkResolver, rhsFactory, revocationStatusResolver, repos.keyRepository, tenantService)
+	connectionService := services.NewConnection(repos.connection, repos.claims, repos.connectionMessages, st, pubSub)
 	displayMethodService := services.NewDisplayMethod(repos.displayMethod)
 	schemaService := services.NewSchema(repos.schemas, schemaLoader, displayMethodService)
-	paymentService, err := services.NewPaymentService(repos.payments, *networkResolver, schemaService, paymentSettings, keyStore)
+	paymentService, err := services.NewPaymentService(repos.payments, *networkResolver, schemaService, paymentSettings, keyStore, pubSub)
 	require.NoError(t, err)
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -368,12 +403,19 @@ func newTestServer(t *testing.T, st *db.Storage) *testServer {
 
 	packageManager, err := NewPackageManagerMock()
 	require.NoError(t, err)
-	claimsService := services.NewClaim(repos.claims, identityService, qrService, mtService, repos.identityState, schemaLoader, st, cfg.ServerUrl, pubSub, ipfsGatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	claimsService := services.NewClaim(repos.claims, identityService, qrService, mtService, repos.identityState, schemaLoader, st, cfg.ServerUrl, pubSub, ipfsGatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks, tenantService)
 	accountService := services.NewAccountService(*networkResolver)
-	linkService := services.NewLinkService(storage, claimsService, qrService, repos.claims, repos.links, repos.schemas, schemaLoader, repos.sessions, pubSub, identityService, *networkResolver, cfg.UniversalLinks)
-	keyService := services.NewKey(keyStore, claimsService, repos.keyRepository)
+	linkService := services.NewLinkService(storage, claimsService, qrService, repos.claims, repos.links, repos.schemas, schemaLoader, repos.sessions, pubSub, identityService, *networkResolver, cfg.UniversalLinks, tenantService)
+	keyService := services.NewKey(keyStore, claimsService, repos.keyRepository, pubSub, cfg.KeyExpiry)
+	proofRequestService := services.NewProofRequest(repos.proofRequests, connectionService, qrService, nil, st)
+	connectionMergeService := services.NewConnectionMerge(repos.connection, repos.connectionMerges, repos.claims, claimsService, qrService, nil, st)
+	pendingActionService := services.NewPendingAction(services.PendingActionConfig{}, repos.pendingActions, NewPublisherMock(), claimsService, connectionService, keyService, paymentService, qrService, nil, st)
+	keyUsageService := services.NewKeyUsage(keyStore, repos.keyUsages, repos.keyPolicies, repos.payments, []kms.KeyID{{Type: kms.KeyTypeEthereum, ID: cfg.PublishingKeyPath}}, st)
+	apiKeyService := services.NewAPIKey(repos.apiKeys, repos.tenants, st)
+	auditLogService := services.NewAuditLog(repos.auditLogs, st)
+	webhookService := services.NewWebhook(repos.webhooks, st, cachex, cfg.Webhooks)
 	discoveryService := services.NewDiscovery(mediaTypeManager, packageManager, mediaTypeManager.GetSupportedProtocolMessages())
-	server := NewServer(&cfg, identityService, accountService, connectionService, claimsService, qrService, NewPublisherMock(), packageManager, *networkResolver, nil, schemaService, linkService, displayMethodService, keyService, paymentService, discoveryService)
+	server := NewServer(&cfg, identityService, accountService, connectionService, claimsService, qrService, NewPublisherMock(), packageManager, *networkResolver, nil, schemaService, linkService, displayMethodService, keyService, paymentService, discoveryService, proofRequestService, connectionMergeService, pendingActionService, keyUsageService, apiKeyService, tenantService, auditLogService, webhookService, nil)
 
 	return &testServer{
 		Server: server,
@@ -387,6 +429,10 @@ func newTestServer(t *testing.T, st *db.Storage) *testServer {
 			schema:        schemaService,
 			displayMethod: displayMethodService,
 			keyService:    keyService,
+			apiKeys:       apiKeyService,
+			tenants:       tenantService,
+			auditLogs:     auditLogService,
+			webhooks:      webhookService,
 		},
 		Infra: infra{
 			db:     st,
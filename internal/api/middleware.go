@@ -4,22 +4,28 @@ import (
 	"context"
 	"crypto/subtle"
 	"errors"
+	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/go-chi/chi/v5/middleware"
 
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
 	apiErrors "github.com/polygonid/sh-id-platform/internal/errors"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/oidc"
 )
 
-// LogMiddleware returns a middleware that adds general log configuration to each context request
-func LogMiddleware(ctx context.Context) StrictMiddlewareFunc {
+// LogMiddleware returns a middleware that adds the request ID to the logs written with the context of each request
+func LogMiddleware(_ context.Context) StrictMiddlewareFunc {
 	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
 		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
 			if reqID := middleware.GetReqID(ctxReq); reqID != "" {
-				log.With("req-id", reqID)
+				ctxReq = log.With(ctxReq, "req-id", reqID)
 			}
-			return f(ctx, w, r, args)
+			return f(ctxReq, w, r, args)
 		}
 	}
 }
@@ -29,9 +35,71 @@ func LogMiddleware(ctx context.Context) StrictMiddlewareFunc {
 // In uses the BasicAuthScopes value in context to figure if and endpoint needs authorization or not, because this
 // value is injected automatically by openapi when basic auth is selected
 func BasicAuthMiddleware(ctx context.Context, user, pass string) StrictMiddlewareFunc {
+	return AuthMiddleware(ctx, user, pass, nil, nil, nil)
+}
+
+// AuthMiddleware returns a middleware that authorizes the secured endpoints with an api key, when the request has
+// the X-API-Key header, with a bearer JWT of the OIDC provider, when the request has a bearer Authorization header,
+// or with http basic auth otherwise.
+// Api keys and tokens are only allowed to call the operations of their scopes and, if they are restricted to some
+// identities, only on those identities. Tokens with a tenant are restricted to the identities of the tenant, loaded
+// with tenantService. A nil apiKeyService or verifier disables that method.
+func AuthMiddleware(_ context.Context, user, pass string, apiKeyService ports.APIKeyService, verifier *oidc.Verifier, tenantService ports.TenantService) StrictMiddlewareFunc {
 	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
 		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
-			if ctxReq.Value(BasicAuthScopes) != nil && user != "" && pass != "" {
+			if ctxReq.Value(BasicAuthScopes) == nil {
+				return f(ctxReq, w, r, args)
+			}
+			if token := r.Header.Get(apiKeyHeader); token != "" && apiKeyService != nil {
+				apiKey, err := apiKeyService.Authenticate(ctxReq, token)
+				if err != nil {
+					if errors.Is(err, services.ErrInvalidAPIKey) {
+						return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+					}
+					log.Error(ctxReq, "authenticating api key", "err", err)
+					return nil, err
+				}
+				caller := "apikey:" + apiKey.ID.String()
+				setAuditActor(ctxReq, caller)
+				if err := authorize(apiKey, apiKey.TenantID, operationID, args); err != nil {
+					log.Info(ctxReq, "api key not allowed", "err", err, "apiKey", apiKey.ID)
+					return nil, apiErrors.ForbiddenError{Err: err}
+				}
+				ctxReq = contextWithCaller(ctxReq, caller)
+				if apiKey.TenantID != nil {
+					return f(contextWithTenant(ctxReq, *apiKey.TenantID), w, r, args)
+				}
+				return f(ctxReq, w, r, args)
+			}
+			if token, ok := bearerToken(r); ok && verifier != nil {
+				claims, err := verifier.Verify(ctxReq, token)
+				if err != nil {
+					log.Info(ctxReq, "invalid bearer token", "err", err)
+					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+				}
+				caller := "oidc:" + claims.Subject
+				setAuditActor(ctxReq, caller)
+				if claims.TenantID != nil {
+					if err := restrictToTenant(ctxReq, tenantService, claims); err != nil {
+						if errors.Is(err, services.ErrTenantNotFound) {
+							log.Info(ctxReq, "bearer token of an unknown tenant", "subject", claims.Subject, "tenant", claims.TenantID)
+							return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+						}
+						log.Error(ctxReq, "getting tenant identities", "err", err, "tenant", claims.TenantID)
+						return nil, err
+					}
+				}
+				if err := authorize(claims, claims.TenantID, operationID, args); err != nil {
+					log.Info(ctxReq, "bearer token not allowed", "err", err, "subject", claims.Subject)
+					return nil, apiErrors.ForbiddenError{Err: err}
+				}
+				ctxReq = contextWithCaller(ctxReq, caller)
+				if claims.TenantID != nil {
+					ctxReq = contextWithTenant(ctxReq, *claims.TenantID)
+				}
+				return f(ctxReq, w, r, args)
+			}
+			if user != "" && pass != "" {
 				userReq, passReq, ok := r.BasicAuth()
 				if !ok {
 					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
@@ -39,8 +107,62 @@ func BasicAuthMiddleware(ctx context.Context, user, pass string) StrictMiddlewar
 				if subtle.ConstantTimeCompare([]byte(user), []byte(userReq)) != 1 || subtle.ConstantTimeCompare([]byte(pass), []byte(passReq)) != 1 {
 					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
 				}
+				setAuditActor(ctxReq, "basic:"+userReq)
+				ctxReq = contextWithCaller(ctxReq, "basic:"+userReq)
+			}
+			if callerOperations[operationID] {
+				return nil, apiErrors.ForbiddenError{Err: fmt.Errorf("%w: %s requires an api key or a bearer token with the %s scope", errForbidden, operationID, operationScopes[operationID])}
 			}
-			return f(ctx, w, r, args)
+			return f(ctxReq, w, r, args)
 		}
 	}
 }
+
+// restrictToTenant limits the identities of the token to the identities of its tenant, or to the ones of the token
+// that belong to the tenant if the token is restricted to some identities
+func restrictToTenant(ctx context.Context, tenantService ports.TenantService, claims *oidc.Claims) error {
+	if tenantService == nil {
+		return services.ErrTenantNotFound
+	}
+	tenantIdentities, err := tenantService.GetIdentities(ctx, *claims.TenantID)
+	if err != nil {
+		return err
+	}
+	if len(claims.Identities) > 0 {
+		tenantIdentities = slices.DeleteFunc(tenantIdentities, func(identity string) bool {
+			return !slices.Contains(claims.Identities, identity)
+		})
+	}
+	claims.Identities = tenantIdentities
+	return nil
+}
+
+// callerOperations can not be called with basic auth because they are recorded with the api key or the bearer token
+// subject of the caller, for example the approvals of the pending actions, that need different approvers
+var callerOperations = map[string]bool{
+	"ApprovePendingAction": true,
+	"RejectPendingAction":  true,
+}
+
+type callerContextKey struct{}
+
+// contextWithCaller returns a copy of the context with the api key or the bearer token subject of the caller
+func contextWithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerContextKey{}, caller)
+}
+
+// callerFromContext returns the api key, the bearer token subject or the basic auth user of the caller, if the
+// request was authenticated
+func callerFromContext(ctx context.Context) (string, bool) {
+	caller, ok := ctx.Value(callerContextKey{}).(string)
+	return caller, ok && caller != ""
+}
+
+// bearerToken returns the token of a bearer Authorization header
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
@@ -57,6 +57,18 @@ func (s *Server) CreatePaymentOption(ctx context.Context, request CreatePaymentO
 		log.Error(ctx, "creating payment option config", "err", err)
 		return CreatePaymentOption400JSONResponse{N400JSONResponse{Message: fmt.Sprintf("invalid config: %s", err)}}, nil
 	}
+	held, err := s.holdAction(ctx, *issuerDID, domain.PendingActionTypeCreatePaymentOption, 0, domain.CreatePaymentOptionPayload{
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+		Config:      *payOptConf,
+	})
+	if err != nil {
+		return CreatePaymentOption500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	if held != nil {
+		return *held, nil
+	}
+
 	id, err := s.paymentService.CreatePaymentOption(ctx, issuerDID, request.Body.Name, request.Body.Description, payOptConf)
 	if err != nil {
 		log.Error(ctx, "creating payment option", "err", err, "issuer", issuerDID, "request", request.Body)
@@ -0,0 +1,240 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// PendingActionAcceptedResponse is returned instead of the operation response when the operation needs to be
+// approved. The operation is held as a pending action and executed once it is approved.
+type PendingActionAcceptedResponse PendingAction
+
+func (response PendingActionAcceptedResponse) visit(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// VisitPublishIdentityStateResponse writes the pending action of a held state publication
+func (response PendingActionAcceptedResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+// VisitRetryPublishStateResponse writes the pending action of a held state publication retry
+func (response PendingActionAcceptedResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+// VisitDeleteConnectionResponse writes the pending action of a held connection deletion
+func (response PendingActionAcceptedResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+// VisitRevokeCredentialResponse writes the pending action of a held revocation
+func (response PendingActionAcceptedResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+// VisitRevokeConnectionCredentialsResponse writes the pending action of a held revocation
+func (response PendingActionAcceptedResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+// VisitRevokeConnectionsCredentialsResponse writes the pending action of a held revocation
+func (response PendingActionAcceptedResponse) VisitRevokeConnectionsCredentialsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+// VisitCreateKeyResponse writes the pending action of a held key creation
+func (response PendingActionAcceptedResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+// VisitDeleteKeyResponse writes the pending action of a held key deletion
+func (response PendingActionAcceptedResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+// VisitCreatePaymentOptionResponse writes the pending action of a held payment option creation
+func (response PendingActionAcceptedResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+// GetPendingActions returns the actions held for approval of the identity
+func (s *Server) GetPendingActions(ctx context.Context, request GetPendingActionsRequestObject) (GetPendingActionsResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetPendingActions400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	var status *domain.PendingActionStatus
+	if request.Params.Status != nil {
+		st := domain.PendingActionStatus(*request.Params.Status)
+		if !slices.Contains([]domain.PendingActionStatus{
+			domain.PendingActionStatusPending, domain.PendingActionStatusApproved, domain.PendingActionStatusExecuted,
+			domain.PendingActionStatusFailed, domain.PendingActionStatusRejected, domain.PendingActionStatusExpired,
+		}, st) {
+			return GetPendingActions400JSONResponse{N400JSONResponse{Message: "invalid status"}}, nil
+		}
+		status = &st
+	}
+
+	actions, err := s.pendingActionService.GetAll(ctx, *issuerDID, status)
+	if err != nil {
+		log.Error(ctx, "get pending actions", "err", err, "did", request.Identifier)
+		return GetPendingActions500JSONResponse{N500JSONResponse{"There was an error retrieving the pending actions"}}, nil
+	}
+
+	resp := make(GetPendingActions200JSONResponse, 0, len(actions))
+	for i := range actions {
+		resp = append(resp, pendingActionResponse(&actions[i]))
+	}
+
+	return resp, nil
+}
+
+// GetPendingAction returns an action held for approval
+func (s *Server) GetPendingAction(ctx context.Context, request GetPendingActionRequestObject) (GetPendingActionResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetPendingAction400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	action, err := s.pendingActionService.GetByID(ctx, *issuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrPendingActionDoesNotExist) {
+			return GetPendingAction404JSONResponse{N404JSONResponse{"The given pending action does not exist"}}, nil
+		}
+		log.Error(ctx, "get pending action", "err", err, "id", request.Id)
+		return GetPendingAction500JSONResponse{N500JSONResponse{"There was an error retrieving the pending action"}}, nil
+	}
+
+	return GetPendingAction200JSONResponse(pendingActionResponse(action)), nil
+}
+
+// ApprovePendingAction records the approval of the caller. The action is executed with the last required approval.
+func (s *Server) ApprovePendingAction(ctx context.Context, request ApprovePendingActionRequestObject) (ApprovePendingActionResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return ApprovePendingAction400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	approver, ok := callerFromContext(ctx)
+	if !ok {
+		return ApprovePendingAction401JSONResponse{N401JSONResponse{services.ErrInvalidApprover.Error()}}, nil
+	}
+	setAuditActor(ctx, approver)
+	action, err := s.pendingActionService.Approve(ctx, *issuerDID, request.Id, approver, decisionReason(request.Body))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidApprover):
+			return ApprovePendingAction401JSONResponse{N401JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrPendingActionDoesNotExist):
+			return ApprovePendingAction404JSONResponse{N404JSONResponse{"The given pending action does not exist"}}, nil
+		case errors.Is(err, services.ErrPendingActionNotPending), errors.Is(err, services.ErrPendingActionAlreadyDecided):
+			return ApprovePendingAction409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "approving pending action", "err", err, "id", request.Id)
+		return ApprovePendingAction500JSONResponse{N500JSONResponse{"There was an error approving the pending action"}}, nil
+	}
+
+	return ApprovePendingAction200JSONResponse(pendingActionResponse(action)), nil
+}
+
+// RejectPendingAction records the rejection of the caller, which rejects the action
+func (s *Server) RejectPendingAction(ctx context.Context, request RejectPendingActionRequestObject) (RejectPendingActionResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return RejectPendingAction400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	approver, ok := callerFromContext(ctx)
+	if !ok {
+		return RejectPendingAction401JSONResponse{N401JSONResponse{services.ErrInvalidApprover.Error()}}, nil
+	}
+	setAuditActor(ctx, approver)
+	action, err := s.pendingActionService.Reject(ctx, *issuerDID, request.Id, approver, decisionReason(request.Body))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidApprover):
+			return RejectPendingAction401JSONResponse{N401JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrPendingActionDoesNotExist):
+			return RejectPendingAction404JSONResponse{N404JSONResponse{"The given pending action does not exist"}}, nil
+		case errors.Is(err, services.ErrPendingActionNotPending), errors.Is(err, services.ErrPendingActionAlreadyDecided):
+			return RejectPendingAction409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "rejecting pending action", "err", err, "id", request.Id)
+		return RejectPendingAction500JSONResponse{N500JSONResponse{"There was an error rejecting the pending action"}}, nil
+	}
+
+	return RejectPendingAction200JSONResponse(pendingActionResponse(action)), nil
+}
+
+// PendingActionCallback receives the answer of a DID approver to the approval request of a pending action
+func (s *Server) PendingActionCallback(ctx context.Context, request PendingActionCallbackRequestObject) (PendingActionCallbackResponseObject, error) {
+	if request.Body == nil || *request.Body == "" {
+		log.Debug(ctx, "empty request body pending-action-callback request")
+		return PendingActionCallback400JSONResponse{N400JSONResponse{"Cannot proceed with empty body"}}, nil
+	}
+
+	action, err := s.pendingActionService.Verify(ctx, request.Params.Id, *request.Body)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidApprover):
+			return PendingActionCallback401JSONResponse{N401JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrPendingActionDoesNotExist):
+			return PendingActionCallback404JSONResponse{N404JSONResponse{"The given pending action does not exist"}}, nil
+		case errors.Is(err, services.ErrPendingActionNotPending), errors.Is(err, services.ErrPendingActionAlreadyDecided):
+			return PendingActionCallback409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "verifying pending action approval", "err", err, "id", request.Params.Id)
+		return PendingActionCallback500JSONResponse{N500JSONResponse{"There was an error approving the pending action"}}, nil
+	}
+
+	setAuditIdentifier(ctx, action.IssuerDID.String())
+	if n := len(action.Approvals); n > 0 {
+		setAuditActor(ctx, action.Approvals[n-1].Approver)
+	}
+	return PendingActionCallback200Response{}, nil
+}
+
+// decisionReason returns the reason of an approval or a rejection, if any
+func decisionReason(body *PendingActionDecisionRequest) *string {
+	if body == nil {
+		return nil
+	}
+	return body.Reason
+}
+
+// holdAction holds the operation as a pending action if it needs approval. It returns nil if the operation
+// can be executed right away.
+func (s *Server) holdAction(ctx context.Context, issuerDID w3c.DID, actionType domain.PendingActionType, credentials int, payload any) (*PendingActionAcceptedResponse, error) {
+	if !s.pendingActionService.IsRequired(actionType, credentials) {
+		return nil, nil
+	}
+	var requestedBy *string
+	if caller, ok := callerFromContext(ctx); ok {
+		requestedBy = &caller
+	}
+	action, err := s.pendingActionService.Hold(ctx, issuerDID, actionType, requestedBy, payload, s.cfg.ServerUrl)
+	if err != nil {
+		log.Error(ctx, "holding action for approval", "err", err, "type", actionType)
+		return nil, err
+	}
+	resp := PendingActionAcceptedResponse(pendingActionResponse(action))
+	return &resp, nil
+}
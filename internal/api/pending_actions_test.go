@@ -0,0 +1,279 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestServer_PendingActions(t *testing.T) {
+	const (
+		method     = "iden3"
+		blockchain = "privado"
+		network    = "main"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+
+	approvers := make(map[string]string)
+	approverIDs := make(map[string]string)
+	for _, name := range []string{"alice", "bob", "carol", "dave", "erin"} {
+		scopes := []domain.APIKeyScope{domain.APIKeyScopePendingActionsApprove}
+		if name == "dave" {
+			scopes = append(scopes, domain.APIKeyScopeKeysAdmin)
+		}
+		apiKey, token, err := server.Services.apiKeys.Create(ctx, ports.APIKeyRequest{Name: name, Scopes: scopes})
+		require.NoError(t, err)
+		approvers[name] = token
+		approverIDs[name] = apiKey.ID.String()
+	}
+	_, adminToken, err := server.Services.apiKeys.Create(ctx, ports.APIKeyRequest{Name: "admin", Scopes: []domain.APIKeyScope{domain.APIKeyScopeKeysAdmin}})
+	require.NoError(t, err)
+
+	server.pendingActionService = services.NewPendingAction(services.PendingActionConfig{
+		RequiredApprovals: 2,
+		Approvers:         []string{"apikey:" + approverIDs["alice"], "apikey:" + approverIDs["bob"], "apikey:" + approverIDs["carol"], "apikey:" + approverIDs["dave"]},
+		Operations:        []domain.PendingActionType{domain.PendingActionTypeCreateKey, domain.PendingActionTypePublishState, domain.PendingActionTypeRevokeCredentials},
+		Expiration:        time.Hour,
+	}, server.Repos.pendingActions, NewPublisherMock(), server.Services.credentials, server.connectionsService, server.Services.keyService, server.Services.payments, server.Services.qrs, nil, server.Infra.db)
+
+	iden, err := server.Services.identity.Create(ctx, "http://issuer-node", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	handler := getHandler(ctx, server)
+
+	holdKey := func(t *testing.T, name string) PendingAction {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		body := CreateKeyRequest{KeyType: CreateKeyRequestKeyType(KeyKeyTypeBabyjubJub), Name: name}
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/keys", did), tests.JSONBody(t, body))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusAccepted, rr.Code)
+		var action PendingAction
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &action))
+		return action
+	}
+
+	decide := func(t *testing.T, id string, decision string, token string, body PendingActionDecisionRequest) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/pending-actions/%s/%s", did, id, decision), tests.JSONBody(t, body))
+		require.NoError(t, err)
+		req.Header.Set(apiKeyHeader, token)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should execute the action with the required approvals", func(t *testing.T) {
+		action := holdKey(t, "approved-key")
+		assert.Equal(t, string(domain.PendingActionStatusPending), action.Status)
+		assert.Equal(t, string(domain.PendingActionTypeCreateKey), action.Type)
+		assert.Equal(t, 2, action.RequiredApprovals)
+		user, _ := authOk()
+		require.NotNil(t, action.RequestedBy)
+		assert.Equal(t, "basic:"+user, *action.RequestedBy)
+
+		rr := decide(t, action.Id, "approve", "wrong", PendingActionDecisionRequest{})
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+		rr = decide(t, action.Id, "approve", adminToken, PendingActionDecisionRequest{})
+		require.Equal(t, http.StatusForbidden, rr.Code)
+
+		rr = decide(t, action.Id, "approve", approvers["alice"], PendingActionDecisionRequest{})
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response PendingAction
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, string(domain.PendingActionStatusPending), response.Status)
+		assert.Len(t, response.Approvals, 1)
+
+		rr = decide(t, action.Id, "approve", approvers["alice"], PendingActionDecisionRequest{})
+		require.Equal(t, http.StatusConflict, rr.Code)
+
+		rr = decide(t, action.Id, "approve", approvers["bob"], PendingActionDecisionRequest{})
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, string(domain.PendingActionStatusExecuted), response.Status)
+		require.NotNil(t, response.Result)
+		assert.NotEmpty(t, (*response.Result)["id"])
+
+		rr = decide(t, action.Id, "approve", approvers["carol"], PendingActionDecisionRequest{})
+		require.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("should not accept approvals with basic auth", func(t *testing.T) {
+		action := holdKey(t, "basic-auth-key")
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/pending-actions/%s/approve", did, action.Id), tests.JSONBody(t, PendingActionDecisionRequest{}))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("should hold the retry of the state publication", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/state/retry", did), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusAccepted, rr.Code)
+		var action PendingAction
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &action))
+		assert.Equal(t, string(domain.PendingActionTypePublishState), action.Type)
+	})
+
+	t.Run("should hold the deletion of a connection that revokes its credentials", func(t *testing.T) {
+		userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5")
+		require.NoError(t, err)
+		fixture := repositories.NewFixture(storage)
+		conn := fixture.CreateConnection(t, &domain.Connection{
+			ID:         uuid.New(),
+			IssuerDID:  *did,
+			UserDID:    *userDID,
+			CreatedAt:  time.Now(),
+			ModifiedAt: time.Now(),
+		})
+		_ = fixture.CreateClaim(t, &domain.Claim{
+			Identifier:      common.ToPointer(did.String()),
+			Issuer:          did.String(),
+			OtherIdentifier: userDID.String(),
+			HIndex:          "20060639968773997271173557722944342103398298534714534718204282267207714246999",
+		})
+
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/v2/identities/%s/connections/%s?revokeCredentials=true", did, conn), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusAccepted, rr.Code)
+		var action PendingAction
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &action))
+		assert.Equal(t, string(domain.PendingActionTypeDeleteConnection), action.Type)
+
+		_, err = server.connectionsService.GetByIDAndIssuerID(ctx, conn, *did)
+		require.NoError(t, err)
+	})
+
+	t.Run("should not accept approvals of callers that are not approvers", func(t *testing.T) {
+		action := holdKey(t, "not-approver-key")
+		rr := decide(t, action.Id, "approve", approvers["erin"], PendingActionDecisionRequest{})
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+		rr = decide(t, action.Id, "reject", approvers["erin"], PendingActionDecisionRequest{})
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should not accept the approval of the requester", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		body := CreateKeyRequest{KeyType: CreateKeyRequestKeyType(KeyKeyTypeBabyjubJub), Name: "requested-key"}
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/keys", did), tests.JSONBody(t, body))
+		require.NoError(t, err)
+		req.Header.Set(apiKeyHeader, approvers["dave"])
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusAccepted, rr.Code)
+		var action PendingAction
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &action))
+		require.NotNil(t, action.RequestedBy)
+		assert.Equal(t, "apikey:"+approverIDs["dave"], *action.RequestedBy)
+
+		rr = decide(t, action.Id, "approve", approvers["dave"], PendingActionDecisionRequest{})
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+		rr = decide(t, action.Id, "approve", approvers["alice"], PendingActionDecisionRequest{})
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response PendingAction
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, string(domain.PendingActionStatusPending), response.Status)
+	})
+
+	t.Run("should reject the action", func(t *testing.T) {
+		action := holdKey(t, "rejected-key")
+		reason := "not requested"
+		rr := decide(t, action.Id, "reject", approvers["carol"], PendingActionDecisionRequest{Reason: &reason})
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response PendingAction
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, string(domain.PendingActionStatusRejected), response.Status)
+		require.NotNil(t, response.FailureReason)
+	})
+
+	t.Run("should audit the decisions with the approver", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/v2/audit-logs?identifier="+url.QueryEscape(did.String()), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response GetAuditLogs200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		actors := make(map[string][]string)
+		for _, entry := range response.Items {
+			if entry.Outcome == AuditLogOutcomeSuccess {
+				actors[entry.Action] = append(actors[entry.Action], entry.Actor)
+			}
+		}
+		assert.Contains(t, actors["ApprovePendingAction"], "apikey:"+approverIDs["alice"])
+		assert.Contains(t, actors["ApprovePendingAction"], "apikey:"+approverIDs["bob"])
+		assert.Contains(t, actors["RejectPendingAction"], "apikey:"+approverIDs["carol"])
+	})
+
+	t.Run("should list the pending actions by status", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/pending-actions?status=executed", did), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response GetPendingActions200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response, 1)
+		assert.Equal(t, string(domain.PendingActionStatusExecuted), response[0].Status)
+	})
+
+	t.Run("should return not found", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/pending-actions/%s", did, "b8a5b8a1-4b3c-4f2e-9a6b-3d2c1e0f9a8b"), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("should execute the approved actions that were not executed", func(t *testing.T) {
+		action := holdKey(t, "stuck-key")
+		id, err := uuid.Parse(action.Id)
+		require.NoError(t, err)
+		stored, err := server.Repos.pendingActions.GetByID(ctx, server.Infra.db.Pgx, id)
+		require.NoError(t, err)
+		stored.Status = domain.PendingActionStatusApproved
+		require.NoError(t, server.Repos.pendingActions.Save(ctx, server.Infra.db.Pgx, stored))
+
+		require.NoError(t, server.pendingActionService.ExecuteApproved(ctx))
+		executed, err := server.pendingActionService.GetByID(ctx, *did, id)
+		require.NoError(t, err)
+		assert.Equal(t, domain.PendingActionStatusExecuted, executed.Status)
+		assert.NotEmpty(t, executed.Result)
+	})
+}
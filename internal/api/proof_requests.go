@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// CreateProofRequest creates a proof request for a connection or a public one
+func (s *Server) CreateProofRequest(ctx context.Context, request CreateProofRequestRequestObject) (CreateProofRequestResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return CreateProofRequest400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	req := &ports.CreateProofRequestRequest{
+		ConnectionID: request.Body.ConnectionID,
+		Scope:        request.Body.Scope,
+	}
+	if request.Body.Reason != nil {
+		req.Reason = *request.Body.Reason
+	}
+
+	proofRequest, err := s.proofRequestService.Create(ctx, *issuerDID, req, s.cfg.ServerUrl)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrConnectionDoesNotExist):
+			return CreateProofRequest404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+		case errors.Is(err, services.ErrInvalidProofRequest), errors.Is(err, services.ErrConnectionWithoutPushService):
+			return CreateProofRequest400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "create proof request", "err", err)
+		return CreateProofRequest500JSONResponse{N500JSONResponse{"There was an error creating the proof request"}}, nil
+	}
+
+	return CreateProofRequest201JSONResponse(proofRequestResponse(proofRequest)), nil
+}
+
+// GetProofRequests returns the proof requests of the identity
+func (s *Server) GetProofRequests(ctx context.Context, request GetProofRequestsRequestObject) (GetProofRequestsResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetProofRequests400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	proofRequests, err := s.proofRequestService.GetAll(ctx, *issuerDID, request.Params.ConnectionID)
+	if err != nil {
+		log.Error(ctx, "get proof requests", "err", err)
+		return GetProofRequests500JSONResponse{N500JSONResponse{"There was an error retrieving the proof requests"}}, nil
+	}
+
+	resp := make(GetProofRequests200JSONResponse, 0, len(proofRequests))
+	for i := range proofRequests {
+		resp = append(resp, proofRequestResponse(&proofRequests[i]))
+	}
+
+	return resp, nil
+}
+
+// GetProofRequest returns a proof request
+func (s *Server) GetProofRequest(ctx context.Context, request GetProofRequestRequestObject) (GetProofRequestResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetProofRequest400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	proofRequest, err := s.proofRequestService.GetByID(ctx, *issuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrProofRequestDoesNotExist) {
+			return GetProofRequest404JSONResponse{N404JSONResponse{"The given proof request does not exist"}}, nil
+		}
+		log.Error(ctx, "get proof request", "err", err, "id", request.Id)
+		return GetProofRequest500JSONResponse{N500JSONResponse{"There was an error retrieving the proof request"}}, nil
+	}
+
+	return GetProofRequest200JSONResponse(proofRequestResponse(proofRequest)), nil
+}
+
+// ProofRequestCallback receives the holder answer to a proof request
+func (s *Server) ProofRequestCallback(ctx context.Context, request ProofRequestCallbackRequestObject) (ProofRequestCallbackResponseObject, error) {
+	if request.Body == nil || *request.Body == "" {
+		log.Debug(ctx, "empty request body proof-request-callback request")
+		return ProofRequestCallback400JSONResponse{N400JSONResponse{"Cannot proceed with empty body"}}, nil
+	}
+
+	if _, err := s.proofRequestService.Verify(ctx, request.Params.Id, *request.Body, s.cfg.ServerUrl); err != nil {
+		switch {
+		case errors.Is(err, services.ErrProofRequestDoesNotExist):
+			return ProofRequestCallback404JSONResponse{N404JSONResponse{"The given proof request does not exist"}}, nil
+		case errors.Is(err, services.ErrProofRequestAlreadyProcessed), errors.Is(err, services.ErrProofRequestVerificationFailed):
+			return ProofRequestCallback400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "verifying proof request", "err", err, "id", request.Params.Id)
+		return ProofRequestCallback500JSONResponse{N500JSONResponse{"There was an error verifying the proof request"}}, nil
+	}
+
+	return ProofRequestCallback200Response{}, nil
+}
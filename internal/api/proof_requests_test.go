@@ -0,0 +1,284 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestServer_CreateProofRequest(t *testing.T) {
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	fixture := repositories.NewFixture(storage)
+	issuerDID, err := w3c.ParseDID("did:iden3:polygon:mumbai:wzokvZ6kMoocKJuSbftdZxTD6qvayGpJb3m4FVXth")
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5")
+	require.NoError(t, err)
+
+	connWithoutPush := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDID,
+		UserDoc:    json.RawMessage(`{"id": "did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5", "@context": ["https://www.w3.org/ns/did/v1"]}`),
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	scope := []ZeroKnowledgeProofRequest{
+		{
+			ID:        1,
+			CircuitID: "credentialAtomicQuerySigV2",
+			Query: map[string]interface{}{
+				"allowedIssuers": []string{"*"},
+				"context":        "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/kyc-v3.json-ld",
+				"type":           "KYCAgeCredential",
+				"credentialSubject": map[string]interface{}{
+					"birthday": map[string]interface{}{"$lt": 20000101},
+				},
+			},
+		},
+	}
+
+	type expected struct {
+		httpCode int
+	}
+
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		body     CreateProofRequestRequest
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name: "No auth header",
+			auth: authWrong,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name: "empty scope",
+			auth: authOk,
+			body: CreateProofRequestRequest{},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "non existing connection",
+			auth: authOk,
+			body: CreateProofRequestRequest{ConnectionID: common.ToPointer(uuid.New()), Scope: scope},
+			expected: expected{
+				httpCode: http.StatusNotFound,
+			},
+		},
+		{
+			name: "connection without push service",
+			auth: authOk,
+			body: CreateProofRequestRequest{ConnectionID: &connWithoutPush, Scope: scope},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "should create a public proof request",
+			auth: authOk,
+			body: CreateProofRequestRequest{Reason: common.ToPointer("age check"), Scope: scope},
+			expected: expected{
+				httpCode: http.StatusCreated,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v2/identities/%s/proof-requests", issuerDID)
+			req, err := http.NewRequest(http.MethodPost, url, tests.JSONBody(t, tc.body))
+			req.SetBasicAuth(tc.auth())
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			if tc.expected.httpCode == http.StatusCreated {
+				var response CreateProofRequest201JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, "age check", response.Reason)
+				assert.Equal(t, string(domain.ProofRequestStatusPending), response.Status)
+				assert.Nil(t, response.ConnectionID)
+				require.NotNil(t, response.QrCode)
+				assert.Equal(t, protocol.AuthorizationRequestMessageType, response.Message.Type)
+				assert.Contains(t, response.Message.Body.CallbackURL, "/v2/proof-requests/callback?id="+response.Id)
+				assert.Empty(t, response.Results)
+
+				rr = httptest.NewRecorder()
+				req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", url, response.Id), nil)
+				req.SetBasicAuth(authOk())
+				require.NoError(t, err)
+				handler.ServeHTTP(rr, req)
+				require.Equal(t, http.StatusOK, rr.Code)
+
+				var proofRequest GetProofRequest200JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &proofRequest))
+				assert.Equal(t, response.Id, proofRequest.Id)
+			}
+		})
+	}
+}
+
+func TestServer_GetProofRequests(t *testing.T) {
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	fixture := repositories.NewFixture(storage)
+	issuerDID, err := w3c.ParseDID("did:iden3:polygon:mumbai:wzokvZ6kMoocKJuSbftdZxTD6qvayGpJb3m4FVXth")
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qFBp1sRF1bFbTybVHHZQRgSWE2nKrdWeAxyZ67PdG")
+	require.NoError(t, err)
+
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	proofRequest := &domain.ProofRequest{
+		ID:           uuid.New(),
+		IssuerDID:    *issuerDID,
+		ConnectionID: &connID,
+		Reason:       "age check",
+		Message: protocol.AuthorizationRequestMessage{
+			ID:   uuid.NewString(),
+			Type: protocol.AuthorizationRequestMessageType,
+			From: issuerDID.String(),
+		},
+		Status:    domain.ProofRequestStatusPending,
+		CreatedAt: time.Now(),
+	}
+	require.NoError(t, repositories.NewProofRequest().Save(ctx, storage.Pgx, proofRequest))
+
+	type expected struct {
+		httpCode int
+		count    int
+	}
+
+	type testConfig struct {
+		name         string
+		auth         func() (string, string)
+		connectionID *uuid.UUID
+		expected     expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name: "No auth header",
+			auth: authWrong,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:         "should return the proof requests of the connection",
+			auth:         authOk,
+			connectionID: &connID,
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    1,
+			},
+		},
+		{
+			name:         "should return no proof requests for other connection",
+			auth:         authOk,
+			connectionID: common.ToPointer(uuid.New()),
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    0,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v2/identities/%s/proof-requests", issuerDID)
+			if tc.connectionID != nil {
+				url += "?connectionID=" + tc.connectionID.String()
+			}
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			req.SetBasicAuth(tc.auth())
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			if tc.expected.httpCode == http.StatusOK {
+				var response GetProofRequests200JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Len(t, response, tc.expected.count)
+			}
+		})
+	}
+}
+
+func TestServer_ProofRequestCallback(t *testing.T) {
+	server := newTestServer(t, nil)
+	handler := getHandler(context.Background(), server)
+
+	type expected struct {
+		httpCode int
+	}
+
+	type testConfig struct {
+		name     string
+		body     string
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name: "should get an error no body",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "should get an error with a non existing proof request",
+			body: "some.jwz.token",
+			expected: expected{
+				httpCode: http.StatusNotFound,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			url := "/v2/proof-requests/callback?id=" + uuid.NewString()
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", "text/plain")
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+		})
+	}
+}
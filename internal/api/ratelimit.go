@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	apiErrors "github.com/polygonid/sh-id-platform/internal/errors"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/metrics"
+	"github.com/polygonid/sh-id-platform/internal/ratelimit"
+)
+
+// Classes of the public endpoints. Each class has its own limits.
+const (
+	rateLimitClassAgent            = "agent"
+	rateLimitClassCallback         = "callback"
+	rateLimitClassSession          = "session"
+	rateLimitClassApproval         = "approval"
+	rateLimitClassQrStore          = "qrStore"
+	rateLimitClassRevocationStatus = "revocationStatus"
+)
+
+// rateLimitClasses are the classes of the rate limited operations
+var rateLimitClasses = map[string]string{
+	"Agent":                    rateLimitClassAgent,
+	"AgentV1":                  rateLimitClassAgent,
+	"AuthCallback":             rateLimitClassCallback,
+	"CreateLinkQrCodeCallback": rateLimitClassCallback,
+	"ProofRequestCallback":     rateLimitClassCallback,
+	"ConnectionMergeCallback":  rateLimitClassCallback,
+	"PendingActionCallback":    rateLimitClassCallback,
+	"Authentication":           rateLimitClassSession,
+	"CreateLinkOffer":          rateLimitClassSession,
+	"ApprovePendingAction":     rateLimitClassApproval,
+	"RejectPendingAction":      rateLimitClassApproval,
+	"GetQrFromStore":           rateLimitClassQrStore,
+	"GetRevocationStatus":      rateLimitClassRevocationStatus,
+	"GetRevocationStatusV2":    rateLimitClassRevocationStatus,
+}
+
+// RateLimitMiddleware returns a middleware that limits the requests of each client ip to the public endpoints, with
+// a limit for each class of endpoint. Requests over the limit are answered with a 429 and a Retry-After header.
+// A nil limiter disables the limits.
+func RateLimitMiddleware(_ context.Context, limiter *ratelimit.Limiter, cfg config.RateLimit) StrictMiddlewareFunc {
+	limits := map[string]ratelimit.Limit{
+		rateLimitClassAgent:            {Requests: cfg.AgentIP, Window: cfg.Window},
+		rateLimitClassCallback:         {Requests: cfg.CallbackIP, Window: cfg.Window},
+		rateLimitClassSession:          {Requests: cfg.SessionIP, Window: cfg.Window},
+		rateLimitClassApproval:         {Requests: cfg.ApprovalIP, Window: cfg.Window},
+		rateLimitClassQrStore:          {Requests: cfg.QrStoreIP, Window: cfg.Window},
+		rateLimitClassRevocationStatus: {Requests: cfg.RevocationStatusIP, Window: cfg.Window},
+	}
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		class, ok := rateLimitClasses[operationID]
+		if !ok || limiter == nil || !limits[class].Enabled() {
+			return f
+		}
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			ip := ratelimit.ClientIP(r, cfg.ClientIPHeader)
+			allowed, retryAfter, err := limiter.Allow(ctxReq, class+":ip:"+ip, limits[class])
+			if err != nil {
+				log.Error(ctxReq, "checking rate limit, the request is allowed", "err", err, "class", class)
+				return f(ctxReq, w, r, args)
+			}
+			if !allowed {
+				log.Info(ctxReq, "rate limit exceeded", "class", class, "ip", ip)
+				metrics.RateLimited(class, "ip")
+				return nil, apiErrors.TooManyRequestsError{Err: errors.New("too many requests"), RetryAfter: retryAfter}
+			}
+			return f(ctxReq, w, r, args)
+		}
+	}
+}
+
+// allowHolder counts an agent request of the holder did against its limit. When the holder is over the limit it
+// returns false and the seconds until the holder can retry. Only the signed and zkp messages are counted, the sender
+// of a plain message is not authenticated and could spend the limit of another holder.
+func (s *Server) allowHolder(ctx context.Context, holderDID string, mediaType iden3comm.MediaType) (bool, int) {
+	if s.rateLimiter == nil || holderDID == "" {
+		return true, 0
+	}
+	if mediaType != packers.MediaTypeSignedMessage && mediaType != packers.MediaTypeZKPMessage {
+		return true, 0
+	}
+	limit := ratelimit.Limit{Requests: s.cfg.RateLimit.AgentDID, Window: s.cfg.RateLimit.Window}
+	allowed, retryAfter, err := s.rateLimiter.Allow(ctx, rateLimitClassAgent+":did:"+holderDID, limit)
+	if err != nil {
+		log.Error(ctx, "checking rate limit, the request is allowed", "err", err, "holder", holderDID)
+		return true, 0
+	}
+	if !allowed {
+		log.Info(ctx, "rate limit exceeded", "class", rateLimitClassAgent, "holder", holderDID)
+		metrics.RateLimited(rateLimitClassAgent, "did")
+		return false, apiErrors.RetryAfterSeconds(retryAfter)
+	}
+	return true, 0
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/cache"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/errors"
+	"github.com/polygonid/sh-id-platform/internal/ratelimit"
+)
+
+func TestRateLimitMiddleware(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.RateLimit{Window: time.Minute, ClientIPHeader: "X-Forwarded-For", AgentIP: 2, QrStoreIP: 1, ApprovalIP: 1}
+	middleware := RateLimitMiddleware(ctx, ratelimit.New(cache.NewMemoryCache()), cfg)
+
+	call := func(operationID string, ip string) *httptest.ResponseRecorder {
+		handler := middleware(func(_ context.Context, w http.ResponseWriter, _ *http.Request, _ interface{}) (interface{}, error) {
+			w.WriteHeader(http.StatusOK)
+			return nil, nil
+		}, operationID)
+		r := httptest.NewRequest(http.MethodPost, "/", nil)
+		r.Header.Set("X-Forwarded-For", ip)
+		rr := httptest.NewRecorder()
+		_, err := handler(ctx, rr, r, nil)
+		if err != nil {
+			errors.ResponseErrorHandlerFunc(rr, r, err)
+		}
+		return rr
+	}
+
+	t.Run("should reject the requests over the limit of the class", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, call("Agent", "203.0.113.1").Code)
+		assert.Equal(t, http.StatusOK, call("AgentV1", "203.0.113.1").Code)
+		rr := call("Agent", "203.0.113.1")
+		require.Equal(t, http.StatusTooManyRequests, rr.Code)
+		retryAfter, err := strconv.Atoi(rr.Header().Get("Retry-After"))
+		require.NoError(t, err)
+		assert.Greater(t, retryAfter, 0)
+		assert.LessOrEqual(t, retryAfter, 60)
+		assert.JSONEq(t, `{"message":"too many requests"}`, rr.Body.String())
+	})
+
+	t.Run("should count each client and class apart", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, call("Agent", "203.0.113.2").Code)
+		assert.Equal(t, http.StatusOK, call("GetQrFromStore", "203.0.113.1").Code)
+		assert.Equal(t, http.StatusTooManyRequests, call("GetQrFromStore", "203.0.113.1").Code)
+	})
+
+	t.Run("should limit the decisions on pending actions", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, call("ApprovePendingAction", "203.0.113.3").Code)
+		assert.Equal(t, http.StatusTooManyRequests, call("RejectPendingAction", "203.0.113.3").Code)
+	})
+
+	t.Run("should not limit disabled classes and private endpoints", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, http.StatusOK, call("AuthCallback", "203.0.113.1").Code)
+			assert.Equal(t, http.StatusOK, call("GetIdentities", "203.0.113.1").Code)
+		}
+	})
+}
+
+func TestServer_allowHolder(t *testing.T) {
+	ctx := context.Background()
+	server := &Server{
+		cfg:         &config.Configuration{RateLimit: config.RateLimit{Window: time.Minute, AgentDID: 1}},
+		rateLimiter: ratelimit.New(cache.NewMemoryCache()),
+	}
+	const holder = "did:iden3:polygon:amoy:x6x5sor7zpxsu478u36QvEgaRUfPjmzqFo5PHHzbb"
+
+	t.Run("should not count the plain messages", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			allowed, _ := server.allowHolder(ctx, holder, packers.MediaTypePlainMessage)
+			assert.True(t, allowed)
+		}
+	})
+
+	t.Run("should limit the authenticated messages of the holder", func(t *testing.T) {
+		allowed, _ := server.allowHolder(ctx, holder, packers.MediaTypeZKPMessage)
+		assert.True(t, allowed)
+		allowed, retryAfter := server.allowHolder(ctx, holder, packers.MediaTypeSignedMessage)
+		assert.False(t, allowed)
+		assert.Greater(t, retryAfter, 0)
+	})
+}
@@ -177,9 +177,30 @@ func connectionResponse(conn *domain.Connection, credentials []*domain.Claim) (G
 		UserID:      conn.UserDID.String(),
 		IssuerID:    conn.IssuerDID.String(),
 		Credentials: credResp,
+		Tags:        conn.Tags,
+		Notes:       conn.Notes,
 	}, nil
 }
 
+func authenticationSessionResponse(session *domain.AuthSession) AuthenticationSession {
+	resp := AuthenticationSession{
+		Id:            session.ID.String(),
+		IssuerID:      session.IssuerDID,
+		Status:        string(session.CurrentStatus()),
+		UserID:        session.UserDID,
+		FailureReason: session.FailureReason,
+		CreatedAt:     TimeUTC(session.CreatedAt),
+		ExpiresAt:     TimeUTC(session.ExpiresAt),
+	}
+	if session.ConnectionID != nil {
+		resp.ConnectionID = common.ToPointer(session.ConnectionID.String())
+	}
+	if session.CompletedAt != nil {
+		resp.CompletedAt = common.ToPointer(TimeUTC(*session.CompletedAt))
+	}
+	return resp
+}
+
 func connectionsResponse(conns []domain.Connection) (GetConnectionsResponse, error) {
 	resp := make([]GetConnectionResponse, 0)
 
@@ -197,6 +218,107 @@ func connectionsResponse(conns []domain.Connection) (GetConnectionsResponse, err
 	return resp, nil
 }
 
+func connectionMessageResponse(message *domain.ConnectionMessage) ConnectionMessage {
+	body := make(map[string]interface{})
+	_ = json.Unmarshal(message.Body, &body)
+	resp := ConnectionMessage{
+		Id:            message.ID.String(),
+		ConnectionID:  message.ConnectionID.String(),
+		Type:          message.Type,
+		ThreadID:      message.ThreadID,
+		Body:          body,
+		Status:        string(message.Status),
+		FailureReason: message.FailureReason,
+		CreatedAt:     TimeUTC(message.CreatedAt),
+	}
+	if message.DeliveredAt != nil {
+		resp.DeliveredAt = common.ToPointer(TimeUTC(*message.DeliveredAt))
+	}
+	return resp
+}
+
+func connectionMergeResponse(merge *domain.ConnectionMerge) ConnectionMerge {
+	resp := ConnectionMerge{
+		Id:                  merge.ID.String(),
+		IssuerID:            merge.IssuerDID.String(),
+		ConnectionID:        merge.ConnectionID.String(),
+		MergedUserID:        merge.MergedUserDID,
+		Method:              string(merge.Method),
+		Status:              string(merge.Status),
+		Reason:              merge.Reason,
+		ReissuedCredentials: merge.ReissuedCredentials,
+		FailureReason:       merge.FailureReason,
+		CreatedAt:           TimeUTC(merge.CreatedAt),
+	}
+	if resp.ReissuedCredentials == nil {
+		resp.ReissuedCredentials = []string{}
+	}
+	if merge.MergedConnectionID != nil {
+		resp.MergedConnectionID = common.ToPointer(merge.MergedConnectionID.String())
+	}
+	if merge.Status == domain.ConnectionMergeStatusPending {
+		resp.QrCode = merge.QRCodeURL
+	}
+	if merge.CompletedAt != nil {
+		resp.CompletedAt = common.ToPointer(TimeUTC(*merge.CompletedAt))
+	}
+	return resp
+}
+
+func proofRequestResponse(request *domain.ProofRequest) ProofRequest {
+	responses := make([]ProofRequestResponse, 0, len(request.Responses))
+	for _, response := range request.Responses {
+		item := ProofRequestResponse{
+			Id:        response.ID.String(),
+			UserID:    response.UserDID,
+			Results:   proofRequestResults(response.Results),
+			CreatedAt: TimeUTC(response.CreatedAt),
+		}
+		if response.ConnectionID != nil {
+			item.ConnectionID = common.ToPointer(response.ConnectionID.String())
+		}
+		responses = append(responses, item)
+	}
+
+	resp := ProofRequest{
+		Id:            request.ID.String(),
+		IssuerID:      request.IssuerDID.String(),
+		UserID:        request.UserDID,
+		Reason:        request.Reason,
+		Status:        string(request.Status),
+		QrCode:        request.QRCodeURL,
+		Message:       request.Message,
+		Results:       proofRequestResults(request.Results),
+		Responses:     responses,
+		FailureReason: request.FailureReason,
+		CreatedAt:     TimeUTC(request.CreatedAt),
+	}
+	if request.ConnectionID != nil {
+		resp.ConnectionID = common.ToPointer(request.ConnectionID.String())
+	}
+	if request.VerifiedAt != nil {
+		resp.VerifiedAt = common.ToPointer(TimeUTC(*request.VerifiedAt))
+	}
+
+	return resp
+}
+
+func proofRequestResults(results []domain.ProofRequestResult) []ProofRequestResult {
+	resp := make([]ProofRequestResult, 0, len(results))
+	for _, result := range results {
+		item := ProofRequestResult{
+			Id:        int(result.ID),
+			CircuitId: result.CircuitID,
+			Outputs:   result.Outputs,
+		}
+		if len(result.Disclosed) > 0 {
+			item.Disclosed = common.ToPointer(result.Disclosed)
+		}
+		resp = append(resp, item)
+	}
+	return resp
+}
+
 func connectionsPaginatedResponse(conns []domain.Connection, pagFilter pagination.Filter, total uint) (ConnectionsPaginated, error) {
 	resp, err := connectionsResponse(conns)
 	if err != nil {
@@ -302,6 +424,18 @@ func toGetPaymentOptionsResponse(opts []domain.PaymentOption) (PaymentOptions, e
 	return res, nil
 }
 
+func toDIDDocument(didDoc *verifiable.DIDDocument) (DIDDocument, error) {
+	var doc DIDDocument
+	raw, err := json.Marshal(didDoc)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
 func toPaymentOption(opt *domain.PaymentOption) (PaymentOption, error) {
 	var config map[string]interface{}
 	raw, err := json.Marshal(opt.Config)
@@ -421,3 +555,43 @@ func toCreatePaymentRequestResponseStatus(status domain.PaymentRequestStatus) (C
 		return CreatePaymentRequestResponseStatusNotVerified, fmt.Errorf("unknown payment status <%s>", status)
 	}
 }
+
+func pendingActionResponse(action *domain.PendingAction) PendingAction {
+	approvals := make([]PendingActionApproval, 0, len(action.Approvals))
+	for _, approval := range action.Approvals {
+		approvals = append(approvals, PendingActionApproval{
+			Approver:  approval.Approver,
+			Decision:  string(approval.Decision),
+			Reason:    approval.Reason,
+			CreatedAt: TimeUTC(approval.CreatedAt),
+		})
+	}
+
+	resp := PendingAction{
+		Id:                action.ID.String(),
+		IssuerID:          action.IssuerDID.String(),
+		Type:              string(action.Type),
+		RequestedBy:       action.RequestedBy,
+		Payload:           map[string]interface{}{},
+		Status:            string(action.Status),
+		RequiredApprovals: action.RequiredApprovals,
+		Approvals:         approvals,
+		FailureReason:     action.FailureReason,
+		CreatedAt:         TimeUTC(action.CreatedAt),
+		ExpiresAt:         TimeUTC(action.ExpiresAt),
+	}
+	_ = json.Unmarshal(action.Payload, &resp.Payload)
+	if len(action.Result) > 0 {
+		var result map[string]interface{}
+		if err := json.Unmarshal(action.Result, &result); err == nil {
+			resp.Result = &result
+		}
+	}
+	if action.Status == domain.PendingActionStatusPending {
+		resp.QrCode = action.QRCodeURL
+	}
+	if action.CompletedAt != nil {
+		resp.CompletedAt = common.ToPointer(TimeUTC(*action.CompletedAt))
+	}
+	return resp
+}
@@ -12,48 +12,68 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/health"
 	"github.com/polygonid/sh-id-platform/internal/network"
+	"github.com/polygonid/sh-id-platform/internal/ratelimit"
+	"github.com/polygonid/sh-id-platform/internal/timeapi"
 )
 
 // Server implements StrictServerInterface and holds the implementation of all API controllers
 // This is the glue to the API autogenerated code
 type Server struct {
-	cfg                  *config.Configuration
-	accountService       ports.AccountService
-	claimService         ports.ClaimService
-	connectionsService   ports.ConnectionService
-	health               *health.Status
-	identityService      ports.IdentityService
-	linkService          ports.LinkService
-	networkResolver      network.Resolver
-	packageManager       *iden3comm.PackageManager
-	publisherGateway     ports.Publisher
-	qrService            ports.QrStoreService
-	schemaService        ports.SchemaService
-	paymentService       ports.PaymentService
-	displayMethodService ports.DisplayMethodService
-	keyService           ports.KeyService
-	discoveryService     ports.DiscoveryService
+	cfg                    *config.Configuration
+	accountService         ports.AccountService
+	claimService           ports.ClaimService
+	connectionsService     ports.ConnectionService
+	health                 *health.Status
+	identityService        ports.IdentityService
+	linkService            ports.LinkService
+	networkResolver        network.Resolver
+	packageManager         *iden3comm.PackageManager
+	publisherGateway       ports.Publisher
+	qrService              ports.QrStoreService
+	schemaService          ports.SchemaService
+	paymentService         ports.PaymentService
+	displayMethodService   ports.DisplayMethodService
+	keyService             ports.KeyService
+	discoveryService       ports.DiscoveryService
+	proofRequestService    ports.ProofRequestService
+	connectionMergeService ports.ConnectionMergeService
+	pendingActionService   ports.PendingActionService
+	keyUsageService        ports.KeyUsageService
+	apiKeyService          ports.APIKeyService
+	tenantService          ports.TenantService
+	auditLogService        ports.AuditLogService
+	webhookService         ports.WebhookService
+	rateLimiter            *ratelimit.Limiter
 }
 
 // NewServer is a Server constructor
-func NewServer(cfg *config.Configuration, identityService ports.IdentityService, accountService ports.AccountService, connectionsService ports.ConnectionService, claimsService ports.ClaimService, qrService ports.QrStoreService, publisherGateway ports.Publisher, packageManager *iden3comm.PackageManager, networkResolver network.Resolver, health *health.Status, schemaService ports.SchemaService, linkService ports.LinkService, displayMethodService ports.DisplayMethodService, keyService ports.KeyService, paymentService ports.PaymentService, discoveryService ports.DiscoveryService) *Server {
+func NewServer(cfg *config.Configuration, identityService ports.IdentityService, accountService ports.AccountService, connectionsService ports.ConnectionService, claimsService ports.ClaimService, qrService ports.QrStoreService, publisherGateway ports.Publisher, packageManager *iden3comm.PackageManager, networkResolver network.Resolver, health *health.Status, schemaService ports.SchemaService, linkService ports.LinkService, displayMethodService ports.DisplayMethodService, keyService ports.KeyService, paymentService ports.PaymentService, discoveryService ports.DiscoveryService, proofRequestService ports.ProofRequestService, connectionMergeService ports.ConnectionMergeService, pendingActionService ports.PendingActionService, keyUsageService ports.KeyUsageService, apiKeyService ports.APIKeyService, tenantService ports.TenantService, auditLogService ports.AuditLogService, webhookService ports.WebhookService, rateLimiter *ratelimit.Limiter) *Server {
 	return &Server{
-		cfg:                  cfg,
-		accountService:       accountService,
-		claimService:         claimsService,
-		connectionsService:   connectionsService,
-		health:               health,
-		identityService:      identityService,
-		linkService:          linkService,
-		networkResolver:      networkResolver,
-		publisherGateway:     publisherGateway,
-		packageManager:       packageManager,
-		qrService:            qrService,
-		schemaService:        schemaService,
-		displayMethodService: displayMethodService,
-		keyService:           keyService,
-		discoveryService:     discoveryService,
-		paymentService:       paymentService,
+		cfg:                    cfg,
+		accountService:         accountService,
+		claimService:           claimsService,
+		connectionsService:     connectionsService,
+		health:                 health,
+		identityService:        identityService,
+		linkService:            linkService,
+		networkResolver:        networkResolver,
+		publisherGateway:       publisherGateway,
+		packageManager:         packageManager,
+		qrService:              qrService,
+		schemaService:          schemaService,
+		displayMethodService:   displayMethodService,
+		keyService:             keyService,
+		discoveryService:       discoveryService,
+		paymentService:         paymentService,
+		proofRequestService:    proofRequestService,
+		connectionMergeService: connectionMergeService,
+		pendingActionService:   pendingActionService,
+		keyUsageService:        keyUsageService,
+		apiKeyService:          apiKeyService,
+		tenantService:          tenantService,
+		auditLogService:        auditLogService,
+		webhookService:         webhookService,
+		rateLimiter:            rateLimiter,
 	}
 }
 
@@ -64,6 +84,30 @@ func (s *Server) Health(_ context.Context, _ HealthRequestObject) (HealthRespons
 	return resp, nil
 }
 
+// Liveness is the liveness probe of the node
+func (s *Server) Liveness(_ context.Context, _ LivenessRequestObject) (LivenessResponseObject, error) {
+	return Liveness200JSONResponse{Status: "ok"}, nil
+}
+
+// Readiness is the readiness probe of the node. It returns the last check of each dependency. The errors are not
+// returned, as they can contain addresses, balances or internal urls, they are logged by the checks.
+func (s *Server) Readiness(_ context.Context, _ ReadinessRequestObject) (ReadinessResponseObject, error) {
+	report := s.health.Report()
+	readiness := Readiness{Ready: report.Ready, Degraded: report.Degraded, Checks: make(map[string]HealthCheck, len(report.Checks))}
+	for service, check := range report.Checks {
+		readiness.Checks[service] = HealthCheck{
+			Healthy:   check.Healthy,
+			Critical:  check.Critical,
+			LatencyMs: check.LatencyMs,
+			CheckedAt: timeapi.Time(check.CheckedAt),
+		}
+	}
+	if !report.Ready {
+		return Readiness503JSONResponse(readiness), nil
+	}
+	return Readiness200JSONResponse(readiness), nil
+}
+
 // RegisterStatic add method to the mux that are not documented in the API.
 func RegisterStatic(mux *chi.Mux) {
 	mux.Get("/", documentation)
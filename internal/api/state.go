@@ -8,6 +8,7 @@ import (
 
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
@@ -22,6 +23,14 @@ func (s *Server) PublishIdentityState(ctx context.Context, request PublishIdenti
 		return PublishIdentityState400JSONResponse{N400JSONResponse{"invalid did"}}, nil
 	}
 
+	held, err := s.holdAction(ctx, *did, domain.PendingActionTypePublishState, 0, domain.PublishStatePayload{})
+	if err != nil {
+		return PublishIdentityState500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	if held != nil {
+		return *held, nil
+	}
+
 	publishedState, err := s.publisherGateway.PublishState(ctx, did)
 	if err != nil {
 		if errors.Is(err, gateways.ErrNoStatesToProcess) || errors.Is(err, gateways.ErrStateIsBeingProcessed) {
@@ -52,6 +61,14 @@ func (s *Server) RetryPublishState(ctx context.Context, request RetryPublishStat
 		return RetryPublishState400JSONResponse{N400JSONResponse{"invalid did"}}, nil
 	}
 
+	held, err := s.holdAction(ctx, *did, domain.PendingActionTypePublishState, 0, domain.PublishStatePayload{Retry: true})
+	if err != nil {
+		return RetryPublishState500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	if held != nil {
+		return *held, nil
+	}
+
 	publishedState, err := s.publisherGateway.RetryPublishState(ctx, did)
 	if err != nil {
 		log.Error(ctx, "error retrying the publishing the state", "err", err)
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+type tenantContextKey struct{}
+
+// contextWithTenant returns a context for the requests of the given tenant
+func contextWithTenant(ctx context.Context, tenantID uuid.UUID) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, tenantID)
+}
+
+// tenantFromContext returns the tenant of the request, or nil if it was not made on behalf of a tenant
+func tenantFromContext(ctx context.Context) *uuid.UUID {
+	tenantID, ok := ctx.Value(tenantContextKey{}).(uuid.UUID)
+	if !ok {
+		return nil
+	}
+	return &tenantID
+}
+
+// GetTenants is the handler for the GET /v2/tenants endpoint.
+func (s *Server) GetTenants(ctx context.Context, _ GetTenantsRequestObject) (GetTenantsResponseObject, error) {
+	tenants, err := s.tenantService.GetAll(ctx)
+	if err != nil {
+		log.Error(ctx, "getting tenants", "err", err)
+		return GetTenants500JSONResponse{N500JSONResponse{Message: "There was an error getting the tenants"}}, nil
+	}
+	resp := make(GetTenants200JSONResponse, 0, len(tenants))
+	for i := range tenants {
+		resp = append(resp, tenantResponse(&tenants[i], nil))
+	}
+	return resp, nil
+}
+
+// CreateTenant is the handler for the POST /v2/tenants endpoint.
+func (s *Server) CreateTenant(ctx context.Context, request CreateTenantRequestObject) (CreateTenantResponseObject, error) {
+	tenant, err := s.tenantService.Create(ctx, ports.TenantRequest{
+		Name:                   &request.Body.Name,
+		MaxIdentities:          request.Body.MaxIdentities,
+		MaxCredentialsPerMonth: request.Body.MaxCredentialsPerMonth,
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidTenantRequest) {
+			return CreateTenant400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "creating tenant", "err", err)
+		return CreateTenant500JSONResponse{N500JSONResponse{Message: "There was an error creating the tenant"}}, nil
+	}
+	return CreateTenant201JSONResponse(tenantResponse(tenant, nil)), nil
+}
+
+// GetTenant is the handler for the GET /v2/tenants/{id} endpoint. It returns the usage of the quotas too.
+func (s *Server) GetTenant(ctx context.Context, request GetTenantRequestObject) (GetTenantResponseObject, error) {
+	tenant, err := s.tenantService.Get(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrTenantNotFound) {
+			return GetTenant404JSONResponse{N404JSONResponse{Message: "tenant not found"}}, nil
+		}
+		log.Error(ctx, "getting tenant", "err", err, "id", request.Id)
+		return GetTenant500JSONResponse{N500JSONResponse{Message: "There was an error getting the tenant"}}, nil
+	}
+	usage, err := s.tenantService.GetUsage(ctx, tenant.ID)
+	if err != nil {
+		log.Error(ctx, "getting tenant usage", "err", err, "id", request.Id)
+		return GetTenant500JSONResponse{N500JSONResponse{Message: "There was an error getting the tenant"}}, nil
+	}
+	return GetTenant200JSONResponse(tenantResponse(tenant, usage)), nil
+}
+
+// UpdateTenant is the handler for the PATCH /v2/tenants/{id} endpoint.
+func (s *Server) UpdateTenant(ctx context.Context, request UpdateTenantRequestObject) (UpdateTenantResponseObject, error) {
+	tenant, err := s.tenantService.Update(ctx, request.Id, ports.TenantRequest{
+		Name:                   request.Body.Name,
+		MaxIdentities:          request.Body.MaxIdentities,
+		MaxCredentialsPerMonth: request.Body.MaxCredentialsPerMonth,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrTenantNotFound):
+			return UpdateTenant404JSONResponse{N404JSONResponse{Message: "tenant not found"}}, nil
+		case errors.Is(err, services.ErrInvalidTenantRequest):
+			return UpdateTenant400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "updating tenant", "err", err, "id", request.Id)
+		return UpdateTenant500JSONResponse{N500JSONResponse{Message: "There was an error updating the tenant"}}, nil
+	}
+	return UpdateTenant200JSONResponse(tenantResponse(tenant, nil)), nil
+}
+
+// AddTenantIdentity is the handler for the POST /v2/tenants/{id}/identities endpoint.
+func (s *Server) AddTenantIdentity(ctx context.Context, request AddTenantIdentityRequestObject) (AddTenantIdentityResponseObject, error) {
+	did, err := w3c.ParseDID(request.Body.Identifier)
+	if err != nil {
+		return AddTenantIdentity400JSONResponse{N400JSONResponse{Message: "invalid identifier"}}, nil
+	}
+	if err := s.tenantService.AddIdentity(ctx, request.Id, *did); err != nil {
+		switch {
+		case errors.Is(err, services.ErrTenantNotFound), errors.Is(err, services.ErrTenantIdentityNotFound):
+			return AddTenantIdentity404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrIdentityInAnotherTenant):
+			return AddTenantIdentity400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrTenantQuotaExceeded):
+			return AddTenantIdentity403JSONResponse{N403JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "adding identity to tenant", "err", err, "id", request.Id)
+		return AddTenantIdentity500JSONResponse{N500JSONResponse{Message: "There was an error adding the identity to the tenant"}}, nil
+	}
+	return AddTenantIdentity200JSONResponse{Message: "identity added to the tenant"}, nil
+}
+
+func tenantResponse(tenant *domain.Tenant, usage *domain.TenantUsage) Tenant {
+	resp := Tenant{
+		Id:                     tenant.ID,
+		Name:                   tenant.Name,
+		MaxIdentities:          tenant.MaxIdentities,
+		MaxCredentialsPerMonth: tenant.MaxCredentialsPerMonth,
+		CreatedAt:              TimeUTC(tenant.CreatedAt),
+	}
+	if usage != nil {
+		resp.Usage = &TenantUsage{Identities: usage.Identities, CredentialsThisMonth: usage.CredentialsThisMonth}
+	}
+	return resp
+}
@@ -0,0 +1,282 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestServer_Tenants(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	otherIden, err := server.Services.identity.Create(ctx, "http://issuer-node", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+
+	var tenant CreateTenant201JSONResponse
+	t.Run("create", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			auth     func() (string, string)
+			body     CreateTenantRequest
+			httpCode int
+		}{
+			{
+				name:     "no auth header",
+				auth:     authWrong,
+				body:     CreateTenantRequest{Name: "acme"},
+				httpCode: http.StatusUnauthorized,
+			},
+			{
+				name:     "should get an error - negative quota",
+				auth:     authOk,
+				body:     CreateTenantRequest{Name: "acme", MaxIdentities: common.ToPointer(-1)},
+				httpCode: http.StatusBadRequest,
+			},
+			{
+				name:     "should create the tenant",
+				auth:     authOk,
+				body:     CreateTenantRequest{Name: "acme " + uuid.NewString(), MaxIdentities: common.ToPointer(1)},
+				httpCode: http.StatusCreated,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				rr := httptest.NewRecorder()
+				req, err := http.NewRequest(http.MethodPost, "/v2/tenants", tests.JSONBody(t, tc.body))
+				require.NoError(t, err)
+				req.SetBasicAuth(tc.auth())
+				handler.ServeHTTP(rr, req)
+				require.Equal(t, tc.httpCode, rr.Code)
+				if tc.httpCode == http.StatusCreated {
+					require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &tenant))
+					assert.Equal(t, tc.body.Name, tenant.Name)
+					require.NotNil(t, tenant.MaxIdentities)
+					assert.Equal(t, 1, *tenant.MaxIdentities)
+				}
+			})
+		}
+	})
+	require.NotEqual(t, uuid.Nil, tenant.Id)
+
+	var apiKey CreateApiKey201JSONResponse
+	t.Run("should create an api key of the tenant", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		body := CreateApiKeyRequest{
+			Name:     "acme",
+			Scopes:   []string{string(domain.APIKeyScopeIdentitiesRead), string(domain.APIKeyScopeIdentitiesWrite)},
+			TenantId: &tenant.Id,
+		}
+		req, err := http.NewRequest(http.MethodPost, "/v2/api-keys", tests.JSONBody(t, body))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusCreated, rr.Code)
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &apiKey))
+		require.NotNil(t, apiKey.ApiKey.TenantId)
+		assert.Equal(t, tenant.Id, *apiKey.ApiKey.TenantId)
+	})
+
+	withAPIKey := func(req *http.Request) { req.Header.Set(apiKeyHeader, apiKey.Token) }
+	withBasicAuth := func(req *http.Request) { req.SetBasicAuth(authOk()) }
+
+	createIdentity := func(t *testing.T, tenantID *uuid.UUID, auth func(*http.Request)) *httptest.ResponseRecorder {
+		t.Helper()
+		body := CreateIdentityRequest{TenantId: tenantID}
+		body.DidMetadata.Method = method
+		body.DidMetadata.Blockchain = blockchain
+		body.DidMetadata.Network = network
+		body.DidMetadata.Type = BJJ
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, "/v2/identities", tests.JSONBody(t, body))
+		require.NoError(t, err)
+		auth(req)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	var identifier string
+	t.Run("should create an identity of the tenant", func(t *testing.T) {
+		rr := createIdentity(t, nil, withAPIKey)
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var response CreateIdentity201JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.NotNil(t, response.Identifier)
+		identifier = *response.Identifier
+
+		rr = httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/v2/identities", nil)
+		require.NoError(t, err)
+		req.Header.Set(apiKeyHeader, apiKey.Token)
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var identities GetIdentities200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &identities))
+		require.Len(t, identities, 1)
+		assert.Equal(t, identifier, identities[0].Identifier)
+	})
+
+	t.Run("should get an error - identity quota exceeded", func(t *testing.T) {
+		rr := createIdentity(t, nil, withAPIKey)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+
+		rr = createIdentity(t, &tenant.Id, withBasicAuth)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+
+		rr = httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/tenants/%s/identities", tenant.Id), tests.JSONBody(t, AddTenantIdentityRequest{Identifier: otherIden.Identifier}))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("should not create identities of another tenant", func(t *testing.T) {
+		rr := createIdentity(t, common.ToPointer(uuid.New()), withAPIKey)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+
+		rr = createIdentity(t, common.ToPointer(uuid.New()), withBasicAuth)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should not act on identities out of the tenant", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/state/status", otherIden.Identifier), nil)
+		require.NoError(t, err)
+		req.Header.Set(apiKeyHeader, apiKey.Token)
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("should not manage tenants with the tenant api key", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/v2/tenants", nil)
+		require.NoError(t, err)
+		req.Header.Set(apiKeyHeader, apiKey.Token)
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("should get the tenant with its usage", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/tenants/%s", tenant.Id), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response GetTenant200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.NotNil(t, response.Usage)
+		assert.Equal(t, 1, response.Usage.Identities)
+		assert.Equal(t, 0, response.Usage.CredentialsThisMonth)
+	})
+
+	t.Run("should update the quotas and add an identity", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("/v2/tenants/%s", tenant.Id), tests.JSONBody(t, UpdateTenantRequest{MaxIdentities: common.ToPointer(0)}))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response UpdateTenant200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Nil(t, response.MaxIdentities)
+
+		rr = httptest.NewRecorder()
+		req, err = http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/tenants/%s/identities", tenant.Id), tests.JSONBody(t, AddTenantIdentityRequest{Identifier: otherIden.Identifier}))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should apply the credentials quota to every credential of the tenant", func(t *testing.T) {
+		_, err := server.Services.tenants.Update(ctx, tenant.Id, ports.TenantRequest{MaxCredentialsPerMonth: common.ToPointer(1)})
+		require.NoError(t, err)
+		_ = repositories.NewFixture(storage).CreateClaim(t, &domain.Claim{
+			Identifier:      common.ToPointer(identifier),
+			Issuer:          identifier,
+			OtherIdentifier: "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR",
+			SchemaType:      "KYCAgeCredential",
+			HIndex:          "20060639968773997271173557722944342103398298534714534718204282267207714246111",
+			CreatedAt:       time.Now(),
+		})
+
+		did, err := w3c.ParseDID(identifier)
+		require.NoError(t, err)
+		_, err = server.Services.credentials.CreateCredential(ctx, &ports.CreateClaimRequest{
+			DID:    did,
+			Schema: "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
+			Type:   "KYCAgeCredential",
+		})
+		assert.ErrorIs(t, err, services.ErrTenantQuotaExceeded)
+	})
+
+	t.Run("should count the credentials stored concurrently", func(t *testing.T) {
+		_, err := server.Services.tenants.Update(ctx, tenant.Id, ports.TenantRequest{MaxCredentialsPerMonth: common.ToPointer(2)})
+		require.NoError(t, err)
+		did, err := w3c.ParseDID(identifier)
+		require.NoError(t, err)
+
+		tx, err := storage.Pgx.Begin(ctx)
+		require.NoError(t, err)
+		defer func() { _ = tx.Rollback(ctx) }()
+		require.NoError(t, server.Services.tenants.LockCredentialQuota(ctx, tx, *did))
+		_, err = repositories.NewClaim().Save(ctx, tx, &domain.Claim{
+			Identifier:      common.ToPointer(identifier),
+			Issuer:          identifier,
+			OtherIdentifier: "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR",
+			SchemaType:      "KYCAgeCredential",
+			HIndex:          "20060639968773997271173557722944342103398298534714534718204282267207714246222",
+			CreatedAt:       time.Now(),
+		})
+		require.NoError(t, err)
+
+		concurrent := make(chan error, 1)
+		go func() {
+			concurrent <- storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+				return server.Services.tenants.LockCredentialQuota(ctx, tx, *did)
+			})
+		}()
+		select {
+		case err := <-concurrent:
+			t.Fatalf("the quota was checked before the credential was stored: %v", err)
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		require.NoError(t, tx.Commit(ctx))
+		assert.ErrorIs(t, <-concurrent, services.ErrTenantQuotaExceeded)
+	})
+
+	t.Run("should get an error - tenant not found", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/tenants/%s", uuid.New()), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,177 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/pagination"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	defaultWebhookDeliveriesMaxResults  = 50
+	webhookDeliveriesMaxResultsMinValue = 10
+)
+
+// CreateWebhook is the handler for the POST /v2/identities/{identifier}/webhooks endpoint.
+func (s *Server) CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return CreateWebhook400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	req := ports.WebhookRequest{
+		URL:         request.Body.Url,
+		Description: request.Body.Description,
+	}
+	if request.Body.Events != nil {
+		for _, eventType := range *request.Body.Events {
+			req.Events = append(req.Events, domain.WebhookEventType(eventType))
+		}
+	}
+
+	webhook, err := s.webhookService.Create(ctx, *issuerDID, req)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidWebhookRequest) {
+			return CreateWebhook400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "creating webhook", "err", err)
+		return CreateWebhook500JSONResponse{N500JSONResponse{Message: "There was an error creating the webhook"}}, nil
+	}
+	resp := webhookResponse(webhook)
+	return CreateWebhook201JSONResponse{
+		Id:          resp.Id,
+		Url:         resp.Url,
+		Events:      resp.Events,
+		Description: resp.Description,
+		CreatedAt:   resp.CreatedAt,
+		Secret:      webhook.Secret,
+	}, nil
+}
+
+// GetWebhooks is the handler for the GET /v2/identities/{identifier}/webhooks endpoint.
+func (s *Server) GetWebhooks(ctx context.Context, request GetWebhooksRequestObject) (GetWebhooksResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetWebhooks400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	webhooks, err := s.webhookService.GetAll(ctx, *issuerDID)
+	if err != nil {
+		log.Error(ctx, "getting webhooks", "err", err)
+		return GetWebhooks500JSONResponse{N500JSONResponse{Message: "There was an error getting the webhooks"}}, nil
+	}
+	resp := make(GetWebhooks200JSONResponse, 0, len(webhooks))
+	for i := range webhooks {
+		resp = append(resp, webhookResponse(&webhooks[i]))
+	}
+	return resp, nil
+}
+
+// GetWebhook is the handler for the GET /v2/identities/{identifier}/webhooks/{id} endpoint.
+func (s *Server) GetWebhook(ctx context.Context, request GetWebhookRequestObject) (GetWebhookResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetWebhook400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	webhook, err := s.webhookService.Get(ctx, *issuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrWebhookNotFound) {
+			return GetWebhook404JSONResponse{N404JSONResponse{Message: "webhook not found"}}, nil
+		}
+		log.Error(ctx, "getting webhook", "err", err)
+		return GetWebhook500JSONResponse{N500JSONResponse{Message: "There was an error getting the webhook"}}, nil
+	}
+	return GetWebhook200JSONResponse(webhookResponse(webhook)), nil
+}
+
+// DeleteWebhook is the handler for the DELETE /v2/identities/{identifier}/webhooks/{id} endpoint.
+func (s *Server) DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return DeleteWebhook400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	if err := s.webhookService.Delete(ctx, *issuerDID, request.Id); err != nil {
+		if errors.Is(err, services.ErrWebhookNotFound) {
+			return DeleteWebhook404JSONResponse{N404JSONResponse{Message: "webhook not found"}}, nil
+		}
+		log.Error(ctx, "deleting webhook", "err", err)
+		return DeleteWebhook500JSONResponse{N500JSONResponse{Message: "There was an error deleting the webhook"}}, nil
+	}
+	return DeleteWebhook200JSONResponse{Message: "webhook deleted"}, nil
+}
+
+// GetWebhookDeliveries is the handler for the GET /v2/identities/{identifier}/webhooks/{id}/deliveries endpoint.
+func (s *Server) GetWebhookDeliveries(ctx context.Context, request GetWebhookDeliveriesRequestObject) (GetWebhookDeliveriesResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetWebhookDeliveries400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	if request.Params.Page != nil && *request.Params.Page == 0 {
+		return GetWebhookDeliveries400JSONResponse{N400JSONResponse{Message: "page must be greater than 0"}}, nil
+	}
+	maxResults := uint(defaultWebhookDeliveriesMaxResults)
+	if request.Params.MaxResults != nil {
+		maxResults = max(*request.Params.MaxResults, webhookDeliveriesMaxResultsMinValue)
+	}
+
+	deliveries, total, err := s.webhookService.GetDeliveries(ctx, *issuerDID, request.Id, *pagination.NewFilter(&maxResults, request.Params.Page))
+	if err != nil {
+		if errors.Is(err, services.ErrWebhookNotFound) {
+			return GetWebhookDeliveries404JSONResponse{N404JSONResponse{Message: "webhook not found"}}, nil
+		}
+		log.Error(ctx, "getting webhook deliveries", "err", err)
+		return GetWebhookDeliveries500JSONResponse{N500JSONResponse{Message: "There was an error getting the webhook deliveries"}}, nil
+	}
+	resp := WebhookDeliveriesPaginated{
+		Items: make([]WebhookDelivery, 0, len(deliveries)),
+		Meta:  PaginatedMetadata{MaxResults: maxResults, Page: 1, Total: total},
+	}
+	if request.Params.Page != nil {
+		resp.Meta.Page = *request.Params.Page
+	}
+	for i := range deliveries {
+		resp.Items = append(resp.Items, webhookDeliveryResponse(&deliveries[i]))
+	}
+	return GetWebhookDeliveries200JSONResponse(resp), nil
+}
+
+func webhookResponse(webhook *domain.Webhook) Webhook {
+	events := make([]WebhookEventType, 0, len(webhook.Events))
+	for _, eventType := range webhook.Events {
+		events = append(events, WebhookEventType(eventType))
+	}
+	return Webhook{
+		Id:          webhook.ID,
+		Url:         webhook.URL,
+		Events:      events,
+		Description: webhook.Description,
+		CreatedAt:   TimeUTC(webhook.CreatedAt),
+	}
+}
+
+func webhookDeliveryResponse(delivery *domain.WebhookDelivery) WebhookDelivery {
+	resp := WebhookDelivery{
+		Id:             delivery.ID,
+		EventType:      WebhookEventType(delivery.EventType),
+		Status:         WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  TimeUTC(delivery.NextAttemptAt),
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		CreatedAt:      TimeUTC(delivery.CreatedAt),
+	}
+	if delivery.DeliveredAt != nil {
+		resp.DeliveredAt = common.ToPointer(TimeUTC(*delivery.DeliveredAt))
+	}
+	return resp
+}
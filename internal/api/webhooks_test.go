@@ -0,0 +1,165 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/cache"
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/pubsub"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestServer_Webhooks(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "http://issuer-node", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	other, err := server.Services.identity.Create(ctx, "http://issuer-node", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+
+	var created CreateWebhook201JSONResponse
+
+	t.Run("should create a webhook", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/webhooks", iden.Identifier), tests.JSONBody(t, CreateWebhookRequest{
+			Url:         "https://issuer.example.com/webhooks",
+			Events:      &[]WebhookEventType{WebhookEventTypeCredentialCreated, WebhookEventTypeCredentialCreated, WebhookEventTypeStateConfirmed},
+			Description: common.ToPointer("credentials"),
+		}))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &created))
+		assert.True(t, strings.HasPrefix(created.Secret, "whsec_"))
+		assert.Equal(t, []WebhookEventType{WebhookEventTypeCredentialCreated, WebhookEventTypeStateConfirmed}, created.Events)
+	})
+
+	t.Run("should get an error creating a webhook", func(t *testing.T) {
+		for _, body := range []CreateWebhookRequest{
+			{Url: "http://issuer.example.com/webhooks"},
+			{Url: "not an url"},
+			{Url: "https://127.0.0.1/webhooks"},
+			{Url: "https://localhost:8080/webhooks"},
+			{Url: "https://169.254.169.254/latest/meta-data"},
+			{Url: "https://10.0.0.1/webhooks"},
+			{Url: "https://[::]/webhooks"},
+			{Url: "https://issuer.example.com/webhooks", Events: &[]WebhookEventType{"credential.unknown"}},
+		} {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/webhooks", iden.Identifier), tests.JSONBody(t, body))
+			require.NoError(t, err)
+			req.SetBasicAuth(authOk())
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, http.StatusBadRequest, rr.Code, body.Url)
+		}
+	})
+
+	t.Run("should get the webhooks without the secret", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/webhooks", iden.Identifier), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.NotContains(t, rr.Body.String(), created.Secret)
+
+		var response GetWebhooks200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response, 1)
+		assert.Equal(t, created.Id, response[0].Id)
+	})
+
+	t.Run("should get the deliveries of the webhook", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/webhooks/%s/deliveries", iden.Identifier, created.Id), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response GetWebhookDeliveries200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Empty(t, response.Items)
+		assert.Equal(t, uint(1), response.Meta.Page)
+	})
+
+	t.Run("should queue each event once in all the replicas", func(t *testing.T) {
+		shared := cache.NewMemoryCache()
+		msg, err := (&event.CredentialCreated{CredentialID: uuid.NewString(), IssuerID: iden.Identifier, SchemaType: "KYCAgeCredential"}).Marshal()
+		require.NoError(t, err)
+		for range 2 {
+			replica := webhookSubscriber{}
+			services.NewWebhook(repositories.NewWebhook(), storage, shared, config.Webhooks{}).Subscribe(ctx, replica)
+			require.NoError(t, replica[event.CredentialCreatedEvent](ctx, msg))
+		}
+
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/webhooks/%s/deliveries", iden.Identifier, created.Id), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response GetWebhookDeliveries200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.Items, 1)
+		assert.Equal(t, WebhookEventTypeCredentialCreated, response.Items[0].EventType)
+	})
+
+	t.Run("should not get the webhook from another identity", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/webhooks/%s", other.Identifier, created.Id), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("should delete the webhook", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/v2/identities/%s/webhooks/%s", iden.Identifier, created.Id), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		rr = httptest.NewRecorder()
+		req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/webhooks/%s", iden.Identifier, created.Id), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+// webhookSubscriber keeps the handlers of the subscribed topics
+type webhookSubscriber map[string]pubsub.EventHandler
+
+func (s webhookSubscriber) Subscribe(_ context.Context, topic string, callback pubsub.EventHandler) {
+	s[topic] = callback
+}
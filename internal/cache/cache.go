@@ -26,6 +26,26 @@ type Cache interface {
 	Exists(ctx context.Context, key string) bool
 	// Delete removes an entry from the cache.
 	Delete(ctx context.Context, key string) error
+	// Increment atomically increments the counter stored in key and returns its value and the time it has left.
+	// A missing counter is created with the ttl, that is not extended by the following increments.
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, time.Duration, error)
+}
+
+// incrementScript increments a counter setting its ttl, in milliseconds, when it is created. It returns the counter
+// and its remaining ttl.
+const incrementScript = `
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return {count, redis.call("PTTL", KEYS[1])}
+`
+
+// Ping returns a health check of the cache that writes a short-lived key
+func Ping(c Cache) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		return c.Set(ctx, "issuer-node-health-check", time.Now().Unix(), time.Minute)
+	}
 }
 
 // NewCacheClient - creates a new cache client based on the configuration
@@ -3,6 +3,7 @@ package cache
 import (
 	"context"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/patrickmn/go-cache"
@@ -15,6 +16,8 @@ const (
 
 type memory struct {
 	c *cache.Cache
+	// counters serializes the increments, so a counter is created only once
+	counters sync.Mutex
 }
 
 // NewMemoryCache returns a basic in memory cache
@@ -53,3 +56,18 @@ func (m *memory) Delete(_ context.Context, key string) error {
 	m.c.Delete(key)
 	return nil
 }
+
+// Increment increments a counter of the in memory cache
+func (m *memory) Increment(_ context.Context, key string, ttl time.Duration) (int64, time.Duration, error) {
+	m.counters.Lock()
+	defer m.counters.Unlock()
+	if _, expiration, found := m.c.GetWithExpiration(key); found {
+		count, err := m.c.IncrementInt64(key, 1)
+		if err != nil {
+			return 0, 0, err
+		}
+		return count, time.Until(expiration), nil
+	}
+	m.c.Set(key, int64(1), ttl)
+	return 1, ttl, nil
+}
@@ -2,20 +2,24 @@ package cache
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-redis/cache/v8"
 	"github.com/go-redis/redis/v8"
 )
 
+var redisIncrementScript = redis.NewScript(incrementScript)
+
 type redisCache struct {
-	redis *cache.Cache
+	redis  *cache.Cache
+	client *redis.Client
 }
 
 // NewRedisCache returns a new cache based on Redis
 func NewRedisCache(client *redis.Client) Cache {
 	myc := cache.New(&cache.Options{Redis: client})
-	return &redisCache{redis: myc}
+	return &redisCache{redis: myc, client: client}
 }
 
 // Set sets a new entry in redis cache
@@ -49,3 +53,15 @@ func (c *redisCache) Exists(ctx context.Context, key string) bool {
 func (c *redisCache) Delete(ctx context.Context, key string) error {
 	return c.redis.Delete(ctx, key)
 }
+
+// Increment increments a counter in redis
+func (c *redisCache) Increment(ctx context.Context, key string, ttl time.Duration) (int64, time.Duration, error) {
+	result, err := redisIncrementScript.Run(ctx, c.client, []string{key}, ttl.Milliseconds()).Int64Slice()
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(result) != 2 { //nolint:mnd
+		return 0, 0, fmt.Errorf("unexpected increment result: %v", result)
+	}
+	return result[0], time.Duration(result[1]) * time.Millisecond, nil
+}
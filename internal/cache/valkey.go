@@ -3,6 +3,8 @@ package cache
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/valkey-io/valkey-go"
@@ -10,6 +12,8 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/log"
 )
 
+var valKeyIncrementScript = valkey.NewLuaScript(incrementScript)
+
 type valKeyCache struct {
 	client valkey.Client
 }
@@ -68,3 +72,15 @@ func (v valKeyCache) Delete(ctx context.Context, key string) error {
 	err := v.client.Do(ctx, v.client.B().Del().Key(key).Build()).Error()
 	return err
 }
+
+// Increment increments a counter in valkey
+func (v valKeyCache) Increment(ctx context.Context, key string, ttl time.Duration) (int64, time.Duration, error) {
+	result, err := valKeyIncrementScript.Exec(ctx, v.client, []string{key}, []string{strconv.FormatInt(ttl.Milliseconds(), 10)}).AsIntSlice()
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(result) != 2 { //nolint:mnd
+		return 0, 0, fmt.Errorf("unexpected increment result: %v", result)
+	}
+	return result[0], time.Duration(result[1]) * time.Millisecond, nil
+}
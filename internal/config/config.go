@@ -5,17 +5,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/caarlos0/env/v11"
 	vault "github.com/hashicorp/vault/api"
+	"github.com/iden3/go-iden3-core/v2/w3c"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/kms"
@@ -33,10 +36,18 @@ const (
 	AWSSM = "aws-sm"
 	// AWSKMS is the AWS KMS provider
 	AWSKMS = "aws-kms"
+	// PKCS11 is the PKCS#11 (HSM) provider
+	PKCS11 = "pkcs11"
+	// RemoteSigner is the external signing service provider
+	RemoteSigner = "remote"
 	// CacheProviderRedis is the redis cache provider
 	CacheProviderRedis = "redis"
 	// CacheProviderValKey is the valkey cache provider
 	CacheProviderValKey = "valkey"
+	// RateLimitStoreCache keeps the rate limit counters in the cache provider
+	RateLimitStoreCache = "cache"
+	// RateLimitStoreMemory keeps the rate limit counters in the memory of each instance
+	RateLimitStoreMemory = "memory"
 
 	ipfsGateway = "https://cloudflare-ipfs.com"
 )
@@ -45,6 +56,7 @@ const (
 type Configuration struct {
 	ServerUrl                   string        `env:"ISSUER_SERVER_URL" envDefault:"http://localhost"`
 	ServerPort                  int           `env:"ISSUER_SERVER_PORT" envDefault:"3001"`
+	MetricsPort                 int           `env:"ISSUER_METRICS_PORT" envDefault:"9090"`
 	PublishingKeyPath           string        `env:"ISSUER_PUBLISH_KEY_PATH" envDefault:"pbkey"`
 	SchemaCache                 bool          `env:"ISSUER_SCHEMA_CACHE" envDefault:"false"`
 	OnChainCheckStatusFrequency time.Duration `env:"ISSUER_ONCHAIN_CHECK_STATUS_FREQUENCY"`
@@ -65,6 +77,161 @@ type Configuration struct {
 	UniversalLinks              UniversalLinks
 	UniversalDIDResolver        UniversalDIDResolver
 	Payments                    Payments
+	AuthSession                 AuthSession
+	Approvals                   Approvals
+	KeyExpiry                   KeyExpiry
+	OIDC                        OIDC
+	Webhooks                    Webhooks
+	Tracing                     Tracing
+	Health                      Health
+	RateLimit                   RateLimit
+	Idempotency                 Idempotency
+}
+
+// OIDC configurations. When IssuerURL is set the API also accepts bearer JWTs issued by the OIDC provider.
+// IssuerURL: Issuer of the tokens. The JWKS url is discovered from its openid configuration
+// Audience: Audience the tokens have to be issued for
+// JWKSURL: Optional url of the JWKS, to skip the discovery
+// JWKSFile: Optional local JWKS file, used instead of fetching the keys. Useful for offline testing
+// JWKSCacheTTL: Time the fetched keys are cached
+// RolesClaim: Claim with the roles of the user. Nested claims are separated by dots, like realm_access.roles
+// IdentitiesClaim: Claim with the identities the user can manage. Users without it can manage all identities
+// TenantClaim: Claim with the tenant of the user. Users of a tenant can only manage the identities of the tenant
+// AdminRole: Role that grants every scope
+// ViewerRole: Role that grants the read scopes
+type OIDC struct {
+	IssuerURL       string        `env:"ISSUER_OIDC_ISSUER_URL"`
+	Audience        string        `env:"ISSUER_OIDC_AUDIENCE"`
+	JWKSURL         string        `env:"ISSUER_OIDC_JWKS_URL"`
+	JWKSFile        string        `env:"ISSUER_OIDC_JWKS_FILE"`
+	JWKSCacheTTL    time.Duration `env:"ISSUER_OIDC_JWKS_CACHE_TTL" envDefault:"1h"`
+	RolesClaim      string        `env:"ISSUER_OIDC_ROLES_CLAIM" envDefault:"roles"`
+	IdentitiesClaim string        `env:"ISSUER_OIDC_IDENTITIES_CLAIM" envDefault:"identities"`
+	TenantClaim     string        `env:"ISSUER_OIDC_TENANT_CLAIM" envDefault:"tenant"`
+	AdminRole       string        `env:"ISSUER_OIDC_ADMIN_ROLE" envDefault:"issuer-admin"`
+	ViewerRole      string        `env:"ISSUER_OIDC_VIEWER_ROLE" envDefault:"issuer-viewer"`
+}
+
+// Enabled returns true when the bearer token authentication is configured
+func (o OIDC) Enabled() bool {
+	return o.IssuerURL != ""
+}
+
+// KeyExpiry configurations
+// WarningPeriod: Time before the expiration of a key when a keyExpiringEvent is published
+// CheckFrequency: How often the pending publisher looks for expiring keys
+type KeyExpiry struct {
+	WarningPeriod  time.Duration `env:"ISSUER_KEY_EXPIRY_WARNING_PERIOD" envDefault:"720h"`
+	CheckFrequency time.Duration `env:"ISSUER_KEY_EXPIRY_CHECK_FREQUENCY" envDefault:"1h"`
+}
+
+// Webhooks configurations
+// DeliveryFrequency: How often the notifications service sends the pending webhook deliveries
+// MaxAttempts: Attempts to deliver an event before the delivery fails. Retries wait from 30s up to 1h
+// Timeout: Timeout of each delivery request
+// AllowHTTP: Accept webhook urls without TLS. Only for local testing
+// AllowPrivateNetworks: Accept webhook urls that resolve to loopback, link local or private addresses. Only for local
+// testing, otherwise the webhooks could be used to reach the internal services of the node
+type Webhooks struct {
+	DeliveryFrequency    time.Duration `env:"ISSUER_WEBHOOKS_DELIVERY_FREQUENCY" envDefault:"5s"`
+	MaxAttempts          int           `env:"ISSUER_WEBHOOKS_MAX_ATTEMPTS" envDefault:"8"`
+	Timeout              time.Duration `env:"ISSUER_WEBHOOKS_TIMEOUT" envDefault:"10s"`
+	AllowHTTP            bool          `env:"ISSUER_WEBHOOKS_ALLOW_HTTP" envDefault:"false"`
+	AllowPrivateNetworks bool          `env:"ISSUER_WEBHOOKS_ALLOW_PRIVATE_NETWORKS" envDefault:"false"`
+}
+
+// Tracing configurations. Spans are exported with OTLP over http when Endpoint is set, otherwise tracing is disabled
+// Endpoint: Url of the OTLP traces receiver, like http://otel-collector:4318. The standard OTEL_EXPORTER_OTLP_*
+// variables, like the headers, are also honored
+// SampleRatio: Ratio of the traces started by the node that are sampled, from 0 to 1. The traces continued from
+// the callers keep their sampling decision
+type Tracing struct {
+	Endpoint    string  `env:"ISSUER_TRACING_OTLP_ENDPOINT"`
+	SampleRatio float64 `env:"ISSUER_TRACING_SAMPLE_RATIO" envDefault:"1"`
+}
+
+// Health configurations of the readiness checks
+// CheckPeriod: How often the dependencies of the node are checked. Each check times out after this period
+// MaxBlockAge: Age of the latest block of a network after which its RPC is considered stale
+// MinBalance: Balance, in wei, under which the publishing account of a network is reported as not ready
+type Health struct {
+	CheckPeriod time.Duration `env:"ISSUER_HEALTH_CHECK_PERIOD" envDefault:"15s"`
+	MaxBlockAge time.Duration `env:"ISSUER_HEALTH_MAX_BLOCK_AGE" envDefault:"5m"`
+	MinBalance  string        `env:"ISSUER_HEALTH_MIN_BALANCE" envDefault:"1"`
+}
+
+// MinBalanceWei returns the configured minimum balance of the publishing accounts. Zero when it is not valid
+func (h Health) MinBalanceWei() *big.Int {
+	balance, ok := new(big.Int).SetString(h.MinBalance, 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+	return balance
+}
+
+// RateLimit configurations of the public endpoints. Each limit is the number of requests allowed per Window, zero
+// disables it.
+// Enabled: Enables the rate limits
+// Store: Where the counters are kept, cache to share them between instances or memory
+// ClientIPHeader: Header with the ip of the caller set by a trusted proxy, like X-Forwarded-For. The remote address
+// of the connection is used when empty
+// AgentIP, AgentDID: Limits of the agent endpoints per client ip and per holder did
+// CallbackIP: Limit of the links, authentication and proof request callbacks per client ip
+// QrStoreIP: Limit of the qr store per client ip
+// RevocationStatusIP: Limit of the revocation status endpoints per client ip
+type RateLimit struct {
+	Enabled            bool          `env:"ISSUER_RATE_LIMIT_ENABLED" envDefault:"true"`
+	Store              string        `env:"ISSUER_RATE_LIMIT_STORE" envDefault:"cache"`
+	Window             time.Duration `env:"ISSUER_RATE_LIMIT_WINDOW" envDefault:"1m"`
+	ClientIPHeader     string        `env:"ISSUER_RATE_LIMIT_CLIENT_IP_HEADER"`
+	AgentIP            int           `env:"ISSUER_RATE_LIMIT_AGENT_IP" envDefault:"300"`
+	AgentDID           int           `env:"ISSUER_RATE_LIMIT_AGENT_DID" envDefault:"120"`
+	CallbackIP         int           `env:"ISSUER_RATE_LIMIT_CALLBACK_IP" envDefault:"60"`
+	SessionIP          int           `env:"ISSUER_RATE_LIMIT_SESSION_IP" envDefault:"60"`
+	ApprovalIP         int           `env:"ISSUER_RATE_LIMIT_APPROVAL_IP" envDefault:"30"`
+	QrStoreIP          int           `env:"ISSUER_RATE_LIMIT_QR_STORE_IP" envDefault:"300"`
+	RevocationStatusIP int           `env:"ISSUER_RATE_LIMIT_REVOCATION_STATUS_IP" envDefault:"600"`
+}
+
+// Idempotency configurations of the requests sent with an Idempotency-Key header
+// TTL: Time the response of a request is kept and replayed to the retries with the same key
+// LockTimeout: Time a key is locked while its request is processed. A key of a request that did not complete, like
+// when the node stopped, can be used again after it
+// PurgeFrequency: How often the pending publisher removes the expired keys
+type Idempotency struct {
+	TTL            time.Duration `env:"ISSUER_IDEMPOTENCY_KEY_TTL" envDefault:"24h"`
+	LockTimeout    time.Duration `env:"ISSUER_IDEMPOTENCY_LOCK_TIMEOUT" envDefault:"5m"`
+	PurgeFrequency time.Duration `env:"ISSUER_IDEMPOTENCY_PURGE_FREQUENCY" envDefault:"1h"`
+}
+
+// Approvals configurations. High impact operations are held until RequiredApprovals approvers confirm them.
+// Approvers call the API with an api key or a bearer token with the pending-actions:approve scope, or answer the
+// QR code of the operation with one of the ApproverDIDs. The caller that asked for an operation can not approve it.
+// RequiredApprovals: Number of approvers that have to confirm an operation. Zero disables approvals
+// Approvers: API callers that approve operations, as apikey:<id> or oidc:<subject>
+// ApproverDIDs: DIDs of the approvers that confirm operations by answering a QR code
+// Operations: Operations that need approval
+// RevocationThreshold: Revocations of up to this number of credentials are not held
+// Expiration: Time the approvers have to confirm an operation
+// ExecuteFrequency: How often the approved operations that were not executed, because the node stopped, are run
+type Approvals struct {
+	RequiredApprovals   int           `env:"ISSUER_APPROVALS_REQUIRED" envDefault:"0"`
+	Approvers           []string      `env:"ISSUER_APPROVALS_APPROVERS" envSeparator:","`
+	ApproverDIDs        []string      `env:"ISSUER_APPROVALS_APPROVER_DIDS" envSeparator:","`
+	Operations          []string      `env:"ISSUER_APPROVALS_OPERATIONS" envSeparator:"," envDefault:"publishState,revokeCredentials,createKey,deleteKey,createPaymentOption"`
+	RevocationThreshold int           `env:"ISSUER_APPROVALS_REVOCATION_THRESHOLD" envDefault:"0"`
+	Expiration          time.Duration `env:"ISSUER_APPROVALS_EXPIRATION" envDefault:"72h"`
+	ExecuteFrequency    time.Duration `env:"ISSUER_APPROVALS_EXECUTE_FREQUENCY" envDefault:"1m"`
+}
+
+// AuthSession configurations
+// TTL: Time an authentication QR code can be answered by the holder
+// Retention: Time a session is kept after it expires so its final status can be queried
+// WebhookURL: Optional url called with the session when an authentication completes or fails
+type AuthSession struct {
+	TTL        time.Duration `env:"ISSUER_AUTH_SESSION_TTL" envDefault:"5m"`
+	Retention  time.Duration `env:"ISSUER_AUTH_SESSION_RETENTION" envDefault:"1h"`
+	WebhookURL string        `env:"ISSUER_AUTH_SESSION_WEBHOOK_URL"`
 }
 
 // Payments configurations
@@ -139,21 +306,58 @@ type Circuit struct {
 
 // KeyStore defines the keystore
 type KeyStore struct {
-	Address                      string `env:"ISSUER_KEY_STORE_ADDRESS"`
-	Token                        string `env:"ISSUER_KEY_STORE_TOKEN"`
-	PluginIden3MountPath         string `env:"ISSUER_KEY_STORE_PLUGIN_IDEN3_MOUNT_PATH"`
-	BJJProvider                  string `env:"ISSUER_KMS_BJJ_PROVIDER"`
-	ETHProvider                  string `env:"ISSUER_KMS_ETH_PROVIDER"`
-	SOLProvider                  string `env:"ISSUER_KMS_SOL_PROVIDER"`
-	ProviderLocalStorageFilePath string `env:"ISSUER_KMS_PROVIDER_LOCAL_STORAGE_FILE_PATH"`
-	AWSAccessKey                 string `env:"ISSUER_KMS_AWS_ACCESS_KEY"`
-	AWSSecretKey                 string `env:"ISSUER_KMS_AWS_SECRET_KEY"`
-	AWSRegion                    string `env:"ISSUER_KMS_AWS_REGION"`
-	AWSURL                       string `env:"ISSUER_KMS_AWS_URL" envDefault:"http://localstack:4566"`
-	VaultUserPassAuthEnabled     bool   `env:"ISSUER_VAULT_USERPASS_AUTH_ENABLED"`
-	VaultUserPassAuthPassword    string `env:"ISSUER_VAULT_USERPASS_AUTH_PASSWORD"`
-	TLSEnabled                   bool   `env:"ISSUER_VAULT_TLS_ENABLED"`
-	CertPath                     string `env:"ISSUER_VAULT_TLS_CERT_PATH"`
+	Address                        string        `env:"ISSUER_KEY_STORE_ADDRESS"`
+	Token                          string        `env:"ISSUER_KEY_STORE_TOKEN"`
+	PluginIden3MountPath           string        `env:"ISSUER_KEY_STORE_PLUGIN_IDEN3_MOUNT_PATH"`
+	BJJProvider                    string        `env:"ISSUER_KMS_BJJ_PROVIDER"`
+	ETHProvider                    string        `env:"ISSUER_KMS_ETH_PROVIDER"`
+	SOLProvider                    string        `env:"ISSUER_KMS_SOL_PROVIDER"`
+	P256Provider                   string        `env:"ISSUER_KMS_P256_PROVIDER"`
+	ProviderLocalStorageFilePath   string        `env:"ISSUER_KMS_PROVIDER_LOCAL_STORAGE_FILE_PATH"`
+	ProviderLocalStoragePassphrase string        `env:"ISSUER_KMS_PROVIDER_LOCAL_STORAGE_PASSPHRASE"`
+	ProviderLocalStorageKeyFile    string        `env:"ISSUER_KMS_PROVIDER_LOCAL_STORAGE_KEY_FILE"`
+	AWSAccessKey                   string        `env:"ISSUER_KMS_AWS_ACCESS_KEY"`
+	AWSSecretKey                   string        `env:"ISSUER_KMS_AWS_SECRET_KEY"`
+	AWSRegion                      string        `env:"ISSUER_KMS_AWS_REGION"`
+	AWSURL                         string        `env:"ISSUER_KMS_AWS_URL" envDefault:"http://localstack:4566"`
+	PKCS11ModulePath               string        `env:"ISSUER_KMS_PKCS11_MODULE_PATH"`
+	PKCS11TokenLabel               string        `env:"ISSUER_KMS_PKCS11_TOKEN_LABEL"`
+	PKCS11Pin                      string        `env:"ISSUER_KMS_PKCS11_PIN"`
+	RemoteSignerURL                string        `env:"ISSUER_KMS_REMOTE_SIGNER_URL"`
+	RemoteSignerCertPath           string        `env:"ISSUER_KMS_REMOTE_SIGNER_CERT_PATH"`
+	RemoteSignerKeyPath            string        `env:"ISSUER_KMS_REMOTE_SIGNER_KEY_PATH"`
+	RemoteSignerCAPath             string        `env:"ISSUER_KMS_REMOTE_SIGNER_CA_PATH"`
+	RemoteSignerTimeout            time.Duration `env:"ISSUER_KMS_REMOTE_SIGNER_TIMEOUT" envDefault:"10s"`
+	VaultUserPassAuthEnabled       bool          `env:"ISSUER_VAULT_USERPASS_AUTH_ENABLED"`
+	VaultUserPassAuthPassword      string        `env:"ISSUER_VAULT_USERPASS_AUTH_PASSWORD"`
+	VaultAuthMethod                string        `env:"ISSUER_VAULT_AUTH_METHOD"`
+	VaultAuthMountPath             string        `env:"ISSUER_VAULT_AUTH_MOUNT_PATH"`
+	VaultAppRoleRoleID             string        `env:"ISSUER_VAULT_APPROLE_ROLE_ID"`
+	VaultAppRoleSecretID           string        `env:"ISSUER_VAULT_APPROLE_SECRET_ID"`
+	VaultAppRoleSecretIDFile       string        `env:"ISSUER_VAULT_APPROLE_SECRET_ID_FILE"`
+	VaultKubernetesRole            string        `env:"ISSUER_VAULT_KUBERNETES_ROLE"`
+	VaultKubernetesTokenPath       string        `env:"ISSUER_VAULT_KUBERNETES_TOKEN_PATH"`
+	TLSEnabled                     bool          `env:"ISSUER_VAULT_TLS_ENABLED"`
+	CertPath                       string        `env:"ISSUER_VAULT_TLS_CERT_PATH"`
+}
+
+// VaultConfig returns the configuration of the vault client
+func (k KeyStore) VaultConfig() providers.Config {
+	return providers.Config{
+		UserPassAuthEnabled: k.VaultUserPassAuthEnabled,
+		Pass:                k.VaultUserPassAuthPassword,
+		Address:             k.Address,
+		Token:               k.Token,
+		TLSEnabled:          k.TLSEnabled,
+		CertPath:            k.CertPath,
+		AuthMethod:          k.VaultAuthMethod,
+		AuthMountPath:       k.VaultAuthMountPath,
+		AppRoleRoleID:       k.VaultAppRoleRoleID,
+		AppRoleSecretID:     k.VaultAppRoleSecretID,
+		AppRoleSecretIDFile: k.VaultAppRoleSecretIDFile,
+		KubernetesRole:      k.VaultKubernetesRole,
+		KubernetesTokenPath: k.VaultKubernetesTokenPath,
+	}
 }
 
 // UniversalDIDResolver defines the universal DID resolver
@@ -222,9 +426,9 @@ func (c *Configuration) sanitize(ctx context.Context) error {
 		return fmt.Errorf("serverUrl is not a valid URL <%s>: %w", c.ServerUrl, err)
 	}
 	c.ServerUrl = sUrl
-	if c.KeyStore.Token == "" && !c.KeyStore.VaultUserPassAuthEnabled {
-		log.Error(ctx, "a vault token must be provided or vault userpass auth must be enabled", "vaultUserPassAuthEnabled", c.KeyStore.VaultUserPassAuthEnabled)
-		return fmt.Errorf("a vault token must be provided or vault userpass auth must be enabled")
+	if c.KeyStore.Token == "" && !c.KeyStore.VaultConfig().LoginRequired() {
+		log.Error(ctx, "a vault token must be provided or a vault auth method must be enabled", "vaultUserPassAuthEnabled", c.KeyStore.VaultUserPassAuthEnabled, "vaultAuthMethod", c.KeyStore.VaultAuthMethod)
+		return fmt.Errorf("a vault token must be provided or a vault auth method must be enabled")
 	}
 
 	return nil
@@ -361,12 +565,18 @@ func checkEnvVars(ctx context.Context, cfg *Configuration) error {
 		cfg.KeyStore.SOLProvider = LocalStorage
 	}
 
-	if (cfg.KeyStore.BJJProvider == LocalStorage || cfg.KeyStore.ETHProvider == LocalStorage || cfg.KeyStore.SOLProvider == LocalStorage) && cfg.KeyStore.ProviderLocalStorageFilePath == "" {
+	if cfg.KeyStore.P256Provider == "" {
+		log.Info(ctx, "ISSUER_KMS_P256_PROVIDER value is missing, using default value: localstorage")
+		cfg.KeyStore.P256Provider = LocalStorage
+	}
+
+	if (cfg.KeyStore.BJJProvider == LocalStorage || cfg.KeyStore.ETHProvider == LocalStorage || cfg.KeyStore.SOLProvider == LocalStorage || cfg.KeyStore.P256Provider == LocalStorage) && cfg.KeyStore.ProviderLocalStorageFilePath == "" {
 		log.Info(ctx, "ISSUER_KMS_PLUGIN_LOCAL_STORAGE_FOLDER value is missing, using default value: ./localstoragekeys")
 		cfg.KeyStore.ProviderLocalStorageFilePath = "./localstoragekeys"
 	}
 
-	if cfg.KeyStore.ETHProvider == AWSSM || cfg.KeyStore.ETHProvider == AWSKMS || cfg.KeyStore.BJJProvider == AWSSM || cfg.KeyStore.SOLProvider == AWSSM {
+	if cfg.KeyStore.ETHProvider == AWSSM || cfg.KeyStore.ETHProvider == AWSKMS || cfg.KeyStore.BJJProvider == AWSSM || cfg.KeyStore.SOLProvider == AWSSM ||
+		cfg.KeyStore.P256Provider == AWSSM || cfg.KeyStore.P256Provider == AWSKMS {
 		if cfg.KeyStore.AWSAccessKey == "" {
 			log.Error(ctx, "ISSUER_AWS_KEY_ID value is missing")
 			return errors.New("ISSUER_AWS_KEY_ID value is missing")
@@ -381,7 +591,130 @@ func checkEnvVars(ctx context.Context, cfg *Configuration) error {
 		}
 	}
 
-	if cfg.KeyStore.BJJProvider == LocalStorage || cfg.KeyStore.ETHProvider == LocalStorage || cfg.KeyStore.SOLProvider == LocalStorage {
+	if err := checkApprovals(ctx, &cfg.Approvals); err != nil {
+		return err
+	}
+
+	if cfg.KeyExpiry.CheckFrequency <= 0 {
+		log.Error(ctx, "ISSUER_KEY_EXPIRY_CHECK_FREQUENCY must be positive")
+		return errors.New("ISSUER_KEY_EXPIRY_CHECK_FREQUENCY must be positive")
+	}
+
+	if cfg.Webhooks.DeliveryFrequency <= 0 || cfg.Webhooks.Timeout <= 0 {
+		log.Error(ctx, "ISSUER_WEBHOOKS_DELIVERY_FREQUENCY and ISSUER_WEBHOOKS_TIMEOUT must be positive")
+		return errors.New("ISSUER_WEBHOOKS_DELIVERY_FREQUENCY and ISSUER_WEBHOOKS_TIMEOUT must be positive")
+	}
+	if cfg.Webhooks.MaxAttempts < 1 {
+		log.Error(ctx, "ISSUER_WEBHOOKS_MAX_ATTEMPTS must be at least 1")
+		return errors.New("ISSUER_WEBHOOKS_MAX_ATTEMPTS must be at least 1")
+	}
+
+	if cfg.Tracing.SampleRatio < 0 || cfg.Tracing.SampleRatio > 1 {
+		log.Error(ctx, "ISSUER_TRACING_SAMPLE_RATIO must be between 0 and 1")
+		return errors.New("ISSUER_TRACING_SAMPLE_RATIO must be between 0 and 1")
+	}
+
+	if cfg.Health.CheckPeriod <= 0 || cfg.Health.MaxBlockAge <= 0 {
+		log.Error(ctx, "ISSUER_HEALTH_CHECK_PERIOD and ISSUER_HEALTH_MAX_BLOCK_AGE must be positive")
+		return errors.New("ISSUER_HEALTH_CHECK_PERIOD and ISSUER_HEALTH_MAX_BLOCK_AGE must be positive")
+	}
+	if cfg.RateLimit.Enabled {
+		if cfg.RateLimit.Store != RateLimitStoreCache && cfg.RateLimit.Store != RateLimitStoreMemory {
+			log.Error(ctx, "ISSUER_RATE_LIMIT_STORE must be cache or memory")
+			return errors.New("ISSUER_RATE_LIMIT_STORE must be cache or memory")
+		}
+		if cfg.RateLimit.Window <= 0 {
+			log.Error(ctx, "ISSUER_RATE_LIMIT_WINDOW must be positive")
+			return errors.New("ISSUER_RATE_LIMIT_WINDOW must be positive")
+		}
+	}
+
+	if cfg.Idempotency.TTL <= 0 || cfg.Idempotency.LockTimeout <= 0 || cfg.Idempotency.PurgeFrequency <= 0 {
+		log.Error(ctx, "ISSUER_IDEMPOTENCY_KEY_TTL, ISSUER_IDEMPOTENCY_LOCK_TIMEOUT and ISSUER_IDEMPOTENCY_PURGE_FREQUENCY must be positive")
+		return errors.New("ISSUER_IDEMPOTENCY_KEY_TTL, ISSUER_IDEMPOTENCY_LOCK_TIMEOUT and ISSUER_IDEMPOTENCY_PURGE_FREQUENCY must be positive")
+	}
+
+	if balance, ok := new(big.Int).SetString(cfg.Health.MinBalance, 10); !ok || balance.Sign() < 0 {
+		log.Error(ctx, "ISSUER_HEALTH_MIN_BALANCE must be a non negative amount of wei")
+		return errors.New("ISSUER_HEALTH_MIN_BALANCE must be a non negative amount of wei")
+	}
+
+	if cfg.OIDC.Enabled() {
+		if cfg.OIDC.Audience == "" {
+			log.Error(ctx, "ISSUER_OIDC_AUDIENCE value is missing")
+			return errors.New("ISSUER_OIDC_AUDIENCE value is missing")
+		}
+		if cfg.OIDC.JWKSCacheTTL <= 0 {
+			log.Error(ctx, "ISSUER_OIDC_JWKS_CACHE_TTL must be positive")
+			return errors.New("ISSUER_OIDC_JWKS_CACHE_TTL must be positive")
+		}
+	} else if cfg.OIDC.JWKSURL != "" || cfg.OIDC.JWKSFile != "" {
+		log.Error(ctx, "ISSUER_OIDC_ISSUER_URL value is missing")
+		return errors.New("ISSUER_OIDC_ISSUER_URL value is missing")
+	}
+
+	if cfg.KeyStore.ETHProvider == PKCS11 || cfg.KeyStore.SOLProvider == PKCS11 {
+		if cfg.KeyStore.PKCS11ModulePath == "" {
+			log.Error(ctx, "ISSUER_KMS_PKCS11_MODULE_PATH value is missing")
+			return errors.New("ISSUER_KMS_PKCS11_MODULE_PATH value is missing")
+		}
+		if cfg.KeyStore.PKCS11TokenLabel == "" {
+			log.Error(ctx, "ISSUER_KMS_PKCS11_TOKEN_LABEL value is missing")
+			return errors.New("ISSUER_KMS_PKCS11_TOKEN_LABEL value is missing")
+		}
+		if cfg.KeyStore.PKCS11Pin == "" {
+			log.Error(ctx, "ISSUER_KMS_PKCS11_PIN value is missing")
+			return errors.New("ISSUER_KMS_PKCS11_PIN value is missing")
+		}
+	}
+
+	if cfg.KeyStore.BJJProvider == RemoteSigner || cfg.KeyStore.ETHProvider == RemoteSigner || cfg.KeyStore.SOLProvider == RemoteSigner || cfg.KeyStore.P256Provider == RemoteSigner {
+		if cfg.KeyStore.RemoteSignerURL == "" {
+			log.Error(ctx, "ISSUER_KMS_REMOTE_SIGNER_URL value is missing")
+			return errors.New("ISSUER_KMS_REMOTE_SIGNER_URL value is missing")
+		}
+		if cfg.KeyStore.RemoteSignerCertPath == "" || cfg.KeyStore.RemoteSignerKeyPath == "" || cfg.KeyStore.RemoteSignerCAPath == "" {
+			log.Error(ctx, "ISSUER_KMS_REMOTE_SIGNER_CERT_PATH, ISSUER_KMS_REMOTE_SIGNER_KEY_PATH and ISSUER_KMS_REMOTE_SIGNER_CA_PATH values are required for mutual TLS")
+			return errors.New("ISSUER_KMS_REMOTE_SIGNER_CERT_PATH, ISSUER_KMS_REMOTE_SIGNER_KEY_PATH and ISSUER_KMS_REMOTE_SIGNER_CA_PATH values are required for mutual TLS")
+		}
+	}
+
+	switch cfg.KeyStore.VaultAuthMethod {
+	case "", providers.AuthMethodToken, providers.AuthMethodUserPass:
+	case providers.AuthMethodAppRole:
+		if cfg.KeyStore.VaultAppRoleRoleID == "" {
+			log.Error(ctx, "ISSUER_VAULT_APPROLE_ROLE_ID value is missing")
+			return errors.New("ISSUER_VAULT_APPROLE_ROLE_ID value is missing")
+		}
+		if (cfg.KeyStore.VaultAppRoleSecretID == "") == (cfg.KeyStore.VaultAppRoleSecretIDFile == "") {
+			log.Error(ctx, "one of ISSUER_VAULT_APPROLE_SECRET_ID or ISSUER_VAULT_APPROLE_SECRET_ID_FILE must be provided")
+			return errors.New("one of ISSUER_VAULT_APPROLE_SECRET_ID or ISSUER_VAULT_APPROLE_SECRET_ID_FILE must be provided")
+		}
+	case providers.AuthMethodKubernetes:
+		if cfg.KeyStore.VaultKubernetesRole == "" {
+			log.Error(ctx, "ISSUER_VAULT_KUBERNETES_ROLE value is missing")
+			return errors.New("ISSUER_VAULT_KUBERNETES_ROLE value is missing")
+		}
+	default:
+		log.Error(ctx, "invalid ISSUER_VAULT_AUTH_METHOD value", "value", cfg.KeyStore.VaultAuthMethod)
+		return fmt.Errorf("invalid ISSUER_VAULT_AUTH_METHOD value %q, supported values are token, userpass, approle and kubernetes", cfg.KeyStore.VaultAuthMethod)
+	}
+
+	if cfg.KeyStore.ProviderLocalStoragePassphrase != "" && cfg.KeyStore.ProviderLocalStorageKeyFile != "" {
+		log.Error(ctx, "ISSUER_KMS_PROVIDER_LOCAL_STORAGE_PASSPHRASE and ISSUER_KMS_PROVIDER_LOCAL_STORAGE_KEY_FILE can not be used together")
+		return errors.New("ISSUER_KMS_PROVIDER_LOCAL_STORAGE_PASSPHRASE and ISSUER_KMS_PROVIDER_LOCAL_STORAGE_KEY_FILE can not be used together")
+	}
+
+	if cfg.KeyStore.ProviderLocalStorageKeyFile != "" {
+		passphrase, err := kms.ReadPassphraseFile(cfg.KeyStore.ProviderLocalStorageKeyFile)
+		if err != nil {
+			log.Error(ctx, "cannot read ISSUER_KMS_PROVIDER_LOCAL_STORAGE_KEY_FILE", "err", err)
+			return err
+		}
+		cfg.KeyStore.ProviderLocalStoragePassphrase = passphrase
+	}
+
+	if cfg.KeyStore.BJJProvider == LocalStorage || cfg.KeyStore.ETHProvider == LocalStorage || cfg.KeyStore.SOLProvider == LocalStorage || cfg.KeyStore.P256Provider == LocalStorage {
 		log.Info(ctx, `
 			=====================================================================================================================================================
 			IMPORTANT: THIS CONFIGURATION SHOULD NOT BE USED IN PRODUCTIVE ENVIRONMENTS!!!. YOU HAVE CONFIGURED THE ISSUER NODE TO SAVE KEYS IN THE LOCAL STORAGE
@@ -392,13 +725,42 @@ func checkEnvVars(ctx context.Context, cfg *Configuration) error {
 	return nil
 }
 
+func checkApprovals(ctx context.Context, approvals *Approvals) error {
+	if approvals.RequiredApprovals <= 0 {
+		return nil
+	}
+
+	for _, did := range approvals.ApproverDIDs {
+		if _, err := w3c.ParseDID(did); err != nil {
+			log.Error(ctx, "ISSUER_APPROVALS_APPROVER_DIDS has an invalid DID", "did", did, "err", err)
+			return fmt.Errorf("ISSUER_APPROVALS_APPROVER_DIDS has an invalid DID: %s", did)
+		}
+	}
+
+	validOperations := []string{"publishState", "revokeCredentials", "createKey", "deleteKey", "createPaymentOption"}
+	for _, operation := range approvals.Operations {
+		if !slices.Contains(validOperations, operation) {
+			log.Error(ctx, "ISSUER_APPROVALS_OPERATIONS has an invalid operation", "operation", operation)
+			return fmt.Errorf("ISSUER_APPROVALS_OPERATIONS has an invalid operation: %s", operation)
+		}
+	}
+
+	if approvals.Expiration <= 0 {
+		log.Error(ctx, "ISSUER_APPROVALS_EXPIRATION must be positive")
+		return errors.New("ISSUER_APPROVALS_EXPIRATION must be positive")
+	}
+
+	log.Info(ctx, "approvals enabled", "required", approvals.RequiredApprovals, "operations", approvals.Operations)
+	return nil
+}
+
 // KeyStoreConfig initializes the key store
 func KeyStoreConfig(ctx context.Context, cfg *Configuration, vaultCfg providers.Config) (*kms.KMS, error) {
 	var (
 		vaultCli *vault.Client
 		vaultErr error
 	)
-	if cfg.KeyStore.BJJProvider == Vault || cfg.KeyStore.ETHProvider == Vault || cfg.KeyStore.SOLProvider == Vault {
+	if cfg.KeyStore.BJJProvider == Vault || cfg.KeyStore.ETHProvider == Vault || cfg.KeyStore.SOLProvider == Vault || cfg.KeyStore.P256Provider == Vault {
 		log.Info(ctx, "using vault key provider")
 		vaultCli, vaultErr = providers.VaultClient(ctx, vaultCfg)
 		if vaultErr != nil {
@@ -406,20 +768,35 @@ func KeyStoreConfig(ctx context.Context, cfg *Configuration, vaultCfg providers.
 			return nil, vaultErr
 		}
 
-		if vaultCfg.UserPassAuthEnabled {
+		if vaultCfg.LoginRequired() {
 			go providers.RenewToken(ctx, vaultCli, vaultCfg)
 		}
 	}
 
 	kmsConfig := kms.Config{
-		BJJKeyProvider:           kms.ConfigProvider(cfg.KeyStore.BJJProvider),
-		ETHKeyProvider:           kms.ConfigProvider(cfg.KeyStore.ETHProvider),
-		SOLKeyProvider:           kms.ConfigProvider(cfg.KeyStore.SOLProvider),
-		AWSAccessKey:             cfg.KeyStore.AWSAccessKey,
-		AWSSecretKey:             cfg.KeyStore.AWSSecretKey,
-		AWSRegion:                cfg.KeyStore.AWSRegion,
-		AWSURL:                   cfg.KeyStore.AWSURL,
-		LocalStoragePath:         cfg.KeyStore.ProviderLocalStorageFilePath,
+		BJJKeyProvider:         kms.ConfigProvider(cfg.KeyStore.BJJProvider),
+		ETHKeyProvider:         kms.ConfigProvider(cfg.KeyStore.ETHProvider),
+		SOLKeyProvider:         kms.ConfigProvider(cfg.KeyStore.SOLProvider),
+		P256KeyProvider:        kms.ConfigProvider(cfg.KeyStore.P256Provider),
+		AWSAccessKey:           cfg.KeyStore.AWSAccessKey,
+		AWSSecretKey:           cfg.KeyStore.AWSSecretKey,
+		AWSRegion:              cfg.KeyStore.AWSRegion,
+		AWSURL:                 cfg.KeyStore.AWSURL,
+		LocalStoragePath:       cfg.KeyStore.ProviderLocalStorageFilePath,
+		LocalStoragePassphrase: cfg.KeyStore.ProviderLocalStoragePassphrase,
+		LocalStorageKeyFile:    cfg.KeyStore.ProviderLocalStorageKeyFile,
+		PKCS11: kms.PKCS11Config{
+			ModulePath: cfg.KeyStore.PKCS11ModulePath,
+			TokenLabel: cfg.KeyStore.PKCS11TokenLabel,
+			Pin:        cfg.KeyStore.PKCS11Pin,
+		},
+		RemoteSigner: kms.RemoteSignerConfig{
+			URL:      cfg.KeyStore.RemoteSignerURL,
+			CertPath: cfg.KeyStore.RemoteSignerCertPath,
+			KeyPath:  cfg.KeyStore.RemoteSignerKeyPath,
+			CAPath:   cfg.KeyStore.RemoteSignerCAPath,
+			Timeout:  cfg.KeyStore.RemoteSignerTimeout,
+		},
 		Vault:                    vaultCli,
 		PluginIden3MountPath:     cfg.KeyStore.PluginIden3MountPath,
 		IssuerETHTransferKeyPath: cfg.Ethereum.TransferAccountKeyPath,
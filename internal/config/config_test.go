@@ -155,11 +155,13 @@ func TestLoadKmsProviders(t *testing.T) {
 	envVars["ISSUER_KMS_BJJ_PROVIDER"] = ""
 	envVars["ISSUER_KMS_ETH_PROVIDER"] = ""
 	envVars["ISSUER_KMS_SOL_PROVIDER"] = ""
+	envVars["ISSUER_KMS_P256_PROVIDER"] = ""
 	loadEnvironmentVariables(t, envVars)
 	cfg, err := Load()
 	assert.NoError(t, err)
 	assert.Equal(t, "localstorage", cfg.KeyStore.BJJProvider)
 	assert.Equal(t, "localstorage", cfg.KeyStore.ETHProvider)
+	assert.Equal(t, "localstorage", cfg.KeyStore.P256Provider)
 
 	envVars["ISSUER_KMS_ETH_PROVIDER"] = "aws-sm"
 	envVars["ISSUER_KMS_AWS_ACCESS_KEY"] = ""
@@ -196,6 +198,75 @@ func TestLoadKmsProviders(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestLoadVaultAuthMethod(t *testing.T) {
+	envVars := initVariables(t)
+	envVars["ISSUER_KEY_STORE_TOKEN"] = ""
+	envVars["ISSUER_VAULT_USERPASS_AUTH_ENABLED"] = "false"
+	envVars["ISSUER_VAULT_AUTH_METHOD"] = "approle"
+	envVars["ISSUER_VAULT_APPROLE_ROLE_ID"] = "role-id"
+	envVars["ISSUER_VAULT_APPROLE_SECRET_ID"] = "secret-id"
+	envVars["ISSUER_VAULT_KUBERNETES_ROLE"] = ""
+	loadEnvironmentVariables(t, envVars)
+	t.Cleanup(func() {
+		for _, key := range []string{"ISSUER_VAULT_AUTH_METHOD", "ISSUER_VAULT_APPROLE_ROLE_ID", "ISSUER_VAULT_APPROLE_SECRET_ID", "ISSUER_VAULT_KUBERNETES_ROLE"} {
+			assert.NoError(t, os.Unsetenv(key))
+		}
+	})
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.True(t, cfg.KeyStore.VaultConfig().LoginRequired())
+	assert.Equal(t, "role-id", cfg.KeyStore.VaultConfig().AppRoleRoleID)
+
+	envVars["ISSUER_VAULT_APPROLE_SECRET_ID"] = ""
+	loadEnvironmentVariables(t, envVars)
+	_, err = Load()
+	assert.Error(t, err)
+
+	envVars["ISSUER_VAULT_AUTH_METHOD"] = "kubernetes"
+	loadEnvironmentVariables(t, envVars)
+	_, err = Load()
+	assert.Error(t, err)
+
+	envVars["ISSUER_VAULT_KUBERNETES_ROLE"] = "issuer-node"
+	loadEnvironmentVariables(t, envVars)
+	cfg, err = Load()
+	assert.NoError(t, err)
+	assert.True(t, cfg.KeyStore.VaultConfig().LoginRequired())
+
+	envVars["ISSUER_VAULT_AUTH_METHOD"] = "ldap"
+	loadEnvironmentVariables(t, envVars)
+	_, err = Load()
+	assert.Error(t, err)
+}
+
+func TestLoadOIDC(t *testing.T) {
+	envVars := initVariables(t)
+	envVars["ISSUER_OIDC_ISSUER_URL"] = "https://sso.example.com/realms/issuer"
+	envVars["ISSUER_OIDC_AUDIENCE"] = ""
+	loadEnvironmentVariables(t, envVars)
+	t.Cleanup(func() {
+		for _, key := range []string{"ISSUER_OIDC_ISSUER_URL", "ISSUER_OIDC_AUDIENCE", "ISSUER_OIDC_JWKS_FILE"} {
+			assert.NoError(t, os.Unsetenv(key))
+		}
+	})
+	_, err := Load()
+	assert.Error(t, err)
+
+	envVars["ISSUER_OIDC_AUDIENCE"] = "issuer-node"
+	loadEnvironmentVariables(t, envVars)
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.True(t, cfg.OIDC.Enabled())
+	assert.Equal(t, "roles", cfg.OIDC.RolesClaim)
+	assert.Equal(t, time.Hour, cfg.OIDC.JWKSCacheTTL)
+
+	envVars["ISSUER_OIDC_ISSUER_URL"] = ""
+	envVars["ISSUER_OIDC_JWKS_FILE"] = "./jwks.json"
+	loadEnvironmentVariables(t, envVars)
+	_, err = Load()
+	assert.Error(t, err)
+}
+
 func TestLoadKmsProvidersFolder(t *testing.T) {
 	envVars := initVariables(t)
 	envVars["ISSUER_KMS_PROVIDER_LOCAL_STORAGE_FILE_PATH"] = "./newfolder"
@@ -263,6 +334,7 @@ func initVariables(t *testing.T) envVarsT {
 		"ISSUER_KMS_BJJ_PROVIDER":                     "localstorage",
 		"ISSUER_KMS_ETH_PROVIDER":                     "localstorage",
 		"ISSUER_KMS_SOL_PROVIDER":                     "localstorage",
+		"ISSUER_KMS_P256_PROVIDER":                    "localstorage",
 		"ISSUER_KMS_AWS_ACCESS_KEY":                   "XYZ",
 		"ISSUER_KMS_AWS_SECRET_KEY":                   "123HHUBUuO5",
 		"ISSUER_KMS_AWS_REGION":                       "eu-west-1",
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyScope is an operation group an api key is allowed to call
+type APIKeyScope string
+
+// List of api key scopes
+const (
+	APIKeyScopeIdentitiesRead        APIKeyScope = "identities:read"         // APIKeyScopeIdentitiesRead get identities, DID documents and states
+	APIKeyScopeIdentitiesWrite       APIKeyScope = "identities:write"        // APIKeyScopeIdentitiesWrite create and update identities and publish states
+	APIKeyScopeCredentialsRead       APIKeyScope = "credentials:read"        // APIKeyScopeCredentialsRead get credentials, offers and links
+	APIKeyScopeCredentialsWrite      APIKeyScope = "credentials:write"       // APIKeyScopeCredentialsWrite issue and delete credentials and manage links
+	APIKeyScopeRevocationsWrite      APIKeyScope = "revocations:write"       // APIKeyScopeRevocationsWrite revoke credentials
+	APIKeyScopeConnectionsRead       APIKeyScope = "connections:read"        // APIKeyScopeConnectionsRead get connections, messages and auth sessions
+	APIKeyScopeConnectionsWrite      APIKeyScope = "connections:write"       // APIKeyScopeConnectionsWrite manage connections and send messages
+	APIKeyScopeSchemasRead           APIKeyScope = "schemas:read"            // APIKeyScopeSchemasRead get schemas and display methods
+	APIKeyScopeSchemasWrite          APIKeyScope = "schemas:write"           // APIKeyScopeSchemasWrite import schemas and manage display methods
+	APIKeyScopeKeysRead              APIKeyScope = "keys:read"               // APIKeyScopeKeysRead get keys, key policies and key usages
+	APIKeyScopeKeysAdmin             APIKeyScope = "keys:admin"              // APIKeyScopeKeysAdmin manage keys, key policies, backups and auth credentials
+	APIKeyScopePaymentsRead          APIKeyScope = "payments:read"           // APIKeyScopePaymentsRead get payment options, requests and settings
+	APIKeyScopePaymentsWrite         APIKeyScope = "payments:write"          // APIKeyScopePaymentsWrite manage payment options and requests
+	APIKeyScopeProofRequestsRead     APIKeyScope = "proof-requests:read"     // APIKeyScopeProofRequestsRead get proof requests
+	APIKeyScopeProofRequestsWrite    APIKeyScope = "proof-requests:write"    // APIKeyScopeProofRequestsWrite create proof requests
+	APIKeyScopePendingActionsRead    APIKeyScope = "pending-actions:read"    // APIKeyScopePendingActionsRead get pending actions
+	APIKeyScopePendingActionsApprove APIKeyScope = "pending-actions:approve" // APIKeyScopePendingActionsApprove approve and reject pending actions
+	APIKeyScopeConfigRead            APIKeyScope = "config:read"             // APIKeyScopeConfigRead get supported networks
+	APIKeyScopeAPIKeysAdmin          APIKeyScope = "apikeys:admin"           // APIKeyScopeAPIKeysAdmin manage api keys
+	APIKeyScopeTenantsAdmin          APIKeyScope = "tenants:admin"           // APIKeyScopeTenantsAdmin manage tenants and their quotas
+	APIKeyScopeAuditRead             APIKeyScope = "audit:read"              // APIKeyScopeAuditRead export and verify the audit log
+	APIKeyScopeWebhooksRead          APIKeyScope = "webhooks:read"           // APIKeyScopeWebhooksRead get webhooks and their deliveries
+	APIKeyScopeWebhooksWrite         APIKeyScope = "webhooks:write"          // APIKeyScopeWebhooksWrite create and delete webhooks
+)
+
+// APIKeyScopes returns all the api key scopes
+func APIKeyScopes() []APIKeyScope {
+	return []APIKeyScope{
+		APIKeyScopeIdentitiesRead, APIKeyScopeIdentitiesWrite, APIKeyScopeCredentialsRead, APIKeyScopeCredentialsWrite,
+		APIKeyScopeRevocationsWrite, APIKeyScopeConnectionsRead, APIKeyScopeConnectionsWrite, APIKeyScopeSchemasRead,
+		APIKeyScopeSchemasWrite, APIKeyScopeKeysRead, APIKeyScopeKeysAdmin, APIKeyScopePaymentsRead,
+		APIKeyScopePaymentsWrite, APIKeyScopeProofRequestsRead, APIKeyScopeProofRequestsWrite,
+		APIKeyScopePendingActionsRead, APIKeyScopePendingActionsApprove, APIKeyScopeConfigRead, APIKeyScopeAPIKeysAdmin, APIKeyScopeTenantsAdmin,
+		APIKeyScopeAuditRead, APIKeyScopeWebhooksRead, APIKeyScopeWebhooksWrite,
+	}
+}
+
+// APIKey is a credential to call the admin API. Only the hash of the secret is stored. A key with no identities
+// and no tenant can act on every identity of the node, otherwise it is restricted to the given identities.
+// The identities of a key of a tenant are the ones of the tenant, loaded when the key is authenticated.
+type APIKey struct {
+	ID         uuid.UUID
+	Name       string
+	KeyHash    []byte
+	Scopes     []APIKeyScope
+	Identities []string
+	TenantID   *uuid.UUID
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	RevokedAt  *time.Time
+	LastUsedAt *time.Time
+}
+
+// NewAPIKey returns a new api key
+func NewAPIKey(name string, keyHash []byte, scopes []APIKeyScope, identities []string, expiresAt *time.Time) *APIKey {
+	return &APIKey{
+		ID:         uuid.New(),
+		Name:       name,
+		KeyHash:    keyHash,
+		Scopes:     scopes,
+		Identities: identities,
+		CreatedAt:  time.Now(),
+		ExpiresAt:  expiresAt,
+	}
+}
+
+// HasScope returns true if the key is allowed to call the operations of the scope
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	return slices.Contains(k.Scopes, scope)
+}
+
+// IsRestricted returns true if the key can only act on some identities
+func (k *APIKey) IsRestricted() bool {
+	return len(k.Identities) > 0 || k.TenantID != nil
+}
+
+// AllowsIdentity returns true if the key can act on the given identity
+func (k *APIKey) AllowsIdentity(did string) bool {
+	return !k.IsRestricted() || slices.Contains(k.Identities, did)
+}
+
+// IsActive returns true if the key is not revoked nor expired
+func (k *APIKey) IsActive(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
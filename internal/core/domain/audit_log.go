@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditOutcome is the result of an audited action
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success" // AuditOutcomeSuccess the action succeeded
+	AuditOutcomeFailure AuditOutcome = "failure" // AuditOutcomeFailure the action failed or was not allowed
+)
+
+// AuditLog is an entry of the append-only audit log of the administrative actions. Each entry is chained to the
+// previous one with its hash, so a modified, removed or reordered entry breaks the chain.
+type AuditLog struct {
+	ID           uuid.UUID
+	Sequence     int64
+	Actor        string
+	Action       string
+	Identifier   *string
+	Resource     string
+	RequestID    string
+	StatusCode   int
+	Outcome      AuditOutcome
+	CreatedAt    time.Time
+	PreviousHash []byte
+	Hash         []byte
+}
+
+// NewAuditLog returns a new audit log entry, not chained yet. Status codes of 400 or more are failures.
+func NewAuditLog(actor, action string, identifier *string, resource, requestID string, statusCode int) *AuditLog {
+	outcome := AuditOutcomeSuccess
+	if statusCode >= http.StatusBadRequest {
+		outcome = AuditOutcomeFailure
+	}
+	return &AuditLog{
+		ID:         uuid.New(),
+		Actor:      actor,
+		Action:     action,
+		Identifier: identifier,
+		Resource:   resource,
+		RequestID:  requestID,
+		StatusCode: statusCode,
+		Outcome:    outcome,
+		// the database stores microseconds, the hash must be the same after reading the entry
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+}
+
+// Chain sets the sequence and the hashes of the entry to follow the previous one, nil for the first entry
+func (a *AuditLog) Chain(previous *AuditLog) {
+	a.Sequence = 1
+	a.PreviousHash = nil
+	if previous != nil {
+		a.Sequence = previous.Sequence + 1
+		a.PreviousHash = previous.Hash
+	}
+	a.Hash = a.ComputeHash()
+}
+
+// ComputeHash returns the sha256 of the entry fields and the hash of the previous entry
+func (a *AuditLog) ComputeHash() []byte {
+	data, _ := json.Marshal(struct {
+		ID           string  `json:"id"`
+		Sequence     int64   `json:"sequence"`
+		Actor        string  `json:"actor"`
+		Action       string  `json:"action"`
+		Identifier   *string `json:"identifier"`
+		Resource     string  `json:"resource"`
+		RequestID    string  `json:"requestId"`
+		StatusCode   int     `json:"statusCode"`
+		Outcome      string  `json:"outcome"`
+		CreatedAt    string  `json:"createdAt"`
+		PreviousHash string  `json:"previousHash"`
+	}{
+		ID:           a.ID.String(),
+		Sequence:     a.Sequence,
+		Actor:        a.Actor,
+		Action:       a.Action,
+		Identifier:   a.Identifier,
+		Resource:     a.Resource,
+		RequestID:    a.RequestID,
+		StatusCode:   a.StatusCode,
+		Outcome:      string(a.Outcome),
+		CreatedAt:    a.CreatedAt.UTC().Format(time.RFC3339Nano),
+		PreviousHash: hex.EncodeToString(a.PreviousHash),
+	})
+	hash := sha256.Sum256(data)
+	return hash[:]
+}
+
+// FollowsChain returns true if the entry has a valid hash and follows the previous entry, nil for the first entry
+func (a *AuditLog) FollowsChain(previous *AuditLog) bool {
+	if previous == nil {
+		if a.Sequence != 1 || a.PreviousHash != nil {
+			return false
+		}
+	} else if a.Sequence != previous.Sequence+1 || !bytes.Equal(a.PreviousHash, previous.Hash) {
+		return false
+	}
+	return bytes.Equal(a.Hash, a.ComputeHash())
+}
+
+// AuditLogVerification is the result of verifying the audit log chain
+type AuditLogVerification struct {
+	Valid   bool
+	Entries int
+	// BrokenAt is the sequence of the first entry that does not follow the chain
+	BrokenAt *int64
+}
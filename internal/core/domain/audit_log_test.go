@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLog_Chain(t *testing.T) {
+	identifier := "did:polygonid:polygon:amoy:2qQ68JkRcf3ybQNvgRV9BP6qLgBrXmUezqBi4wsEuV"
+	first := NewAuditLog("basic:user", "CreateIdentity", nil, "POST /v2/identities", "req-1", http.StatusCreated)
+	first.Chain(nil)
+	second := NewAuditLog("apikey:1", "RevokeCredential", &identifier, "POST /v2/identities/x/credentials/revoke/1", "req-2", http.StatusForbidden)
+	second.Chain(first)
+
+	assert.Equal(t, AuditOutcomeSuccess, first.Outcome)
+	assert.Equal(t, AuditOutcomeFailure, second.Outcome)
+	assert.Equal(t, int64(1), first.Sequence)
+	assert.Equal(t, int64(2), second.Sequence)
+	assert.True(t, first.FollowsChain(nil))
+	assert.True(t, second.FollowsChain(first))
+
+	t.Run("should detect a modified entry", func(t *testing.T) {
+		modified := *second
+		modified.Outcome = AuditOutcomeSuccess
+		assert.False(t, modified.FollowsChain(first))
+	})
+
+	t.Run("should detect a removed entry", func(t *testing.T) {
+		third := NewAuditLog("apikey:1", "DeleteConnection", &identifier, "DELETE /v2/identities/x/connections/1", "req-3", http.StatusOK)
+		third.Chain(second)
+		assert.False(t, third.FollowsChain(first))
+	})
+
+	t.Run("should detect a rehashed entry", func(t *testing.T) {
+		rehashed := *first
+		rehashed.Actor = "basic:someone"
+		rehashed.Hash = rehashed.ComputeHash()
+		assert.True(t, rehashed.FollowsChain(nil))
+		assert.False(t, second.FollowsChain(&rehashed))
+	})
+}
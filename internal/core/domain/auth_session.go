@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/iden3comm/v2/protocol"
+)
+
+// AuthSessionStatus is the status of an authentication session
+type AuthSessionStatus string
+
+const (
+	AuthSessionStatusPending       AuthSessionStatus = "pending"       // AuthSessionStatusPending the QR code has not been answered yet
+	AuthSessionStatusAuthenticated AuthSessionStatus = "authenticated" // AuthSessionStatusAuthenticated the holder has been authenticated and a connection exists
+	AuthSessionStatusExpired       AuthSessionStatus = "expired"       // AuthSessionStatusExpired the session was not answered before its expiration
+	AuthSessionStatusFailed        AuthSessionStatus = "failed"        // AuthSessionStatusFailed the holder answer could not be verified
+)
+
+// AuthSession is an authentication session started by an issuer QR code
+type AuthSession struct {
+	ID            uuid.UUID                            `json:"id"`
+	IssuerDID     string                               `json:"issuerDID"`
+	Request       protocol.AuthorizationRequestMessage `json:"request"`
+	Status        AuthSessionStatus                    `json:"status"`
+	ConnectionID  *uuid.UUID                           `json:"connectionID,omitempty"`
+	UserDID       *string                              `json:"userDID,omitempty"`
+	FailureReason *string                              `json:"failureReason,omitempty"`
+	CreatedAt     time.Time                            `json:"createdAt"`
+	ExpiresAt     time.Time                            `json:"expiresAt"`
+	CompletedAt   *time.Time                           `json:"completedAt,omitempty"`
+}
+
+// NewAuthSession returns a pending authentication session that expires after the given ttl
+func NewAuthSession(id uuid.UUID, request protocol.AuthorizationRequestMessage, ttl time.Duration) *AuthSession {
+	now := time.Now()
+	return &AuthSession{
+		ID:        id,
+		IssuerDID: request.From,
+		Request:   request,
+		Status:    AuthSessionStatusPending,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+}
+
+// CurrentStatus returns the status of the session, taking into account its expiration
+func (s *AuthSession) CurrentStatus() AuthSessionStatus {
+	if s.Status == AuthSessionStatusPending && time.Now().After(s.ExpiresAt) {
+		return AuthSessionStatusExpired
+	}
+	return s.Status
+}
+
+// Authenticated marks the session as authenticated with the given connection
+func (s *AuthSession) Authenticated(connectionID uuid.UUID, userDID string) {
+	now := time.Now()
+	s.Status = AuthSessionStatusAuthenticated
+	s.ConnectionID = &connectionID
+	s.UserDID = &userDID
+	s.FailureReason = nil
+	s.CompletedAt = &now
+}
+
+// Expired marks the session as expired
+func (s *AuthSession) Expired() {
+	now := time.Now()
+	s.Status = AuthSessionStatusExpired
+	s.CompletedAt = &now
+}
+
+// Failed marks the session as failed with the given reason
+func (s *AuthSession) Failed(reason string) {
+	now := time.Now()
+	s.Status = AuthSessionStatusFailed
+	s.FailureReason = &reason
+	s.CompletedAt = &now
+}
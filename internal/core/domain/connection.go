@@ -17,5 +17,7 @@ type Connection struct {
 	UserDoc     json.RawMessage
 	CreatedAt   time.Time
 	ModifiedAt  time.Time
+	Tags        []string
+	Notes       *string
 	Credentials *Credentials
 }
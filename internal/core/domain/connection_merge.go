@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/protocol"
+)
+
+// ConnectionMergeMethod is what backs the decision of merging two connections
+type ConnectionMergeMethod string
+
+const (
+	ConnectionMergeMethodAdmin ConnectionMergeMethod = "admin" // ConnectionMergeMethodAdmin the issuer decided the connections belong to the same holder
+	ConnectionMergeMethodProof ConnectionMergeMethod = "proof" // ConnectionMergeMethodProof the holder proved control of the merged DID
+)
+
+// ConnectionMergeStatus is the status of a connection merge
+type ConnectionMergeStatus string
+
+const (
+	ConnectionMergeStatusPending   ConnectionMergeStatus = "pending"   // ConnectionMergeStatusPending waiting for the holder to prove control of the DID to merge
+	ConnectionMergeStatusCompleted ConnectionMergeStatus = "completed" // ConnectionMergeStatusCompleted the connections have been merged
+	ConnectionMergeStatusFailed    ConnectionMergeStatus = "failed"    // ConnectionMergeStatusFailed the holder answer could not be verified or the merge was not possible
+)
+
+// ConnectionMerge links the DID of a duplicated connection to the connection that survives. It is kept as the audit
+// trail of the merge.
+type ConnectionMerge struct {
+	ID                  uuid.UUID
+	IssuerDID           w3c.DID
+	ConnectionID        uuid.UUID
+	MergedConnectionID  *uuid.UUID
+	MergedUserDID       *string
+	Method              ConnectionMergeMethod
+	Status              ConnectionMergeStatus
+	Reason              *string
+	ReissueCredentials  bool
+	ReissuedCredentials []string
+	Request             *protocol.AuthorizationRequestMessage
+	QRCodeURL           *string
+	FailureReason       *string
+	CreatedAt           time.Time
+	CompletedAt         *time.Time
+}
+
+// NewConnectionMerge returns a new connection merge with the given method
+func NewConnectionMerge(issuerDID w3c.DID, connectionID uuid.UUID, method ConnectionMergeMethod, reason *string, reissueCredentials bool) *ConnectionMerge {
+	return &ConnectionMerge{
+		ID:                 uuid.New(),
+		IssuerDID:          issuerDID,
+		ConnectionID:       connectionID,
+		Method:             method,
+		Status:             ConnectionMergeStatusPending,
+		Reason:             reason,
+		ReissueCredentials: reissueCredentials,
+		CreatedAt:          time.Now(),
+	}
+}
+
+// Completed marks the merge as completed with the given merged DID. The merged connection is nil when the DID
+// was not connected to the issuer anymore.
+func (m *ConnectionMerge) Completed(mergedConnectionID *uuid.UUID, mergedUserDID string) {
+	now := time.Now()
+	m.Status = ConnectionMergeStatusCompleted
+	m.MergedConnectionID = mergedConnectionID
+	m.MergedUserDID = &mergedUserDID
+	m.FailureReason = nil
+	m.CompletedAt = &now
+}
+
+// Failed marks the merge as failed with the given reason
+func (m *ConnectionMerge) Failed(reason string) {
+	now := time.Now()
+	m.Status = ConnectionMergeStatusFailed
+	m.FailureReason = &reason
+	m.CompletedAt = &now
+}
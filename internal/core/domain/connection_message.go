@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// ConnectionMessageStatus is the delivery status of a message sent to a connection
+type ConnectionMessageStatus string
+
+const (
+	ConnectionMessageStatusPending   ConnectionMessageStatus = "pending"   // ConnectionMessageStatusPending the message is waiting to be pushed
+	ConnectionMessageStatusDelivered ConnectionMessageStatus = "delivered" // ConnectionMessageStatusDelivered the push service accepted the message for every device
+	ConnectionMessageStatusFailed    ConnectionMessageStatus = "failed"    // ConnectionMessageStatusFailed the message could not be pushed
+)
+
+// ConnectionMessage is an iden3comm basic message sent by the issuer to a connection
+type ConnectionMessage struct {
+	ID            uuid.UUID
+	IssuerDID     w3c.DID
+	ConnectionID  uuid.UUID
+	Type          string
+	ThreadID      string
+	Body          json.RawMessage
+	Status        ConnectionMessageStatus
+	FailureReason *string
+	CreatedAt     time.Time
+	DeliveredAt   *time.Time
+}
+
+// NewConnectionMessage returns a pending connection message. A new thread is started if threadID is empty.
+func NewConnectionMessage(issuerDID w3c.DID, connectionID uuid.UUID, msgType string, threadID string, body json.RawMessage) *ConnectionMessage {
+	id := uuid.New()
+	if threadID == "" {
+		threadID = id.String()
+	}
+	return &ConnectionMessage{
+		ID:           id,
+		IssuerDID:    issuerDID,
+		ConnectionID: connectionID,
+		Type:         msgType,
+		ThreadID:     threadID,
+		Body:         body,
+		Status:       ConnectionMessageStatusPending,
+		CreatedAt:    time.Now(),
+	}
+}
+
+// Delivered marks the message as delivered
+func (m *ConnectionMessage) Delivered() {
+	now := time.Now()
+	m.Status = ConnectionMessageStatusDelivered
+	m.DeliveredAt = &now
+	m.FailureReason = nil
+}
+
+// Failed marks the message as failed with the given reason
+func (m *ConnectionMessage) Failed(reason string) {
+	m.Status = ConnectionMessageStatusFailed
+	m.DeliveredAt = nil
+	m.FailureReason = &reason
+}
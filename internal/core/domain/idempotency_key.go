@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// IdempotencyKey is the Idempotency-Key sent by a client in a mutating request of an identity. Until the request
+// completes the key is locked, so concurrent retries are rejected, and then it keeps the response to replay it to
+// the retries. A key can only be reused with the same request.
+type IdempotencyKey struct {
+	Identifier  string
+	Operation   string
+	Key         string
+	RequestHash string
+	StatusCode  *int
+	Response    []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// NewIdempotencyKey returns a new key locked until lockTimeout, the time a request is expected to complete in
+func NewIdempotencyKey(identifier, operation, key string, request []byte, lockTimeout time.Duration) *IdempotencyKey {
+	// postgres keeps microseconds, the creation date identifies the lock of the key
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	return &IdempotencyKey{
+		Identifier:  identifier,
+		Operation:   operation,
+		Key:         key,
+		RequestHash: HashIdempotentRequest(request),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(lockTimeout),
+	}
+}
+
+// HashIdempotentRequest returns the hash that identifies the request sent with a key
+func HashIdempotentRequest(request []byte) string {
+	hash := sha256.Sum256(request)
+	return hex.EncodeToString(hash[:])
+}
+
+// Completed returns true when the request finished and its response is stored
+func (k *IdempotencyKey) Completed() bool {
+	return k.StatusCode != nil
+}
+
+// Complete stores the response of the request, that is kept for ttl
+func (k *IdempotencyKey) Complete(statusCode int, response []byte, ttl time.Duration) {
+	k.StatusCode = &statusCode
+	k.Response = response
+	k.ExpiresAt = time.Now().Add(ttl)
+}
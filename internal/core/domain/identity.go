@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
@@ -33,6 +34,7 @@ type Identity struct {
 	Balance                       *big.Int                      `json:"balance"`
 	AuthCoreClaimRevocationStatus AuthCoreClaimRevocationStatus `json:"authCoreClaimRevocationStatus"`
 	AuthCredentialsIDs            []string                      `json:"authCredentialsIDs"`
+	TenantID                      *uuid.UUID                    `json:"tenantID"`
 }
 
 // IdentityDisplayName struct
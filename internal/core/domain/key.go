@@ -19,7 +19,14 @@ type Key struct {
 	IssuerDID KeyCoreDID `json:"issuer_did"`
 	PublicKey string     `json:"public_key"`
 	Name      string     `json:"name"`
+	Published bool       `json:"published"`
 	CreatedAt time.Time  `json:"created_at"`
+	// ExpiresAt is the end of the validity period of the key. Keys without it don't expire
+	ExpiresAt *time.Time `json:"expires_at"`
+	// ExpiryWarnedAt is set when the warning about the upcoming expiration has been published
+	ExpiryWarnedAt *time.Time `json:"expiry_warned_at"`
+	// ExpiredAt is set when the expiration of the key has been processed
+	ExpiredAt *time.Time `json:"expired_at"`
 }
 
 // NewKey creates a new Key
@@ -33,6 +40,11 @@ func NewKey(issuerDID w3c.DID, publicKey, name string) *Key {
 	}
 }
 
+// IsExpired returns true if the validity period of the key has ended at the given time
+func (key *Key) IsExpired(now time.Time) bool {
+	return key.ExpiresAt != nil && !key.ExpiresAt.After(now)
+}
+
 // IssuerCoreDID returns the issuer DID as a w3c.DID pointer
 func (key *Key) IssuerCoreDID() *w3c.DID {
 	return common.ToPointer(w3c.DID(key.IssuerDID))
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+// KeyUsage is a sign request of a kms key. Key usages are append only, they are the audit trail of what every
+// key signed and why.
+type KeyUsage struct {
+	ID        uuid.UUID
+	KeyID     string
+	KeyType   kms.KeyType
+	IssuerDID *w3c.DID
+	Purpose   kms.SignPurpose
+	Digest    string
+	Status    kms.SignStatus
+	Reason    *string
+	CreatedAt time.Time
+}
+
+// NewKeyUsage returns the key usage of the given sign request. The issuer is taken from the key id when the key
+// is bound to an identity.
+func NewKeyUsage(record kms.SignRecord) *KeyUsage {
+	usage := &KeyUsage{
+		ID:        uuid.New(),
+		KeyID:     record.KeyID.ID,
+		KeyType:   record.KeyID.Type,
+		IssuerDID: issuerDIDFromKeyID(record.KeyID.ID),
+		Purpose:   record.Purpose,
+		Digest:    hex.EncodeToString(record.Digest),
+		Status:    record.Status,
+		CreatedAt: time.Now(),
+	}
+	if record.Reason != "" {
+		usage.Reason = &record.Reason
+	}
+	return usage
+}
+
+// KeyPolicy restricts how a kms key can be used. An empty AllowedPurposes allows any purpose and a RateLimit of 0
+// does not limit the number of signatures.
+type KeyPolicy struct {
+	KeyID           string
+	IssuerDID       w3c.DID
+	AllowedPurposes []kms.SignPurpose
+	RateLimit       int
+	RateLimitWindow time.Duration
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// NewKeyPolicy returns a new key policy
+func NewKeyPolicy(issuerDID w3c.DID, keyID string, allowedPurposes []kms.SignPurpose, rateLimit int, rateLimitWindow time.Duration) *KeyPolicy {
+	now := time.Now()
+	return &KeyPolicy{
+		KeyID:           keyID,
+		IssuerDID:       issuerDID,
+		AllowedPurposes: allowedPurposes,
+		RateLimit:       rateLimit,
+		RateLimitWindow: rateLimitWindow,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+// Allows returns true if the key can sign for the given purpose
+func (p *KeyPolicy) Allows(purpose kms.SignPurpose) bool {
+	return len(p.AllowedPurposes) == 0 || slices.Contains(p.AllowedPurposes, purpose)
+}
+
+// IsRateLimited returns true if the policy limits the number of signatures
+func (p *KeyPolicy) IsRateLimited() bool {
+	return p.RateLimit > 0 && p.RateLimitWindow > 0
+}
+
+// issuerDIDFromKeyID returns the identity a key is bound to. Bound key ids contain the DID followed by a slash.
+func issuerDIDFromKeyID(keyID string) *w3c.DID {
+	start := strings.Index(keyID, "did:")
+	if start < 0 {
+		return nil
+	}
+	end := strings.Index(keyID[start:], "/")
+	if end < 0 {
+		return nil
+	}
+	did, err := w3c.ParseDID(keyID[start : start+end])
+	if err != nil {
+		return nil
+	}
+	return did
+}
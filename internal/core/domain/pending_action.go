@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+// PendingActionType is an operation that can be held until it is approved
+type PendingActionType string
+
+const (
+	PendingActionTypePublishState        PendingActionType = "publishState"        // PendingActionTypePublishState publish the identity state on chain
+	PendingActionTypeRevokeCredentials   PendingActionType = "revokeCredentials"   // PendingActionTypeRevokeCredentials revoke one or more credentials
+	PendingActionTypeCreateKey           PendingActionType = "createKey"           // PendingActionTypeCreateKey create a new key
+	PendingActionTypeDeleteKey           PendingActionType = "deleteKey"           // PendingActionTypeDeleteKey delete a key
+	PendingActionTypeCreatePaymentOption PendingActionType = "createPaymentOption" // PendingActionTypeCreatePaymentOption create a payment option
+	PendingActionTypeDeleteConnection    PendingActionType = "deleteConnection"    // PendingActionTypeDeleteConnection delete a connection revoking its credentials, held as a revocation
+)
+
+// PendingActionStatus is the status of a pending action
+type PendingActionStatus string
+
+const (
+	PendingActionStatusPending  PendingActionStatus = "pending"  // PendingActionStatusPending waiting for approvals
+	PendingActionStatusApproved PendingActionStatus = "approved" // PendingActionStatusApproved approved and being executed
+	PendingActionStatusExecuted PendingActionStatus = "executed" // PendingActionStatusExecuted approved and executed
+	PendingActionStatusFailed   PendingActionStatus = "failed"   // PendingActionStatusFailed approved but the execution failed
+	PendingActionStatusRejected PendingActionStatus = "rejected" // PendingActionStatusRejected rejected by an approver
+	PendingActionStatusExpired  PendingActionStatus = "expired"  // PendingActionStatusExpired not approved in time
+)
+
+// PendingActionDecision is the answer of an approver to a pending action
+type PendingActionDecision string
+
+const (
+	PendingActionDecisionApproved PendingActionDecision = "approved" // PendingActionDecisionApproved the approver confirmed the action
+	PendingActionDecisionRejected PendingActionDecision = "rejected" // PendingActionDecisionRejected the approver rejected the action
+)
+
+// PendingActionApproval is the decision of one approver. Approver is the api key or the bearer token subject the
+// approver called the API with, or the DID of the approver.
+type PendingActionApproval struct {
+	Approver  string
+	Decision  PendingActionDecision
+	Reason    *string
+	CreatedAt time.Time
+}
+
+// PublishStatePayload is the payload of a publish state action. Retry publishes again the last failed state.
+type PublishStatePayload struct {
+	Retry bool `json:"retry,omitempty"`
+}
+
+// RevokeCredentialsPayload is the payload of a revoke credentials action. The nonces are resolved when the action
+// is held, so approvers confirm exactly the credentials that are revoked.
+type RevokeCredentialsPayload struct {
+	Nonces []uint64 `json:"nonces"`
+}
+
+// DeleteConnectionPayload is the payload of a delete connection action. The credentials are revoked before the
+// connection is deleted.
+type DeleteConnectionPayload struct {
+	ConnectionID      uuid.UUID `json:"connectionID"`
+	DeleteCredentials bool      `json:"deleteCredentials"`
+	Nonces            []uint64  `json:"nonces"`
+}
+
+// CreateKeyPayload is the payload of a create key action
+type CreateKeyPayload struct {
+	KeyType   kms.KeyType `json:"keyType"`
+	Name      string      `json:"name"`
+	ExpiresAt *time.Time  `json:"expiresAt,omitempty"`
+}
+
+// DeleteKeyPayload is the payload of a delete key action
+type DeleteKeyPayload struct {
+	KeyID string `json:"keyID"`
+}
+
+// CreatePaymentOptionPayload is the payload of a create payment option action
+type CreatePaymentOptionPayload struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Config      PaymentOptionConfig `json:"config"`
+}
+
+// PendingAction is an operation held until the required number of approvers confirm it. It is executed by the
+// last approval and kept afterward as the audit trail of the decision. RequestedBy is the caller that asked for the
+// operation, that can not approve it.
+type PendingAction struct {
+	ID                uuid.UUID
+	IssuerDID         w3c.DID
+	Type              PendingActionType
+	RequestedBy       *string
+	Payload           json.RawMessage
+	Status            PendingActionStatus
+	RequiredApprovals int
+	Approvals         []PendingActionApproval
+	Request           *protocol.AuthorizationRequestMessage
+	QRCodeURL         *string
+	Result            json.RawMessage
+	FailureReason     *string
+	CreatedAt         time.Time
+	ExpiresAt         time.Time
+	CompletedAt       *time.Time
+}
+
+// NewPendingAction returns a new pending action with the given payload
+func NewPendingAction(issuerDID w3c.DID, actionType PendingActionType, requestedBy *string, payload any, requiredApprovals int, expiresAt time.Time) (*PendingAction, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &PendingAction{
+		ID:                uuid.New(),
+		IssuerDID:         issuerDID,
+		Type:              actionType,
+		RequestedBy:       requestedBy,
+		Payload:           raw,
+		Status:            PendingActionStatusPending,
+		RequiredApprovals: requiredApprovals,
+		Approvals:         make([]PendingActionApproval, 0),
+		CreatedAt:         time.Now(),
+		ExpiresAt:         expiresAt,
+	}, nil
+}
+
+// ApprovalsCount returns the number of approvers that confirmed the action
+func (a *PendingAction) ApprovalsCount() int {
+	count := 0
+	for _, approval := range a.Approvals {
+		if approval.Decision == PendingActionDecisionApproved {
+			count++
+		}
+	}
+	return count
+}
+
+// HasDecided returns true if the approver already approved or rejected the action
+func (a *PendingAction) HasDecided(approver string) bool {
+	for _, approval := range a.Approvals {
+		if approval.Approver == approver {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRequester returns true if the caller asked for the action
+func (a *PendingAction) IsRequester(caller string) bool {
+	return a.RequestedBy != nil && *a.RequestedBy == caller
+}
+
+// IsExpired returns true if the action is still pending after its expiration
+func (a *PendingAction) IsExpired() bool {
+	return a.Status == PendingActionStatusPending && time.Now().After(a.ExpiresAt)
+}
+
+// Executed marks the action as executed with the given result
+func (a *PendingAction) Executed(result json.RawMessage) {
+	now := time.Now()
+	a.Status = PendingActionStatusExecuted
+	a.Result = result
+	a.CompletedAt = &now
+}
+
+// Finished marks the action with the given final status and reason
+func (a *PendingAction) Finished(status PendingActionStatus, reason string) {
+	now := time.Now()
+	a.Status = status
+	a.FailureReason = &reason
+	a.CompletedAt = &now
+}
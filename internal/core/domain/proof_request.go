@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/protocol"
+)
+
+// ProofRequestStatus is the status of a proof request
+type ProofRequestStatus string
+
+const (
+	ProofRequestStatusPending  ProofRequestStatus = "pending"  // ProofRequestStatusPending waiting for the holder to answer
+	ProofRequestStatusVerified ProofRequestStatus = "verified" // ProofRequestStatusVerified a holder answered with valid proofs
+	ProofRequestStatusFailed   ProofRequestStatus = "failed"   // ProofRequestStatusFailed the request could not be sent to the connection
+)
+
+// ProofRequest is an authorization request with zero knowledge query scopes created by the issuer acting as verifier.
+// It can target a specific connection or be shared as a public QR code. A public request stays open and keeps the
+// verified answer of each holder that answers it.
+type ProofRequest struct {
+	ID            uuid.UUID
+	IssuerDID     w3c.DID
+	ConnectionID  *uuid.UUID
+	UserDID       *string
+	Reason        string
+	Message       protocol.AuthorizationRequestMessage
+	QRCodeURL     *string
+	Status        ProofRequestStatus
+	Results       []ProofRequestResult
+	FailureReason *string
+	CreatedAt     time.Time
+	VerifiedAt    *time.Time
+	Responses     []ProofRequestResponse
+}
+
+// ProofRequestResponse is the verified answer of a holder to a proof request
+type ProofRequestResponse struct {
+	ID             uuid.UUID
+	ProofRequestID uuid.UUID
+	UserDID        string
+	ConnectionID   *uuid.UUID
+	Results        []ProofRequestResult
+	CreatedAt      time.Time
+}
+
+// ProofRequestResult holds the verified outcome of one of the scopes of a proof request
+type ProofRequestResult struct {
+	ID        uint32                 `json:"id"`
+	CircuitID string                 `json:"circuitId"`
+	Outputs   map[string]interface{} `json:"outputs"`
+	Disclosed map[string]string      `json:"disclosed,omitempty"`
+}
+
+// IsPublic returns true when the request is shared as a QR code instead of being sent to a connection
+func (p *ProofRequest) IsPublic() bool {
+	return p.QRCodeURL != nil
+}
+
+// IsOpen returns true when the request accepts answers. A public request keeps accepting the answers of new holders.
+func (p *ProofRequest) IsOpen() bool {
+	if p.IsPublic() {
+		return p.Status != ProofRequestStatusFailed
+	}
+	return p.Status == ProofRequestStatusPending
+}
+
+// Verified records the verified answer of the given user and marks the proof request as verified. The answer of a
+// request sent to a connection is also kept on the request itself.
+func (p *ProofRequest) Verified(userDID string, connectionID *uuid.UUID, results []ProofRequestResult) *ProofRequestResponse {
+	now := time.Now()
+	response := &ProofRequestResponse{
+		ID:             uuid.New(),
+		ProofRequestID: p.ID,
+		UserDID:        userDID,
+		ConnectionID:   connectionID,
+		Results:        results,
+		CreatedAt:      now,
+	}
+	if !p.IsPublic() {
+		p.UserDID = &userDID
+		p.Results = results
+		p.VerifiedAt = &now
+	}
+	if p.VerifiedAt == nil {
+		p.VerifiedAt = &now
+	}
+	p.Status = ProofRequestStatusVerified
+	p.FailureReason = nil
+	p.Responses = append(p.Responses, *response)
+	return response
+}
+
+// Failed marks the proof request as failed with the given reason
+func (p *ProofRequest) Failed(reason string) {
+	p.Status = ProofRequestStatusFailed
+	p.FailureReason = &reason
+}
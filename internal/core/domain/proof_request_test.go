@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestProofRequest_Verified(t *testing.T) {
+	connID := uuid.New()
+
+	t.Run("should close a request sent to a connection", func(t *testing.T) {
+		request := ProofRequest{ID: uuid.New(), ConnectionID: &connID, Status: ProofRequestStatusPending}
+		require.True(t, request.IsOpen())
+
+		response := request.Verified("did:holder", &connID, []ProofRequestResult{{ID: 1}})
+		assert.Equal(t, request.ID, response.ProofRequestID)
+		assert.Equal(t, ProofRequestStatusVerified, request.Status)
+		assert.Equal(t, "did:holder", *request.UserDID)
+		assert.Len(t, request.Results, 1)
+		assert.False(t, request.IsOpen())
+	})
+
+	t.Run("should keep a public request open for other holders", func(t *testing.T) {
+		request := ProofRequest{ID: uuid.New(), QRCodeURL: common.ToPointer("iden3comm://"), Status: ProofRequestStatusPending}
+		request.Verified("did:first", &connID, []ProofRequestResult{{ID: 1}})
+		verifiedAt := request.VerifiedAt
+		request.Verified("did:second", nil, []ProofRequestResult{{ID: 1}})
+
+		assert.True(t, request.IsOpen())
+		assert.Equal(t, ProofRequestStatusVerified, request.Status)
+		assert.Nil(t, request.UserDID)
+		assert.Nil(t, request.ConnectionID)
+		assert.Same(t, verifiedAt, request.VerifiedAt)
+		require.Len(t, request.Responses, 2)
+		assert.Equal(t, "did:second", request.Responses[1].UserDID)
+	})
+
+	t.Run("should not accept answers once failed", func(t *testing.T) {
+		request := ProofRequest{ConnectionID: &connID}
+		request.Failed("no push service")
+		assert.False(t, request.IsOpen())
+
+		public := ProofRequest{QRCodeURL: common.ToPointer("iden3comm://")}
+		public.Failed("error")
+		assert.False(t, public.IsOpen())
+	})
+}
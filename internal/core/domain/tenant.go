@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tenant is an organization that owns identities and api keys. The schemas, display methods, payment options and
+// credentials of its identities belong to the tenant. Nil quotas are unlimited.
+type Tenant struct {
+	ID                     uuid.UUID
+	Name                   string
+	MaxIdentities          *int
+	MaxCredentialsPerMonth *int
+	CreatedAt              time.Time
+}
+
+// TenantUsage is the usage of the quotas of a tenant
+type TenantUsage struct {
+	Identities           int
+	CredentialsThisMonth int
+}
+
+// NewTenant returns a new tenant
+func NewTenant(name string, maxIdentities, maxCredentialsPerMonth *int) *Tenant {
+	return &Tenant{
+		ID:                     uuid.New(),
+		Name:                   name,
+		MaxIdentities:          maxIdentities,
+		MaxCredentialsPerMonth: maxCredentialsPerMonth,
+		CreatedAt:              time.Now(),
+	}
+}
+
+// AllowsNewIdentity returns true if the tenant can have one more identity
+func (t *Tenant) AllowsNewIdentity(identities int) bool {
+	return t.MaxIdentities == nil || identities < *t.MaxIdentities
+}
+
+// AllowsNewCredential returns true if the identities of the tenant can issue one more credential this month
+func (t *Tenant) AllowsNewCredential(credentialsThisMonth int) bool {
+	return t.MaxCredentialsPerMonth == nil || credentialsThisMonth < *t.MaxCredentialsPerMonth
+}
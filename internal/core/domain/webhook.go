@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEventType is the type of the issuer lifecycle events sent to the webhooks
+type WebhookEventType string
+
+const (
+	WebhookEventCredentialCreated WebhookEventType = "credential.created" // WebhookEventCredentialCreated credential issued and offered to the holder
+	WebhookEventCredentialFetched WebhookEventType = "credential.fetched" // WebhookEventCredentialFetched credential fetched by the holder
+	WebhookEventCredentialRevoked WebhookEventType = "credential.revoked" // WebhookEventCredentialRevoked credentials revoked
+	WebhookEventStatePublished    WebhookEventType = "state.published"    // WebhookEventStatePublished state transaction sent
+	WebhookEventStateConfirmed    WebhookEventType = "state.confirmed"    // WebhookEventStateConfirmed state transaction confirmed
+	WebhookEventStateFailed       WebhookEventType = "state.failed"       // WebhookEventStateFailed state publication failed
+	WebhookEventConnectionCreated WebhookEventType = "connection.created" // WebhookEventConnectionCreated connection created
+	WebhookEventLinkRedeemed      WebhookEventType = "link.redeemed"      // WebhookEventLinkRedeemed credential issued from a link
+	WebhookEventPaymentVerified   WebhookEventType = "payment.verified"   // WebhookEventPaymentVerified payment request verified
+)
+
+// WebhookEventTypes returns all the webhook event types
+func WebhookEventTypes() []WebhookEventType {
+	return []WebhookEventType{
+		WebhookEventCredentialCreated, WebhookEventCredentialFetched, WebhookEventCredentialRevoked,
+		WebhookEventStatePublished, WebhookEventStateConfirmed, WebhookEventStateFailed,
+		WebhookEventConnectionCreated, WebhookEventLinkRedeemed, WebhookEventPaymentVerified,
+	}
+}
+
+// Webhook is an url of an identity that receives its lifecycle events. The secret signs the deliveries.
+// A webhook with no events receives all of them.
+type Webhook struct {
+	ID          uuid.UUID
+	Identifier  string
+	URL         string
+	Secret      string
+	Events      []WebhookEventType
+	Description *string
+	CreatedAt   time.Time
+}
+
+// NewWebhook returns a new webhook
+func NewWebhook(identifier, url, secret string, events []WebhookEventType, description *string) *Webhook {
+	return &Webhook{
+		ID:          uuid.New(),
+		Identifier:  identifier,
+		URL:         url,
+		Secret:      secret,
+		Events:      events,
+		Description: description,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// Subscribed returns true if the webhook receives the event type
+func (w *Webhook) Subscribed(eventType WebhookEventType) bool {
+	return len(w.Events) == 0 || slices.Contains(w.Events, eventType)
+}
+
+// WebhookDeliveryStatus is the status of a webhook delivery
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"   // WebhookDeliveryPending waiting for the next attempt
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded" // WebhookDeliverySucceeded the webhook answered with a 2xx status
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"    // WebhookDeliveryFailed all the attempts failed
+)
+
+const (
+	webhookRetryBaseDelay = 30 * time.Second
+	webhookRetryMaxDelay  = time.Hour
+)
+
+// WebhookDelivery is the delivery of an event to a webhook and the result of its attempts
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	WebhookID      uuid.UUID
+	EventType      WebhookEventType
+	Payload        []byte
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode *int
+	LastError      *string
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// NewWebhookDelivery returns a new pending delivery of the payload, to be sent right away
+func NewWebhookDelivery(id, webhookID uuid.UUID, eventType WebhookEventType, payload []byte) *WebhookDelivery {
+	now := time.Now()
+	return &WebhookDelivery{
+		ID:            id,
+		WebhookID:     webhookID,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        WebhookDeliveryPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+// Attempted records the result of an attempt. A 2xx status code is a success. Failed attempts are retried with an
+// exponential backoff until maxAttempts. statusCode is nil when the webhook could not be called.
+func (d *WebhookDelivery) Attempted(statusCode *int, err error, maxAttempts int, now time.Time) {
+	d.Attempts++
+	d.LastStatusCode = statusCode
+	d.LastError = nil
+	if err == nil && statusCode != nil && *statusCode >= http.StatusOK && *statusCode < http.StatusMultipleChoices {
+		d.Status = WebhookDeliverySucceeded
+		d.DeliveredAt = &now
+		return
+	}
+	if err == nil {
+		err = fmt.Errorf("unexpected status code %d", *statusCode)
+	}
+	msg := err.Error()
+	d.LastError = &msg
+	if d.Attempts >= maxAttempts {
+		d.Status = WebhookDeliveryFailed
+		return
+	}
+	d.NextAttemptAt = now.Add(webhookRetryDelay(d.Attempts))
+}
+
+// webhookRetryDelay returns the delay after the given number of failed attempts
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := webhookRetryBaseDelay
+	for i := 1; i < attempts && delay < webhookRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, webhookRetryMaxDelay)
+}
+
+// WebhookSignature returns the signature of a delivery, the hex encoded HMAC-SHA256 of "<timestamp>.<body>" with the
+// secret of the webhook
+func WebhookSignature(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = fmt.Fprintf(mac, "%d.", timestamp)
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
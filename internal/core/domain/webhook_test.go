@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestWebhookDelivery_Attempted(t *testing.T) {
+	now := time.Now()
+
+	t.Run("should succeed with a 2xx status", func(t *testing.T) {
+		delivery := NewWebhookDelivery(uuid.New(), uuid.New(), WebhookEventCredentialCreated, []byte(`{}`))
+		delivery.Attempted(common.ToPointer(http.StatusNoContent), nil, 3, now)
+		assert.Equal(t, WebhookDeliverySucceeded, delivery.Status)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Nil(t, delivery.LastError)
+		require.NotNil(t, delivery.DeliveredAt)
+	})
+
+	t.Run("should retry with backoff and fail after the last attempt", func(t *testing.T) {
+		delivery := NewWebhookDelivery(uuid.New(), uuid.New(), WebhookEventCredentialCreated, []byte(`{}`))
+		delivery.Attempted(common.ToPointer(http.StatusInternalServerError), nil, 3, now)
+		assert.Equal(t, WebhookDeliveryPending, delivery.Status)
+		assert.Equal(t, now.Add(30*time.Second), delivery.NextAttemptAt)
+		require.NotNil(t, delivery.LastError)
+
+		delivery.Attempted(nil, errors.New("connection refused"), 3, now)
+		assert.Equal(t, WebhookDeliveryPending, delivery.Status)
+		assert.Equal(t, now.Add(time.Minute), delivery.NextAttemptAt)
+		assert.Equal(t, "connection refused", *delivery.LastError)
+
+		delivery.Attempted(common.ToPointer(http.StatusBadGateway), nil, 3, now)
+		assert.Equal(t, WebhookDeliveryFailed, delivery.Status)
+		assert.Nil(t, delivery.DeliveredAt)
+	})
+
+	t.Run("should cap the retry delay", func(t *testing.T) {
+		assert.Equal(t, time.Hour, webhookRetryDelay(20))
+	})
+}
+
+func TestWebhookSignature(t *testing.T) {
+	signature := WebhookSignature("secret", 1700000000, []byte(`{"type":"credential.created"}`))
+	assert.Len(t, signature, 64)
+	assert.Equal(t, signature, WebhookSignature("secret", 1700000000, []byte(`{"type":"credential.created"}`)))
+	assert.NotEqual(t, signature, WebhookSignature("other", 1700000000, []byte(`{"type":"credential.created"}`)))
+	assert.NotEqual(t, signature, WebhookSignature("secret", 1700000001, []byte(`{"type":"credential.created"}`)))
+}
@@ -2,19 +2,34 @@ package event
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/polygonid/sh-id-platform/internal/pubsub"
 )
 
 const (
-	CreateCredentialEvent = "createCredentialEvent" // CreateCredentialEvent create credential event
-	CreateConnectionEvent = "createConnectionEvent" // CreateConnectionEvent create connection MyEvent
-	CreateStateEvent      = "createStateEvent"      // CreateStateEvent create state event
+	CreateCredentialEvent  = "createCredentialEvent"  // CreateCredentialEvent credential ready to be offered event
+	CredentialCreatedEvent = "credentialCreatedEvent" // CredentialCreatedEvent credential stored event
+	CreateConnectionEvent  = "createConnectionEvent"  // CreateConnectionEvent create connection MyEvent
+	CreateStateEvent       = "createStateEvent"       // CreateStateEvent create state event
+	ConnectionMessageEvent = "connectionMessageEvent" // ConnectionMessageEvent send connection message event
+	AuthSessionEvent       = "authSessionEvent"       // AuthSessionEvent authentication session completed event
+	KeyExpiringEvent       = "keyExpiringEvent"       // KeyExpiringEvent key close to the end of its validity period event
+	KeyExpiredEvent        = "keyExpiredEvent"        // KeyExpiredEvent key validity period ended event
+	StatePublishedEvent    = "statePublishedEvent"    // StatePublishedEvent state transaction sent event
+	StateFailedEvent       = "stateFailedEvent"       // StateFailedEvent state publication failed event
+	CredentialFetchedEvent = "credentialFetchedEvent" // CredentialFetchedEvent credential fetched by the holder event
+	CredentialRevokedEvent = "credentialRevokedEvent" // CredentialRevokedEvent credentials revoked event
+	LinkRedeemedEvent      = "linkRedeemedEvent"      // LinkRedeemedEvent credential issued from a link event
+	PaymentVerifiedEvent   = "paymentVerifiedEvent"   // PaymentVerifiedEvent payment request verified event
 )
 
-// CreateState defines the createState data
+// CreateState defines the createState, statePublished and stateFailed data. The createState event is published when
+// the state transaction is confirmed.
 type CreateState struct {
-	State string `json:"state"`
+	State    string  `json:"state"`
+	IssuerID string  `json:"issuerID,omitempty"`
+	TxID     *string `json:"txID,omitempty"`
 }
 
 // Marshal marshals the event into a pubsub.Message
@@ -43,6 +58,23 @@ func (ev *CreateCredential) Unmarshal(msg pubsub.Message) error {
 	return json.Unmarshal(msg, &ev)
 }
 
+// CredentialCreated defines the credentialCreated data. It is published once, when the credential is stored.
+type CredentialCreated struct {
+	CredentialID string `json:"credentialID"`
+	IssuerID     string `json:"issuerID"`
+	SchemaType   string `json:"schemaType"`
+}
+
+// Marshal marshals the event into a pubsub.Message
+func (ev *CredentialCreated) Marshal() (msg pubsub.Message, err error) {
+	return json.Marshal(ev)
+}
+
+// Unmarshal creates an event from that message
+func (ev *CredentialCreated) Unmarshal(msg pubsub.Message) error {
+	return json.Unmarshal(msg, &ev)
+}
+
 // CreateConnection defines the createCredential data
 type CreateConnection struct {
 	ConnectionID string `json:"connectionID"`
@@ -58,3 +90,131 @@ func (ev *CreateConnection) Marshal() (msg pubsub.Message, err error) {
 func (ev *CreateConnection) Unmarshal(msg pubsub.Message) error {
 	return json.Unmarshal(msg, &ev)
 }
+
+// ConnectionMessage defines the connectionMessage data
+type ConnectionMessage struct {
+	MessageID string `json:"messageID"`
+	IssuerID  string `json:"issuerID"`
+}
+
+// Marshal marshals the event into a pubsub.Message
+func (ev *ConnectionMessage) Marshal() (msg pubsub.Message, err error) {
+	return json.Marshal(ev)
+}
+
+// Unmarshal creates an event from that message
+func (ev *ConnectionMessage) Unmarshal(msg pubsub.Message) error {
+	return json.Unmarshal(msg, &ev)
+}
+
+// AuthSession defines the authSession data. It is published when an authentication session is completed or fails.
+type AuthSession struct {
+	SessionID     string     `json:"sessionID"`
+	IssuerID      string     `json:"issuerID"`
+	Status        string     `json:"status"`
+	ConnectionID  *string    `json:"connectionID,omitempty"`
+	UserID        *string    `json:"userID,omitempty"`
+	FailureReason *string    `json:"failureReason,omitempty"`
+	CompletedAt   *time.Time `json:"completedAt,omitempty"`
+}
+
+// Marshal marshals the event into a pubsub.Message
+func (ev *AuthSession) Marshal() (msg pubsub.Message, err error) {
+	return json.Marshal(ev)
+}
+
+// Unmarshal creates an event from that message
+func (ev *AuthSession) Unmarshal(msg pubsub.Message) error {
+	return json.Unmarshal(msg, &ev)
+}
+
+// KeyExpiry defines the keyExpiringEvent and keyExpiredEvent data. AuthCredentialID is the auth credential
+// revoked because the key expired, if any.
+type KeyExpiry struct {
+	IssuerID         string    `json:"issuerID"`
+	PublicKey        string    `json:"publicKey"`
+	Name             string    `json:"name"`
+	ExpiresAt        time.Time `json:"expiresAt"`
+	AuthCredentialID *string   `json:"authCredentialID,omitempty"`
+}
+
+// Marshal marshals the event into a pubsub.Message
+func (ev *KeyExpiry) Marshal() (msg pubsub.Message, err error) {
+	return json.Marshal(ev)
+}
+
+// Unmarshal creates an event from that message
+func (ev *KeyExpiry) Unmarshal(msg pubsub.Message) error {
+	return json.Unmarshal(msg, &ev)
+}
+
+// CredentialFetched defines the credentialFetched data
+type CredentialFetched struct {
+	CredentialID string `json:"credentialID"`
+	IssuerID     string `json:"issuerID"`
+	UserID       string `json:"userID"`
+}
+
+// Marshal marshals the event into a pubsub.Message
+func (ev *CredentialFetched) Marshal() (msg pubsub.Message, err error) {
+	return json.Marshal(ev)
+}
+
+// Unmarshal creates an event from that message
+func (ev *CredentialFetched) Unmarshal(msg pubsub.Message) error {
+	return json.Unmarshal(msg, &ev)
+}
+
+// CredentialRevoked defines the credentialRevoked data. All the credentials share the revocation nonce.
+type CredentialRevoked struct {
+	CredentialIDs []string `json:"credentialIDs"`
+	IssuerID      string   `json:"issuerID"`
+	Nonce         uint64   `json:"nonce"`
+}
+
+// Marshal marshals the event into a pubsub.Message
+func (ev *CredentialRevoked) Marshal() (msg pubsub.Message, err error) {
+	return json.Marshal(ev)
+}
+
+// Unmarshal creates an event from that message
+func (ev *CredentialRevoked) Unmarshal(msg pubsub.Message) error {
+	return json.Unmarshal(msg, &ev)
+}
+
+// LinkRedeemed defines the linkRedeemed data
+type LinkRedeemed struct {
+	LinkID       string `json:"linkID"`
+	IssuerID     string `json:"issuerID"`
+	UserID       string `json:"userID"`
+	CredentialID string `json:"credentialID"`
+}
+
+// Marshal marshals the event into a pubsub.Message
+func (ev *LinkRedeemed) Marshal() (msg pubsub.Message, err error) {
+	return json.Marshal(ev)
+}
+
+// Unmarshal creates an event from that message
+func (ev *LinkRedeemed) Unmarshal(msg pubsub.Message) error {
+	return json.Unmarshal(msg, &ev)
+}
+
+// PaymentVerified defines the paymentVerified data
+type PaymentVerified struct {
+	PaymentRequestID string `json:"paymentRequestID"`
+	IssuerID         string `json:"issuerID"`
+	UserID           string `json:"userID"`
+	Nonce            string `json:"nonce"`
+	Status           string `json:"status"`
+}
+
+// Marshal marshals the event into a pubsub.Message
+func (ev *PaymentVerified) Marshal() (msg pubsub.Message, err error) {
+	return json.Marshal(ev)
+}
+
+// Unmarshal creates an event from that message
+func (ev *PaymentVerified) Unmarshal(msg pubsub.Message) error {
+	return json.Unmarshal(msg, &ev)
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// APIKeyRepository is the interface implemented by the api keys repository
+type APIKeyRepository interface {
+	Save(ctx context.Context, conn db.Querier, apiKey *domain.APIKey) error
+	GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.APIKey, error)
+	GetAll(ctx context.Context, conn db.Querier) ([]domain.APIKey, error)
+	UpdateLastUsed(ctx context.Context, conn db.Querier, id uuid.UUID, lastUsedAt time.Time) error
+}
@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// APIKeyRequest is the request to create an api key
+type APIKeyRequest struct {
+	Name       string
+	Scopes     []domain.APIKeyScope
+	Identities []string
+	TenantID   *uuid.UUID
+	ExpiresAt  *time.Time
+}
+
+// APIKeyService manages the api keys of the admin API and authenticates the requests made with them
+type APIKeyService interface {
+	// Create returns the new api key and its secret token. The token can not be recovered later.
+	Create(ctx context.Context, req APIKeyRequest) (*domain.APIKey, string, error)
+	Get(ctx context.Context, id uuid.UUID) (*domain.APIKey, error)
+	GetAll(ctx context.Context) ([]domain.APIKey, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	Authenticate(ctx context.Context, token string) (*domain.APIKey, error)
+}
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// AuditLogRepository is the interface implemented by the audit log repository. There is no way to update or delete
+// entries.
+type AuditLogRepository interface {
+	// Append chains the entry to the last one and stores it. It must be called in a transaction.
+	Append(ctx context.Context, conn db.Querier, entry *domain.AuditLog) error
+	Find(ctx context.Context, conn db.Querier, filter *AuditLogFilter) ([]domain.AuditLog, uint, error)
+}
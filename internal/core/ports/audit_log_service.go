@@ -0,0 +1,28 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/pagination"
+)
+
+// AuditLogFilter filters the audit log entries. Entries are sorted by sequence.
+type AuditLogFilter struct {
+	Actor      *string
+	Action     *string
+	Identifier *string
+	Outcome    *domain.AuditOutcome
+	From       *time.Time
+	To         *time.Time
+	Pagination pagination.Filter
+}
+
+// AuditLogService records the administrative actions in the audit log
+type AuditLogService interface {
+	Record(ctx context.Context, entry *domain.AuditLog) error
+	Find(ctx context.Context, filter *AuditLogFilter) ([]domain.AuditLog, uint, error)
+	// Verify checks the hash chain of the whole audit log
+	Verify(ctx context.Context) (*domain.AuditLogVerification, error)
+}
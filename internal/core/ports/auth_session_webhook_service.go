@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/pubsub"
+)
+
+// AuthSessionWebhookService sends the outcome of the authentication sessions to the configured webhook
+type AuthSessionWebhookService interface {
+	SendAuthSessionWebhook(ctx context.Context, payload pubsub.Message) error
+}
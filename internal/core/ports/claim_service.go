@@ -80,8 +80,10 @@ type ClaimsFilter struct {
 	SchemaType      string
 	SchemaURL       string
 	Subject         string
+	Subjects        []string // Any of these subjects
 	QueryField      string
 	QueryFieldValue string
+	Attributes      map[string]string // Exact match on credentialSubject fields
 	FTSQuery        string
 	FTSAndCond      bool
 	Proofs          []verifiable.ProofType
@@ -155,4 +157,5 @@ type ClaimService interface {
 	GetByStateIDWithMTPProof(ctx context.Context, did *w3c.DID, state string) ([]*domain.Claim, error)
 	GetAuthCredentials(ctx context.Context, identifier *w3c.DID) ([]*domain.Claim, error)
 	GetAuthCredentialByPublicKey(ctx context.Context, identifier *w3c.DID, pubKey []byte) (*domain.Claim, error)
+	SendCredentialOffer(ctx context.Context, identifier w3c.DID, credentialIDs []uuid.UUID) error
 }
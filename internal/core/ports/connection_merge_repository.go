@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ConnectionMergeRepository is the interface implemented by the connection merges repository
+type ConnectionMergeRepository interface {
+	Save(ctx context.Context, conn db.Querier, merge *domain.ConnectionMerge) error
+	GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.ConnectionMerge, error)
+	GetByConnectionID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, connectionID uuid.UUID) ([]domain.ConnectionMerge, error)
+}
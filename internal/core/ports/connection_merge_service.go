@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// ConnectionMergeCallbackURL is the url the holder answers a connection merge request to
+const ConnectionMergeCallbackURL = "%s/v2/connections/merges/callback?id=%s"
+
+// MergeConnectionsRequest holds the information to merge a duplicated holder into a connection.
+// If ConnectionID is nil the holder has to prove control of the DID to merge by answering a QR code.
+type MergeConnectionsRequest struct {
+	ConnectionID       *uuid.UUID
+	Reason             *string
+	ReissueCredentials bool
+}
+
+// ConnectionMergeService is the interface implemented by the connection merge service
+type ConnectionMergeService interface {
+	Merge(ctx context.Context, issuerDID w3c.DID, connectionID uuid.UUID, req *MergeConnectionsRequest, serverURL string) (*domain.ConnectionMerge, error)
+	Verify(ctx context.Context, id uuid.UUID, token string) (*domain.ConnectionMerge, error)
+	GetByConnectionID(ctx context.Context, issuerDID w3c.DID, connectionID uuid.UUID) ([]domain.ConnectionMerge, error)
+	GetMergedUserDIDs(ctx context.Context, issuerDID w3c.DID, connectionID uuid.UUID) ([]w3c.DID, error)
+}
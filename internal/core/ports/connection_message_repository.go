@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ConnectionMessageRepository is the interface implemented by the connection messages repository
+type ConnectionMessageRepository interface {
+	Save(ctx context.Context, conn db.Querier, message *domain.ConnectionMessage) error
+	UpdateStatus(ctx context.Context, conn db.Querier, message *domain.ConnectionMessage) error
+	GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.ConnectionMessage, error)
+	GetByConnectionID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, connectionID uuid.UUID) ([]domain.ConnectionMessage, error)
+}
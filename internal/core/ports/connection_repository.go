@@ -21,4 +21,7 @@ type ConnectionRepository interface {
 	GetAllWithCredentialsByIssuerID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, filter *NewGetAllConnectionsRequest) ([]domain.Connection, uint, error)
 	GetByUserSessionID(ctx context.Context, conn db.Querier, sessionID uuid.UUID) (*domain.Connection, error)
 	SaveUserAuthentication(ctx context.Context, conn db.Querier, connID uuid.UUID, sessID uuid.UUID, mTime time.Time) error
+	UpdateTagsAndNotes(ctx context.Context, conn db.Querier, connection *domain.Connection) error
+	UpdateUserDID(ctx context.Context, conn db.Querier, connection *domain.Connection) error
+	Merge(ctx context.Context, conn db.Querier, issuerDID w3c.DID, sourceID uuid.UUID, targetID uuid.UUID) error
 }
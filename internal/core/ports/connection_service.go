@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
@@ -13,10 +14,37 @@ import (
 
 // NewGetAllConnectionsRequest struct
 type NewGetAllConnectionsRequest struct {
-	WithCredentials bool
-	Query           string
-	Pagination      pagination.Filter
-	OrderBy         sqltools.OrderByFilters
+	WithCredentials   bool
+	Query             string
+	Tags              []string
+	CredentialsFilter *ConnectionCredentialsFilter
+	Pagination        pagination.Filter
+	OrderBy           sqltools.OrderByFilters
+}
+
+// ConnectionCredentialsFilter selects connections holding at least one credential that matches all the given conditions
+type ConnectionCredentialsFilter struct {
+	SchemaType string            // Partial match on the credential schema type
+	Attributes map[string]string // Exact match on credentialSubject fields
+	Revoked    *bool
+}
+
+// IsEmpty returns true if the filter has no conditions
+func (f *ConnectionCredentialsFilter) IsEmpty() bool {
+	return f == nil || (f.SchemaType == "" && len(f.Attributes) == 0 && f.Revoked == nil)
+}
+
+// UpdateConnectionRequest holds the issuer defined metadata of a connection that can be updated
+type UpdateConnectionRequest struct {
+	Tags  *[]string
+	Notes *string
+}
+
+// SendConnectionMessageRequest holds an iden3comm message to be sent to a connection
+type SendConnectionMessageRequest struct {
+	Type     string
+	ThreadID string
+	Body     json.RawMessage
 }
 
 // NewGetAllRequest returns the request object for obtaining all connections
@@ -79,4 +107,9 @@ type ConnectionService interface {
 	GetByUserID(ctx context.Context, issuerDID w3c.DID, userID w3c.DID) (*domain.Connection, error)
 	GetAllByIssuerID(ctx context.Context, issuerDID w3c.DID, request *NewGetAllConnectionsRequest) ([]domain.Connection, uint, error)
 	GetByUserSessionID(ctx context.Context, sessionID uuid.UUID) (*domain.Connection, error)
+	Update(ctx context.Context, id uuid.UUID, issuerDID w3c.DID, request *UpdateConnectionRequest) error
+	SendMessage(ctx context.Context, id uuid.UUID, issuerDID w3c.DID, request *SendConnectionMessageRequest) (*domain.ConnectionMessage, error)
+	GetMessages(ctx context.Context, id uuid.UUID, issuerDID w3c.DID) ([]domain.ConnectionMessage, error)
+	GetMessage(ctx context.Context, messageID uuid.UUID, issuerDID w3c.DID) (*domain.ConnectionMessage, error)
+	UpdateMessageStatus(ctx context.Context, message *domain.ConnectionMessage) error
 }
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// IdempotencyKeyRepository is the interface implemented by the idempotency keys repository
+type IdempotencyKeyRepository interface {
+	// Create stores the key, replacing an expired one. It returns false when a key that has not expired exists.
+	Create(ctx context.Context, conn db.Querier, key *domain.IdempotencyKey) (bool, error)
+	Get(ctx context.Context, conn db.Querier, identifier, operation, key string) (*domain.IdempotencyKey, error)
+	// Complete stores the response of the key, if it is still locked by the same request
+	Complete(ctx context.Context, conn db.Querier, key *domain.IdempotencyKey) error
+	// Delete removes the key, if it is still locked by the same request
+	Delete(ctx context.Context, conn db.Querier, key *domain.IdempotencyKey) error
+	// DeleteExpired removes the keys expired at now and returns how many
+	DeleteExpired(ctx context.Context, conn db.Querier, now time.Time) (int64, error)
+}
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// IdempotencyKeyService makes the mutating requests of the identities idempotent
+type IdempotencyKeyService interface {
+	// Begin locks the key for the request and returns it. When the request already completed the returned key is
+	// completed and has the response to replay.
+	Begin(ctx context.Context, identifier, operation, key string, request []byte) (*domain.IdempotencyKey, error)
+	// Complete stores the response of the request
+	Complete(ctx context.Context, key *domain.IdempotencyKey, statusCode int, response []byte) error
+	// Release unlocks the key of a request that failed, so it can be retried
+	Release(ctx context.Context, key *domain.IdempotencyKey) error
+	// DeleteExpired removes the expired keys
+	DeleteExpired(ctx context.Context) (int64, error)
+}
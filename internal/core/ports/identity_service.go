@@ -27,6 +27,7 @@ type DIDCreationOptions struct {
 	KeyType              kms.KeyType                     `json:"keyType"`
 	AuthCredentialStatus verifiable.CredentialStatusType `json:"authCredentialStatus,omitempty"`
 	DisplayName          *string                         `json:"displayName,omitempty"`
+	TenantID             *uuid.UUID                      `json:"tenantID,omitempty"`
 }
 
 // CreateAuthenticationQRCodeResponse represents the response of the CreateAuthenticationQRCode method
@@ -34,6 +35,7 @@ type CreateAuthenticationQRCodeResponse struct {
 	QRCodeURL string `json:"qrCodeURL"`
 	SessionID uuid.UUID
 	QrID      uuid.UUID
+	ExpiresAt time.Time
 }
 
 // IdentityService is the interface implemented by the identity service
@@ -41,7 +43,7 @@ type IdentityService interface {
 	GetByDID(ctx context.Context, identifier w3c.DID) (*domain.Identity, error)
 	Create(ctx context.Context, hostURL string, didOptions *DIDCreationOptions) (*domain.Identity, error)
 	SignClaimEntry(ctx context.Context, authClaim *domain.Claim, claimEntry *core.Claim) (*verifiable.BJJSignatureProof2021, error)
-	Get(ctx context.Context) (identities []domain.IdentityDisplayName, err error)
+	Get(ctx context.Context, tenantID *uuid.UUID) (identities []domain.IdentityDisplayName, err error)
 	UpdateState(ctx context.Context, did w3c.DID) (*domain.IdentityState, error)
 	Exists(ctx context.Context, identifier w3c.DID) (bool, error)
 	GetLatestStateByID(ctx context.Context, identifier w3c.DID) (*domain.IdentityState, error)
@@ -53,11 +55,13 @@ type IdentityService interface {
 	UpdateIdentityState(ctx context.Context, state *domain.IdentityState) error
 	GetTransactedStates(ctx context.Context) ([]domain.IdentityState, error)
 	GetStates(ctx context.Context, issuerDID w3c.DID, filter *GetStateTransactionsRequest) ([]domain.IdentityState, uint, error)
-	CreateAuthenticationQRCode(ctx context.Context, serverURL string, issuerDID w3c.DID) (*CreateAuthenticationQRCodeResponse, error)
+	CreateAuthenticationQRCode(ctx context.Context, serverURL string, issuerDID w3c.DID, ttl time.Duration) (*CreateAuthenticationQRCodeResponse, error)
 	Authenticate(ctx context.Context, message string, sessionID uuid.UUID, serverURL string) (*protocol.AuthorizationResponseMessage, error)
+	GetAuthSession(ctx context.Context, issuerDID w3c.DID, sessionID uuid.UUID) (*domain.AuthSession, error)
 	AuthenticateWithRequest(ctx context.Context, sessionID *uuid.UUID, authReq protocol.AuthorizationRequestMessage, message string, serverURL string) (*protocol.AuthorizationResponseMessage, error)
 	GetFailedState(ctx context.Context, identifier w3c.DID) (*domain.IdentityState, error)
 	PublishGenesisStateToRHS(ctx context.Context, did *w3c.DID) error
 	UpdateIdentityDisplayName(ctx context.Context, did w3c.DID, displayName string) error
 	CreateAuthCredential(ctx context.Context, did *w3c.DID, keyID string, revNonce *uint64, expiration *time.Time, version *uint32, credentialStatusType verifiable.CredentialStatusType) (uuid.UUID, error)
+	GetDIDDocument(ctx context.Context, did w3c.DID, serverURL string) (*verifiable.DIDDocument, error)
 }
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
@@ -16,4 +17,5 @@ type KeyRepository interface {
 	GetByPublicKey(ctx context.Context, issuerDID w3c.DID, publicKey string) (*domain.Key, error)
 	Delete(ctx context.Context, issuerDID w3c.DID, publicKey string) error
 	GetByName(ctx context.Context, issuerDID w3c.DID, name string) (*domain.Key, error)
+	GetExpiring(ctx context.Context, before time.Time) ([]*domain.Key, error)
 }
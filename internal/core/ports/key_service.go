@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
@@ -15,6 +16,9 @@ type KMSKey struct {
 	PublicKey                   string
 	HasAssociatedAuthCredential bool
 	Name                        string
+	Published                   bool
+	ExpiresAt                   *time.Time
+	Expired                     bool
 }
 
 // KeyFilter is the filter to use when getting keys
@@ -26,9 +30,11 @@ type KeyFilter struct {
 
 // KeyService is the service that manages keys
 type KeyService interface {
-	Create(ctx context.Context, did *w3c.DID, keyType kms.KeyType, name string) (kms.KeyID, error)
-	Update(ctx context.Context, did *w3c.DID, keyID string, name string) error
+	Create(ctx context.Context, did *w3c.DID, keyType kms.KeyType, name string, expiresAt *time.Time) (kms.KeyID, error)
+	Update(ctx context.Context, did *w3c.DID, keyID string, name string, published *bool, expiresAt *time.Time) error
 	Get(ctx context.Context, did *w3c.DID, keyID string) (*KMSKey, error)
 	GetAll(ctx context.Context, did *w3c.DID, filter KeyFilter) ([]*KMSKey, uint, error)
 	Delete(ctx context.Context, did *w3c.DID, keyID string) error
+	Backup(ctx context.Context, did *w3c.DID, shares, threshold int) (*kms.KeyBackup, [][]byte, error)
+	ProcessExpirations(ctx context.Context) error
 }
@@ -0,0 +1,34 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+// KeyUsageFilter is the filter to use when getting the usages of a key
+type KeyUsageFilter struct {
+	Purpose    *kms.SignPurpose
+	Status     *kms.SignStatus
+	MaxResults uint // Max number of results to return on each call.
+	Page       uint // Page number to return. First is 1.
+}
+
+// KeyUsageRepository is the interface implemented by the key usages repository. Key usages can only be added.
+type KeyUsageRepository interface {
+	Save(ctx context.Context, conn db.Querier, usage *domain.KeyUsage) error
+	CountSigned(ctx context.Context, conn db.Querier, keyID string, since time.Time) (int, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID, keyID string, filter KeyUsageFilter) ([]domain.KeyUsage, uint, error)
+}
+
+// KeyPolicyRepository is the interface implemented by the key policies repository
+type KeyPolicyRepository interface {
+	Save(ctx context.Context, conn db.Querier, policy *domain.KeyPolicy) error
+	GetByKeyID(ctx context.Context, conn db.Querier, keyID string) (*domain.KeyPolicy, error)
+	Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, keyID string) error
+}
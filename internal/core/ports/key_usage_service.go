@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+// KeyPolicyRequest is the policy to set to a key
+type KeyPolicyRequest struct {
+	AllowedPurposes []kms.SignPurpose
+	RateLimit       int
+	RateLimitWindow time.Duration
+}
+
+// KeyUsageService records the sign requests of the kms keys and enforces their policies
+type KeyUsageService interface {
+	kms.SignAuditor
+	GetUsages(ctx context.Context, issuerDID w3c.DID, keyID string, filter KeyUsageFilter) ([]domain.KeyUsage, uint, error)
+	GetPolicy(ctx context.Context, issuerDID w3c.DID, keyID string) (*domain.KeyPolicy, error)
+	SetPolicy(ctx context.Context, issuerDID w3c.DID, keyID string, req KeyPolicyRequest) (*domain.KeyPolicy, error)
+	DeletePolicy(ctx context.Context, issuerDID w3c.DID, keyID string) error
+}
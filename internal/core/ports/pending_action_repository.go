@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// PendingActionRepository is the interface implemented by the pending actions repository
+type PendingActionRepository interface {
+	Save(ctx context.Context, conn db.Querier, action *domain.PendingAction) error
+	AddApproval(ctx context.Context, conn db.Querier, id uuid.UUID, approval domain.PendingActionApproval) error
+	GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.PendingAction, error)
+	GetByIDForUpdate(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.PendingAction, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID, status *domain.PendingActionStatus) ([]domain.PendingAction, error)
+	GetApproved(ctx context.Context, conn db.Querier) ([]uuid.UUID, error)
+}
@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// PendingActionCallbackURL is the url a DID approver answers the approval request of a pending action to
+const PendingActionCallbackURL = "%s/v2/pending-actions/callback?id=%s"
+
+// PendingActionService is the interface implemented by the pending action service
+type PendingActionService interface {
+	IsRequired(actionType domain.PendingActionType, credentials int) bool
+	Hold(ctx context.Context, issuerDID w3c.DID, actionType domain.PendingActionType, requestedBy *string, payload any, serverURL string) (*domain.PendingAction, error)
+	Approve(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, approver string, reason *string) (*domain.PendingAction, error)
+	Reject(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, approver string, reason *string) (*domain.PendingAction, error)
+	Verify(ctx context.Context, id uuid.UUID, token string) (*domain.PendingAction, error)
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.PendingAction, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID, status *domain.PendingActionStatus) ([]domain.PendingAction, error)
+	ExecuteApproved(ctx context.Context) error
+}
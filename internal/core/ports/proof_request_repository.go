@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ProofRequestRepository is the interface implemented by the proof requests repository
+type ProofRequestRepository interface {
+	Save(ctx context.Context, conn db.Querier, request *domain.ProofRequest) error
+	GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.ProofRequest, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID, connectionID *uuid.UUID) ([]domain.ProofRequest, error)
+	SaveResponse(ctx context.Context, conn db.Querier, response *domain.ProofRequestResponse) error
+	GetResponses(ctx context.Context, conn db.Querier, proofRequestIDs []uuid.UUID) ([]domain.ProofRequestResponse, error)
+}
@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+const (
+	// ProofRequestCallbackURL is the URL the holder calls back with the proofs of a proof request
+	ProofRequestCallbackURL = "%s/v2/proof-requests/callback?id=%s"
+)
+
+// CreateProofRequestRequest holds the data to create a proof request.
+// The request is sent to the connection if ConnectionID is provided, otherwise a public QR code is created.
+type CreateProofRequestRequest struct {
+	ConnectionID *uuid.UUID
+	Reason       string
+	Scope        []protocol.ZeroKnowledgeProofRequest
+}
+
+// ProofRequestService is the interface implemented by the proof request service
+type ProofRequestService interface {
+	Create(ctx context.Context, issuerDID w3c.DID, request *CreateProofRequestRequest, serverURL string) (*domain.ProofRequest, error)
+	Verify(ctx context.Context, id uuid.UUID, token string, serverURL string) (*domain.ProofRequest, error)
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.ProofRequest, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID, connectionID *uuid.UUID) ([]domain.ProofRequest, error)
+}
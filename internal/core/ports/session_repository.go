@@ -3,11 +3,11 @@ package ports
 import (
 	"context"
 
-	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 )
 
 // SessionRepository defines the interface for managing sessions
 type SessionRepository interface {
-	Get(ctx context.Context, key string) (protocol.AuthorizationRequestMessage, error)
-	Set(ctx context.Context, key string, value protocol.AuthorizationRequestMessage) error
+	Get(ctx context.Context, key string) (*domain.AuthSession, error)
+	Set(ctx context.Context, key string, value *domain.AuthSession) error
 }
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// TenantRepository is the interface implemented by the tenants repository
+type TenantRepository interface {
+	Save(ctx context.Context, conn db.Querier, tenant *domain.Tenant) error
+	GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.Tenant, error)
+	GetByIDForUpdate(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.Tenant, error)
+	GetAll(ctx context.Context, conn db.Querier) ([]domain.Tenant, error)
+	GetByIdentity(ctx context.Context, conn db.Querier, identifier string) (*domain.Tenant, error)
+	GetIdentities(ctx context.Context, conn db.Querier, id uuid.UUID) ([]string, error)
+	AddIdentity(ctx context.Context, conn db.Querier, id uuid.UUID, identifier string) error
+	CountIdentities(ctx context.Context, conn db.Querier, id uuid.UUID) (int, error)
+	CountCredentialsSince(ctx context.Context, conn db.Querier, id uuid.UUID, since time.Time) (int, error)
+}
@@ -0,0 +1,33 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// TenantRequest is the request to create or update a tenant. Nil fields are not updated and a quota of 0 removes it.
+type TenantRequest struct {
+	Name                   *string
+	MaxIdentities          *int
+	MaxCredentialsPerMonth *int
+}
+
+// TenantService is the interface implemented by the tenants service
+type TenantService interface {
+	Create(ctx context.Context, req TenantRequest) (*domain.Tenant, error)
+	Update(ctx context.Context, id uuid.UUID, req TenantRequest) (*domain.Tenant, error)
+	Get(ctx context.Context, id uuid.UUID) (*domain.Tenant, error)
+	GetAll(ctx context.Context) ([]domain.Tenant, error)
+	GetUsage(ctx context.Context, id uuid.UUID) (*domain.TenantUsage, error)
+	GetIdentities(ctx context.Context, id uuid.UUID) ([]string, error)
+	AddIdentity(ctx context.Context, id uuid.UUID, identifier w3c.DID) error
+	CheckIdentityQuota(ctx context.Context, id uuid.UUID) error
+	CheckCredentialQuota(ctx context.Context, identifier w3c.DID) error
+	LockIdentityQuota(ctx context.Context, tx db.Querier, id uuid.UUID) error
+	LockCredentialQuota(ctx context.Context, tx db.Querier, identifier w3c.DID) error
+}
@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/pagination"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// WebhookRepository is the interface implemented by the webhooks repository
+type WebhookRepository interface {
+	Save(ctx context.Context, conn db.Querier, webhook *domain.Webhook) error
+	GetByID(ctx context.Context, conn db.Querier, identifier w3c.DID, id uuid.UUID) (*domain.Webhook, error)
+	GetWebhook(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.Webhook, error)
+	GetAll(ctx context.Context, conn db.Querier, identifier w3c.DID) ([]domain.Webhook, error)
+	Delete(ctx context.Context, conn db.Querier, identifier w3c.DID, id uuid.UUID) error
+	SaveDelivery(ctx context.Context, conn db.Querier, delivery *domain.WebhookDelivery) error
+	GetDeliveries(ctx context.Context, conn db.Querier, webhookID uuid.UUID, filter pagination.Filter) ([]domain.WebhookDelivery, uint, error)
+	// ClaimDueDeliveries returns up to limit pending deliveries due at now and postpones them until leaseUntil, so
+	// other instances don't send them at the same time
+	ClaimDueDeliveries(ctx context.Context, conn db.Querier, now, leaseUntil time.Time, limit int) ([]domain.WebhookDelivery, error)
+}
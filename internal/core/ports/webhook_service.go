@@ -0,0 +1,33 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/pagination"
+	"github.com/polygonid/sh-id-platform/internal/pubsub"
+)
+
+// WebhookRequest is the request to register a webhook
+type WebhookRequest struct {
+	URL         string
+	Events      []domain.WebhookEventType
+	Description *string
+}
+
+// WebhookService manages the webhooks of the identities and delivers the issuer lifecycle events to them
+type WebhookService interface {
+	// Create returns the new webhook with its secret, used to verify the signature of the deliveries
+	Create(ctx context.Context, identifier w3c.DID, req WebhookRequest) (*domain.Webhook, error)
+	Get(ctx context.Context, identifier w3c.DID, id uuid.UUID) (*domain.Webhook, error)
+	GetAll(ctx context.Context, identifier w3c.DID) ([]domain.Webhook, error)
+	Delete(ctx context.Context, identifier w3c.DID, id uuid.UUID) error
+	GetDeliveries(ctx context.Context, identifier w3c.DID, id uuid.UUID, filter pagination.Filter) ([]domain.WebhookDelivery, uint, error)
+	// Subscribe queues a delivery of the pubsub events to the webhooks of the identity of the event
+	Subscribe(ctx context.Context, subscriber pubsub.Subscriber)
+	// ProcessDeliveries sends the pending deliveries that are due
+	ProcessDeliveries(ctx context.Context) error
+}
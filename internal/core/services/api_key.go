@@ -0,0 +1,215 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const (
+	apiKeyTokenPrefix  = "ink_"
+	apiKeySecretLength = 32
+	// apiKeyLastUsedPrecision avoids writing the last use of the key on every request
+	apiKeyLastUsedPrecision = time.Minute
+)
+
+var (
+	// ErrAPIKeyNotFound is returned when the api key does not exist
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	// ErrInvalidAPIKeyRequest is returned when the name, scopes, identities or expiration of the api key are not valid
+	ErrInvalidAPIKeyRequest = errors.New("invalid api key request")
+	// ErrInvalidAPIKey is returned when the token does not belong to an active api key
+	ErrInvalidAPIKey = errors.New("invalid api key")
+)
+
+type apiKey struct {
+	repo       ports.APIKeyRepository
+	tenantRepo ports.TenantRepository
+	storage    *db.Storage
+}
+
+// NewAPIKey returns the service that manages the api keys of the admin API
+func NewAPIKey(repo ports.APIKeyRepository, tenantRepo ports.TenantRepository, storage *db.Storage) ports.APIKeyService {
+	return &apiKey{
+		repo:       repo,
+		tenantRepo: tenantRepo,
+		storage:    storage,
+	}
+}
+
+// Create validates the request and stores a new api key. The returned token is the only copy of the secret.
+func (a *apiKey) Create(ctx context.Context, req ports.APIKeyRequest) (*domain.APIKey, string, error) {
+	if err := validateAPIKeyRequest(req); err != nil {
+		return nil, "", err
+	}
+	if req.TenantID != nil {
+		if _, err := a.tenantRepo.GetByID(ctx, a.storage.Pgx, *req.TenantID); err != nil {
+			if errors.Is(err, repositories.ErrTenantNotFound) {
+				return nil, "", fmt.Errorf("%w: tenant not found", ErrInvalidAPIKeyRequest)
+			}
+			return nil, "", err
+		}
+	}
+
+	secret := make([]byte, apiKeySecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	encodedSecret := base64.RawURLEncoding.EncodeToString(secret)
+	key := domain.NewAPIKey(strings.TrimSpace(req.Name), hashAPIKeySecret(encodedSecret), req.Scopes, req.Identities, req.ExpiresAt)
+	key.TenantID = req.TenantID
+	if err := a.repo.Save(ctx, a.storage.Pgx, key); err != nil {
+		log.Error(ctx, "saving api key", "err", err)
+		return nil, "", err
+	}
+
+	log.Info(ctx, "api key created", "id", key.ID, "name", key.Name, "scopes", key.Scopes, "identities", key.Identities, "tenant", key.TenantID)
+	return key, apiKeyTokenPrefix + key.ID.String() + "." + encodedSecret, nil
+}
+
+// Get returns the api key with the given id
+func (a *apiKey) Get(ctx context.Context, id uuid.UUID) (*domain.APIKey, error) {
+	key, err := a.repo.GetByID(ctx, a.storage.Pgx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAPIKeyNotFound) {
+			return nil, ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	return key, nil
+}
+
+// GetAll returns all the api keys
+func (a *apiKey) GetAll(ctx context.Context) ([]domain.APIKey, error) {
+	return a.repo.GetAll(ctx, a.storage.Pgx)
+}
+
+// Revoke revokes the api key, so it can't be used anymore. Revoking a revoked key does nothing.
+func (a *apiKey) Revoke(ctx context.Context, id uuid.UUID) error {
+	key, err := a.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if key.RevokedAt != nil {
+		return nil
+	}
+	now := time.Now()
+	key.RevokedAt = &now
+	if err := a.repo.Save(ctx, a.storage.Pgx, key); err != nil {
+		log.Error(ctx, "revoking api key", "err", err, "id", id)
+		return err
+	}
+	log.Info(ctx, "api key revoked", "id", key.ID, "name", key.Name)
+	return nil
+}
+
+// Authenticate returns the active api key of the token. The identities of a key of a tenant are the identities of
+// the tenant, or the ones of the key that belong to the tenant if the key is restricted to some identities.
+func (a *apiKey) Authenticate(ctx context.Context, token string) (*domain.APIKey, error) {
+	id, secret, ok := parseAPIKeyToken(token)
+	if !ok {
+		return nil, ErrInvalidAPIKey
+	}
+	key, err := a.repo.GetByID(ctx, a.storage.Pgx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAPIKeyNotFound) {
+			return nil, ErrInvalidAPIKey
+		}
+		log.Error(ctx, "getting api key", "err", err, "id", id)
+		return nil, err
+	}
+
+	now := time.Now()
+	if subtle.ConstantTimeCompare(key.KeyHash, hashAPIKeySecret(secret)) != 1 || !key.IsActive(now) {
+		log.Warn(ctx, "invalid api key used", "id", id)
+		return nil, ErrInvalidAPIKey
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) > apiKeyLastUsedPrecision {
+		if err := a.repo.UpdateLastUsed(ctx, a.storage.Pgx, key.ID, now); err != nil {
+			log.Warn(ctx, "updating api key last use", "err", err, "id", key.ID)
+		}
+		key.LastUsedAt = &now
+	}
+
+	if key.TenantID != nil {
+		tenantIdentities, err := a.tenantRepo.GetIdentities(ctx, a.storage.Pgx, *key.TenantID)
+		if err != nil {
+			log.Error(ctx, "getting tenant identities", "err", err, "id", key.ID, "tenant", key.TenantID)
+			return nil, err
+		}
+		if len(key.Identities) > 0 {
+			tenantIdentities = slices.DeleteFunc(tenantIdentities, func(identity string) bool {
+				return !slices.Contains(key.Identities, identity)
+			})
+		}
+		key.Identities = tenantIdentities
+	}
+	return key, nil
+}
+
+func validateAPIKeyRequest(req ports.APIKeyRequest) error {
+	if strings.TrimSpace(req.Name) == "" {
+		return fmt.Errorf("%w: the name is required", ErrInvalidAPIKeyRequest)
+	}
+	if len(req.Scopes) == 0 {
+		return fmt.Errorf("%w: at least one scope is required", ErrInvalidAPIKeyRequest)
+	}
+	for _, scope := range req.Scopes {
+		if !slices.Contains(domain.APIKeyScopes(), scope) {
+			return fmt.Errorf("%w: unknown scope %s", ErrInvalidAPIKeyRequest, scope)
+		}
+	}
+	for _, identity := range req.Identities {
+		if _, err := w3c.ParseDID(identity); err != nil {
+			return fmt.Errorf("%w: invalid identity %s", ErrInvalidAPIKeyRequest, identity)
+		}
+	}
+	for _, scope := range []domain.APIKeyScope{domain.APIKeyScopeAPIKeysAdmin, domain.APIKeyScopeTenantsAdmin} {
+		if (len(req.Identities) > 0 || req.TenantID != nil) && slices.Contains(req.Scopes, scope) {
+			return fmt.Errorf("%w: the %s scope can not be restricted to some identities or a tenant", ErrInvalidAPIKeyRequest, scope)
+		}
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return fmt.Errorf("%w: the expiration must be in the future", ErrInvalidAPIKeyRequest)
+	}
+	return nil
+}
+
+// parseAPIKeyToken splits a token with the format ink_<id>.<secret>
+func parseAPIKeyToken(token string) (uuid.UUID, string, bool) {
+	idAndSecret, ok := strings.CutPrefix(token, apiKeyTokenPrefix)
+	if !ok {
+		return uuid.Nil, "", false
+	}
+	rawID, secret, ok := strings.Cut(idAndSecret, ".")
+	if !ok || secret == "" {
+		return uuid.Nil, "", false
+	}
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		return uuid.Nil, "", false
+	}
+	return id, secret, true
+}
+
+func hashAPIKeySecret(secret string) []byte {
+	hash := sha256.Sum256([]byte(secret))
+	return hash[:]
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/pagination"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// auditLogVerifyPageSize is the number of entries read at once when verifying the chain
+const auditLogVerifyPageSize = 1000
+
+type auditLog struct {
+	repo    ports.AuditLogRepository
+	storage *db.Storage
+}
+
+// NewAuditLog returns a new audit log service
+func NewAuditLog(repo ports.AuditLogRepository, storage *db.Storage) ports.AuditLogService {
+	return &auditLog{repo: repo, storage: storage}
+}
+
+// Record appends the entry to the audit log
+func (a *auditLog) Record(ctx context.Context, entry *domain.AuditLog) error {
+	return a.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		return a.repo.Append(ctx, tx, entry)
+	})
+}
+
+// Find returns the entries that match the filter and the total number of them
+func (a *auditLog) Find(ctx context.Context, filter *ports.AuditLogFilter) ([]domain.AuditLog, uint, error) {
+	return a.repo.Find(ctx, a.storage.Pgx, filter)
+}
+
+// Verify reads the whole audit log and checks that every entry follows the previous one
+func (a *auditLog) Verify(ctx context.Context) (*domain.AuditLogVerification, error) {
+	verification := &domain.AuditLogVerification{Valid: true}
+	var previous *domain.AuditLog
+	for page := uint(1); ; page++ {
+		entries, _, err := a.repo.Find(ctx, a.storage.Pgx, &ports.AuditLogFilter{
+			Pagination: pagination.Filter{MaxResults: auditLogVerifyPageSize, Page: common.ToPointer(page)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range entries {
+			if !entries[i].FollowsChain(previous) {
+				verification.Valid = false
+				verification.BrokenAt = common.ToPointer(entries[i].Sequence)
+				return verification, nil
+			}
+			verification.Entries++
+			previous = &entries[i]
+		}
+		if len(entries) < auditLogVerifyPageSize {
+			return verification, nil
+		}
+	}
+}
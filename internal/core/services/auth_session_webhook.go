@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/http"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/pubsub"
+)
+
+type authSessionWebhook struct {
+	conn *http.Client
+	url  string
+}
+
+// NewAuthSessionWebhook returns a service that posts the completed authentication sessions to the given url
+func NewAuthSessionWebhook(conn *http.Client, url string) ports.AuthSessionWebhookService {
+	return &authSessionWebhook{
+		conn: conn,
+		url:  url,
+	}
+}
+
+func (w *authSessionWebhook) SendAuthSessionWebhook(ctx context.Context, payload pubsub.Message) error {
+	var sEvent event.AuthSession
+	if err := sEvent.Unmarshal(payload); err != nil {
+		return errors.New("sendAuthSessionWebhook unexpected data type")
+	}
+
+	body, err := json.Marshal(sEvent)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.conn.Post(ctx, w.url, body); err != nil {
+		log.Error(ctx, "sendAuthSessionWebhook: posting authentication session", "err", err, "sessionID", sEvent.SessionID)
+		return err
+	}
+
+	log.Info(ctx, "sendAuthSessionWebhook: authentication session sent", "sessionID", sEvent.SessionID, "status", sEvent.Status)
+	return nil
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
+	httpPkg "github.com/polygonid/sh-id-platform/internal/http"
+)
+
+func TestAuthSessionWebhook_SendAuthSessionWebhook(t *testing.T) {
+	ctx := context.Background()
+	var received event.AuthSession
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &received))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	ev := &event.AuthSession{
+		SessionID:     "8edd8112-c415-11ed-b036-debe37e1cbd6",
+		IssuerID:      "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		Status:        string(domain.AuthSessionStatusFailed),
+		FailureReason: common.ToPointer("proof is not valid"),
+	}
+	payload, err := ev.Marshal()
+	require.NoError(t, err)
+
+	webhook := NewAuthSessionWebhook(httpPkg.NewClient(*srv.Client()), srv.URL)
+	require.NoError(t, webhook.SendAuthSessionWebhook(ctx, payload))
+	assert.Equal(t, *ev, received)
+
+	t.Run("should return an error if the webhook fails", func(t *testing.T) {
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer failing.Close()
+
+		webhook := NewAuthSessionWebhook(httpPkg.NewClient(*failing.Client()), failing.URL)
+		assert.Error(t, webhook.SendAuthSessionWebhook(ctx, payload))
+	})
+
+	t.Run("should return an error with an unexpected payload", func(t *testing.T) {
+		assert.Error(t, webhook.SendAuthSessionWebhook(ctx, []byte("not json")))
+	})
+}
@@ -27,6 +27,8 @@ import (
 	"github.com/lestrrat-go/jwx/v3/jwa"
 	"github.com/lestrrat-go/jwx/v3/jwk"
 	"github.com/segmentio/asm/base64"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/config"
@@ -37,11 +39,13 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/jsonschema"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/metrics"
 	"github.com/polygonid/sh-id-platform/internal/pubsub"
 	"github.com/polygonid/sh-id-platform/internal/qrlink"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
 	schemaPkg "github.com/polygonid/sh-id-platform/internal/schema"
+	"github.com/polygonid/sh-id-platform/internal/tracing"
 	"github.com/polygonid/sh-id-platform/internal/urn"
 	"github.com/polygonid/sh-id-platform/internal/utils"
 )
@@ -83,10 +87,11 @@ type claim struct {
 	ipfsClient               *shell.Shell
 	revocationStatusResolver *revocationstatus.Resolver
 	mediatypeManager         ports.MediaTypeManager
+	tenantService            ports.TenantService
 }
 
-// NewClaim creates a new claim service
-func NewClaim(repo ports.ClaimRepository, idenSrv ports.IdentityService, qrService ports.QrStoreService, mtService ports.MtService, identityStateRepository ports.IdentityStateRepository, ld loader.DocumentLoader, storage *db.Storage, host string, ps pubsub.Publisher, ipfsGatewayURL string, revocationStatusResolver *revocationstatus.Resolver, mediatypeManager ports.MediaTypeManager, cfg config.UniversalLinks) ports.ClaimService {
+// NewClaim creates a new claim service. The credentials quota of the tenants is not checked if tenantService is nil.
+func NewClaim(repo ports.ClaimRepository, idenSrv ports.IdentityService, qrService ports.QrStoreService, mtService ports.MtService, identityStateRepository ports.IdentityStateRepository, ld loader.DocumentLoader, storage *db.Storage, host string, ps pubsub.Publisher, ipfsGatewayURL string, revocationStatusResolver *revocationstatus.Resolver, mediatypeManager ports.MediaTypeManager, cfg config.UniversalLinks, tenantService ports.TenantService) ports.ClaimService {
 	s := &claim{
 		host:                     host,
 		icRepo:                   repo,
@@ -100,6 +105,7 @@ func NewClaim(repo ports.ClaimRepository, idenSrv ports.IdentityService, qrServi
 		revocationStatusResolver: revocationStatusResolver,
 		mediatypeManager:         mediatypeManager,
 		cfg:                      cfg,
+		tenantService:            tenantService,
 	}
 	if ipfsGatewayURL != "" {
 		s.ipfsClient = shell.NewShell(ipfsGatewayURL)
@@ -116,10 +122,26 @@ func (c *claim) Save(ctx context.Context, req *ports.CreateClaimRequest) (*domai
 	if err != nil {
 		return nil, err
 	}
-	claim.ID, err = c.icRepo.Save(ctx, c.storage.Pgx, claim)
+	err = c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if c.tenantService != nil {
+			if err := c.tenantService.LockCredentialQuota(ctx, tx, *req.DID); err != nil {
+				return err
+			}
+		}
+		claim.ID, err = c.icRepo.Save(ctx, tx, claim)
+		return err
+	})
 	if err != nil {
+		if errors.Is(err, ErrTenantQuotaExceeded) {
+			log.Warn(ctx, "credential not created", "err", err, "did", req.DID)
+		}
 		return nil, err
 	}
+	metrics.CredentialIssued(claim.SchemaType)
+	created := &event.CredentialCreated{CredentialID: claim.ID.String(), IssuerID: req.DID.String(), SchemaType: claim.SchemaType}
+	if err := c.publisher.Publish(ctx, event.CredentialCreatedEvent, created); err != nil {
+		log.Error(ctx, "publish CredentialCreatedEvent", "err", err.Error(), "credential", claim.ID.String())
+	}
 	if req.SignatureProof {
 		err = c.publisher.Publish(ctx, event.CreateCredentialEvent, &event.CreateCredential{CredentialIDs: []string{claim.ID.String()}, IssuerID: req.DID.String()})
 		if err != nil {
@@ -136,14 +158,23 @@ func (c *claim) GetRevoked(ctx context.Context, currentState string) ([]*domain.
 }
 
 // CreateCredential - Create a new Credential, but this method doesn't save it in the repository.
-func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequest) (*domain.Claim, error) {
+func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequest) (_ *domain.Claim, err error) {
+	ctx, span := tracing.Start(ctx, "ClaimService.CreateCredential", trace.WithAttributes(attribute.String("identity", req.DID.String()), attribute.String("schema", req.Type)))
+	defer func() { tracing.End(span, err) }()
+
 	if err := c.guardCreateClaimRequest(req); err != nil {
 		log.Error(ctx, "create claim request validation", "req", req, "err", err)
 		return nil, err
 	}
 
+	if c.tenantService != nil {
+		if err := c.tenantService.CheckCredentialQuota(ctx, *req.DID); err != nil {
+			log.Warn(ctx, "credential not created", "err", err, "did", req.DID)
+			return nil, err
+		}
+	}
+
 	var nonce uint64
-	var err error
 	if req.RevNonce != nil {
 		nonce = *req.RevNonce
 	} else {
@@ -331,10 +362,26 @@ func encryptCredential(_ context.Context, req *ports.CreateClaimRequest, vc veri
 	return b64.RawStdEncoding.EncodeToString(ciphertext), nil
 }
 
-func (c *claim) Revoke(ctx context.Context, id w3c.DID, nonce uint64, description string) error {
+func (c *claim) Revoke(ctx context.Context, id w3c.DID, nonce uint64, description string) (err error) {
+	ctx, span := tracing.Start(ctx, "ClaimService.Revoke", trace.WithAttributes(attribute.String("identity", id.String())))
+	defer func() { tracing.End(span, err) }()
+
 	return c.revoke(ctx, &id, nonce, description, c.storage.Pgx)
 }
 
+// SendCredentialOffer publishes a CreateCredentialEvent so the notification service sends an offer
+// of the given credentials to the holder. All the credentials must belong to the same holder.
+func (c *claim) SendCredentialOffer(ctx context.Context, identifier w3c.DID, credentialIDs []uuid.UUID) error {
+	if len(credentialIDs) == 0 {
+		return nil
+	}
+	ids := make([]string, len(credentialIDs))
+	for i := range credentialIDs {
+		ids[i] = credentialIDs[i].String()
+	}
+	return c.publisher.Publish(ctx, event.CreateCredentialEvent, &event.CreateCredential{CredentialIDs: ids, IssuerID: identifier.String()})
+}
+
 func (c *claim) RevokeAllFromConnection(ctx context.Context, connID uuid.UUID, issuerID w3c.DID) error {
 	credentials, err := c.icRepo.GetNonRevokedByConnectionAndIssuerID(ctx, c.storage.Pgx, connID, issuerID)
 	if err != nil {
@@ -390,7 +437,10 @@ func (c *claim) Delete(ctx context.Context, issuerDID *w3c.DID, id uuid.UUID) er
 	return nil
 }
 
-func (c *claim) GetByID(ctx context.Context, issID *w3c.DID, id uuid.UUID) (*domain.Claim, error) {
+func (c *claim) GetByID(ctx context.Context, issID *w3c.DID, id uuid.UUID) (_ *domain.Claim, err error) {
+	ctx, span := tracing.Start(ctx, "ClaimService.GetByID", trace.WithAttributes(attribute.String("identity", issID.String())))
+	defer func() { tracing.End(span, err) }()
+
 	claim, err := c.icRepo.GetByIdAndIssuer(ctx, c.storage.Pgx, issID, id)
 	if err != nil {
 		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
@@ -461,7 +511,10 @@ func (c *claim) GetCredentialQrCode(ctx context.Context, issID *w3c.DID, id uuid
 	}, nil
 }
 
-func (c *claim) Agent(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType) (*iden3comm.BasicMessage, error) {
+func (c *claim) Agent(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType) (_ *iden3comm.BasicMessage, err error) {
+	ctx, span := tracing.Start(ctx, "ClaimService.Agent")
+	defer func() { tracing.End(span, err) }()
+
 	if req.UserDID == nil {
 		return nil, fmt.Errorf("'from' field cannot be empty")
 	}
@@ -519,7 +572,10 @@ func (c *claim) GetFirstNonRevokedAuthClaim(ctx context.Context, did *w3c.DID) (
 	return authClaims[0], nil
 }
 
-func (c *claim) GetAll(ctx context.Context, did w3c.DID, filter *ports.ClaimsFilter) ([]*domain.Claim, uint, error) {
+func (c *claim) GetAll(ctx context.Context, did w3c.DID, filter *ports.ClaimsFilter) (_ []*domain.Claim, _ uint, err error) {
+	ctx, span := tracing.Start(ctx, "ClaimService.GetAll", trace.WithAttributes(attribute.String("identity", did.String())))
+	defer func() { tracing.End(span, err) }()
+
 	claims, total, err := c.icRepo.GetAllByIssuerID(ctx, c.storage.Pgx, did, filter)
 	if err != nil {
 		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
@@ -593,7 +649,10 @@ func (c *claim) GetAuthClaimForPublishing(ctx context.Context, did *w3c.DID, sta
 }
 
 // UpdateClaimsMTPAndState update identity status and claim MTP
-func (c *claim) UpdateClaimsMTPAndState(ctx context.Context, currentState *domain.IdentityState) error {
+func (c *claim) UpdateClaimsMTPAndState(ctx context.Context, currentState *domain.IdentityState) (err error) {
+	ctx, span := tracing.Start(ctx, "ClaimService.UpdateClaimsMTPAndState")
+	defer func() { tracing.End(span, err) }()
+
 	did, err := w3c.ParseDID(currentState.Identifier)
 	if err != nil {
 		return err
@@ -779,6 +838,15 @@ func (c *claim) revoke(ctx context.Context, did *w3c.DID, nonce uint64, descript
 		return err
 	}
 
+	ids := make([]string, len(claims))
+	for i := range claims {
+		ids[i] = claims[i].ID.String()
+		metrics.CredentialRevoked(claims[i].SchemaType)
+	}
+	if err := c.publisher.Publish(ctx, event.CredentialRevokedEvent, &event.CredentialRevoked{CredentialIDs: ids, IssuerID: did.String(), Nonce: nonce}); err != nil {
+		log.Error(ctx, "publish CredentialRevokedEvent", "err", err.Error(), "nonce", nonce)
+	}
+
 	return nil
 }
 
@@ -870,6 +938,11 @@ func (c *claim) getAgentCredential(ctx context.Context, basicMessage *ports.Agen
 		return nil, err
 	}
 
+	fetched := &event.CredentialFetched{CredentialID: claim.ID.String(), IssuerID: basicMessage.IssuerDID.String(), UserID: basicMessage.UserDID.String()}
+	if err := c.publisher.Publish(ctx, event.CredentialFetchedEvent, fetched); err != nil {
+		log.Error(ctx, "publish CredentialFetchedEvent", "err", err.Error(), "credential", claim.ID.String())
+	}
+
 	var body []byte
 	messageType := protocol.CredentialIssuanceResponseMessageType
 	if claim.HasEncryptedData() {
@@ -0,0 +1,335 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/google/uuid"
+	auth "github.com/iden3/go-iden3-auth/v2"
+	"github.com/iden3/go-iden3-auth/v2/pubsignals"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/qrlink"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const connectionMergeReason = "connection merge"
+
+var (
+	// ErrConnectionMergeDoesNotExist connection merge does not exist
+	ErrConnectionMergeDoesNotExist = errors.New("connection merge does not exist")
+	// ErrInvalidConnectionMerge the connections can not be merged
+	ErrInvalidConnectionMerge = errors.New("invalid connection merge")
+	// ErrConnectionMergeAlreadyProcessed the connection merge request has already been answered
+	ErrConnectionMergeAlreadyProcessed = errors.New("connection merge already processed")
+	// ErrConnectionMergeVerificationFailed the holder answer could not be verified
+	ErrConnectionMergeVerificationFailed = errors.New("connection merge verification failed")
+	// ErrConnectionMergeAlreadyExists the identity has already been merged into a connection
+	ErrConnectionMergeAlreadyExists = errors.New("the identity has already been merged into a connection")
+)
+
+type connectionMerge struct {
+	connRepo     ports.ConnectionRepository
+	mergeRepo    ports.ConnectionMergeRepository
+	claimsRepo   ports.ClaimRepository
+	claimService ports.ClaimService
+	qrService    ports.QrStoreService
+	verifier     *auth.Verifier
+	storage      *db.Storage
+}
+
+// NewConnectionMerge returns a new connection merge service
+func NewConnectionMerge(connRepo ports.ConnectionRepository, mergeRepo ports.ConnectionMergeRepository, claimsRepo ports.ClaimRepository, claimService ports.ClaimService, qrService ports.QrStoreService, verifier *auth.Verifier, storage *db.Storage) ports.ConnectionMergeService {
+	return &connectionMerge{
+		connRepo:     connRepo,
+		mergeRepo:    mergeRepo,
+		claimsRepo:   claimsRepo,
+		claimService: claimService,
+		qrService:    qrService,
+		verifier:     verifier,
+		storage:      storage,
+	}
+}
+
+// Merge merges a duplicated connection into the given one as an issuer decision. If the request has no connection
+// to merge, a QR code is created so the holder can prove control of the DID to merge.
+func (c *connectionMerge) Merge(ctx context.Context, issuerDID w3c.DID, connectionID uuid.UUID, req *ports.MergeConnectionsRequest, serverURL string) (*domain.ConnectionMerge, error) {
+	target, err := c.getConnection(ctx, connectionID, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ConnectionID == nil {
+		return c.createMergeRequest(ctx, target, req, serverURL)
+	}
+
+	if *req.ConnectionID == target.ID {
+		return nil, fmt.Errorf("%w: a connection can not be merged into itself", ErrInvalidConnectionMerge)
+	}
+
+	source, err := c.getConnection(ctx, *req.ConnectionID, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+
+	merge := domain.NewConnectionMerge(issuerDID, target.ID, domain.ConnectionMergeMethodAdmin, req.Reason, req.ReissueCredentials)
+	if err := c.merge(ctx, merge, &source.ID, source.UserDID, nil); err != nil {
+		if !errors.Is(err, ErrConnectionMergeAlreadyExists) {
+			log.Error(ctx, "merging connections", "err", err, "connection", target.ID, "merged", source.ID)
+		}
+		return nil, err
+	}
+
+	c.reissueCredentials(ctx, merge, source.UserDID, target.UserDID)
+
+	return merge, nil
+}
+
+// Verify verifies the holder answer to a connection merge request. The DID that answered proved control of it, so it
+// becomes the connection DID and the previous one is kept as merged. An answer that can not be verified is rejected
+// and the request stays pending.
+func (c *connectionMerge) Verify(ctx context.Context, id uuid.UUID, token string) (*domain.ConnectionMerge, error) {
+	merge, err := c.mergeRepo.GetByID(ctx, c.storage.Pgx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrConnectionMergeDoesNotExist) {
+			return nil, ErrConnectionMergeDoesNotExist
+		}
+		return nil, err
+	}
+
+	if merge.Status != domain.ConnectionMergeStatusPending || merge.Request == nil {
+		return nil, ErrConnectionMergeAlreadyProcessed
+	}
+
+	if c.verifier == nil {
+		return nil, errors.New("verifier not configured")
+	}
+
+	arm, err := c.verifier.FullVerify(ctx, token, *merge.Request, pubsignals.WithAcceptedStateTransitionDelay(transitionDelay))
+	if err != nil {
+		log.Warn(ctx, "connection merge verification failed", "err", err, "id", id)
+		return nil, fmt.Errorf("%w: %s", ErrConnectionMergeVerificationFailed, err)
+	}
+
+	userDID, err := w3c.ParseDID(arm.From)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrConnectionMergeVerificationFailed, err)
+	}
+
+	target, err := c.getConnection(ctx, merge.ConnectionID, merge.IssuerDID)
+	if err != nil {
+		if errors.Is(err, ErrConnectionDoesNotExist) {
+			return nil, fmt.Errorf("%w: the connection does not exist anymore", ErrConnectionMergeDoesNotExist)
+		}
+		return nil, err
+	}
+
+	if target.UserDID.String() == userDID.String() {
+		return nil, fmt.Errorf("%w: the request was answered by the connection identity instead of the new one", ErrConnectionMergeVerificationFailed)
+	}
+
+	// the new DID may be connected already, that connection is merged too
+	var sourceID *uuid.UUID
+	source, err := c.connRepo.GetByUserID(ctx, c.storage.Pgx, merge.IssuerDID, *userDID)
+	switch {
+	case err == nil:
+		sourceID = &source.ID
+	case !errors.Is(err, repositories.ErrConnectionDoesNotExist):
+		return nil, err
+	}
+
+	previousUserDID := target.UserDID
+	target.UserDID = *userDID
+	target.UserDoc = arm.Body.DIDDoc
+	if len(target.UserDoc) == 0 && source != nil {
+		target.UserDoc = source.UserDoc
+	}
+	target.ModifiedAt = time.Now()
+
+	if err := c.merge(ctx, merge, sourceID, previousUserDID, target); err != nil {
+		if !errors.Is(err, ErrConnectionMergeAlreadyExists) {
+			log.Error(ctx, "merging connections", "err", err, "connection", target.ID, "merged", previousUserDID)
+		}
+		return nil, err
+	}
+
+	c.reissueCredentials(ctx, merge, previousUserDID, *userDID)
+
+	return merge, nil
+}
+
+// GetByConnectionID returns the merges of the given connection
+func (c *connectionMerge) GetByConnectionID(ctx context.Context, issuerDID w3c.DID, connectionID uuid.UUID) ([]domain.ConnectionMerge, error) {
+	if _, err := c.getConnection(ctx, connectionID, issuerDID); err != nil {
+		return nil, err
+	}
+	return c.mergeRepo.GetByConnectionID(ctx, c.storage.Pgx, issuerDID, connectionID)
+}
+
+// GetMergedUserDIDs returns the DIDs merged into the given connection
+func (c *connectionMerge) GetMergedUserDIDs(ctx context.Context, issuerDID w3c.DID, connectionID uuid.UUID) ([]w3c.DID, error) {
+	merges, err := c.mergeRepo.GetByConnectionID(ctx, c.storage.Pgx, issuerDID, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	dids := make([]w3c.DID, 0, len(merges))
+	for _, merge := range merges {
+		if merge.Status != domain.ConnectionMergeStatusCompleted || merge.MergedUserDID == nil {
+			continue
+		}
+		did, err := w3c.ParseDID(*merge.MergedUserDID)
+		if err != nil {
+			return nil, err
+		}
+		dids = append(dids, *did)
+	}
+
+	return dids, nil
+}
+
+func (c *connectionMerge) createMergeRequest(ctx context.Context, target *domain.Connection, req *ports.MergeConnectionsRequest, serverURL string) (*domain.ConnectionMerge, error) {
+	merge := domain.NewConnectionMerge(target.IssuerDID, target.ID, domain.ConnectionMergeMethodProof, req.Reason, req.ReissueCredentials)
+
+	authReq := auth.CreateAuthorizationRequest(connectionMergeReason, target.IssuerDID.String(), fmt.Sprintf(ports.ConnectionMergeCallbackURL, serverURL, merge.ID))
+	authReq.Body.Scope = make([]protocol.ZeroKnowledgeProofRequest, 0)
+	merge.Request = &authReq
+
+	raw, err := json.Marshal(authReq)
+	if err != nil {
+		return nil, err
+	}
+	linkID, err := c.qrService.Store(ctx, raw, DefaultQRBodyTTL)
+	if err != nil {
+		return nil, err
+	}
+	merge.QRCodeURL = common.ToPointer(qrlink.NewDeepLink(serverURL, linkID, nil))
+
+	if err := c.mergeRepo.Save(ctx, c.storage.Pgx, merge); err != nil {
+		log.Error(ctx, "saving connection merge", "err", err)
+		return nil, err
+	}
+
+	return merge, nil
+}
+
+// merge moves the source connection, if any, into the merge connection, replaces the connection identity when
+// updated is given and stores the merge of mergedUserDID as completed
+func (c *connectionMerge) merge(ctx context.Context, merge *domain.ConnectionMerge, sourceID *uuid.UUID, mergedUserDID w3c.DID, updated *domain.Connection) error {
+	err := c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if sourceID != nil {
+			if err := c.connRepo.Merge(ctx, tx, merge.IssuerDID, *sourceID, merge.ConnectionID); err != nil {
+				return err
+			}
+		}
+		if updated != nil {
+			if err := c.connRepo.UpdateUserDID(ctx, tx, updated); err != nil {
+				return err
+			}
+		}
+		merge.Completed(sourceID, mergedUserDID.String())
+		return c.mergeRepo.Save(ctx, tx, merge)
+	})
+	if errors.Is(err, repositories.ErrConnectionMergeAlreadyExists) {
+		return ErrConnectionMergeAlreadyExists
+	}
+	return err
+}
+
+// reissueCredentials issues again the non revoked credentials of the merged DID to the connection DID and offers them
+// to the holder. The merge is already done so errors are only logged and the credentials that could not be issued are skipped.
+func (c *connectionMerge) reissueCredentials(ctx context.Context, merge *domain.ConnectionMerge, from w3c.DID, to w3c.DID) {
+	if !merge.ReissueCredentials {
+		return
+	}
+
+	claims, err := c.claimsRepo.GetClaimsOfAConnection(ctx, c.storage.Pgx, merge.IssuerDID, from)
+	if err != nil {
+		log.Error(ctx, "getting credentials to reissue", "err", err, "merge", merge.ID)
+		return
+	}
+
+	ids := make([]uuid.UUID, 0, len(claims))
+	for _, claim := range claims {
+		if claim.Revoked {
+			continue
+		}
+		req, err := reissueClaimRequest(merge.IssuerDID, claim, to)
+		if err != nil {
+			log.Error(ctx, "building credential to reissue", "err", err, "credential", claim.ID)
+			continue
+		}
+		newClaim, err := c.claimService.Save(ctx, req)
+		if err != nil {
+			log.Error(ctx, "reissuing credential", "err", err, "credential", claim.ID)
+			continue
+		}
+		ids = append(ids, newClaim.ID)
+		merge.ReissuedCredentials = append(merge.ReissuedCredentials, newClaim.ID.String())
+	}
+
+	if err := c.mergeRepo.Save(ctx, c.storage.Pgx, merge); err != nil {
+		log.Error(ctx, "saving reissued credentials", "err", err, "merge", merge.ID)
+	}
+
+	if err := c.claimService.SendCredentialOffer(ctx, merge.IssuerDID, ids); err != nil {
+		log.Error(ctx, "sending reissued credentials offer", "err", err, "merge", merge.ID)
+	}
+}
+
+func (c *connectionMerge) getConnection(ctx context.Context, id uuid.UUID, issuerDID w3c.DID) (*domain.Connection, error) {
+	conn, err := c.connRepo.GetByIDAndIssuerID(ctx, c.storage.Pgx, id, issuerDID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+			return nil, ErrConnectionDoesNotExist
+		}
+		return nil, err
+	}
+	return conn, nil
+}
+
+// reissueClaimRequest builds the request to issue the given credential to another subject
+func reissueClaimRequest(issuerDID w3c.DID, claim *domain.Claim, subject w3c.DID) (*ports.CreateClaimRequest, error) {
+	vc, err := claim.GetVerifiableCredential()
+	if err != nil {
+		return nil, err
+	}
+
+	credentialSubject := maps.Clone(vc.CredentialSubject)
+	if credentialSubject == nil {
+		credentialSubject = make(map[string]any)
+	}
+	credentialSubject["id"] = subject.String()
+	delete(credentialSubject, "type")
+
+	var expiration *time.Time
+	if claim.Expiration > 0 {
+		expiration = common.ToPointer(time.Unix(claim.Expiration, 0))
+	}
+
+	var credentialStatusType verifiable.CredentialStatusType
+	if status, err := claim.GetCredentialStatus(); err == nil {
+		credentialStatusType = status.Type
+	}
+
+	proofs := ports.ClaimRequestProofs{
+		BJJSignatureProof2021:      claim.SignatureProof.Status == pgtype.Present,
+		Iden3SparseMerkleTreeProof: claim.MtProof || claim.MTPProof.Status == pgtype.Present,
+	}
+
+	return ports.NewCreateClaimRequest(&issuerDID, nil, claim.SchemaURL, credentialSubject, expiration, claim.SchemaType, nil, nil, nil,
+		proofs, nil, false, credentialStatusType, vc.RefreshService, nil, vc.DisplayMethod, nil), nil
+}
@@ -2,34 +2,55 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
 	"github.com/jackc/pgx/v4"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/notifications"
+	"github.com/polygonid/sh-id-platform/internal/pubsub"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 )
 
-// ErrConnectionDoesNotExist connection does not exist
-var ErrConnectionDoesNotExist = errors.New("connection does not exist")
+var (
+	// ErrConnectionDoesNotExist connection does not exist
+	ErrConnectionDoesNotExist = errors.New("connection does not exist")
+	// ErrConnectionMessageDoesNotExist connection message does not exist
+	ErrConnectionMessageDoesNotExist = errors.New("connection message does not exist")
+	// ErrConnectionWithoutPushService the connection user did document has no push service to deliver messages
+	ErrConnectionWithoutPushService = errors.New("connection user did document has no push service")
+	// ErrInvalidConnectionMessage the message to send is not valid
+	ErrInvalidConnectionMessage = errors.New("invalid connection message")
+)
 
 type connection struct {
-	connRepo   ports.ConnectionRepository
-	claimsRepo ports.ClaimRepository
-	storage    *db.Storage
+	connRepo    ports.ConnectionRepository
+	claimsRepo  ports.ClaimRepository
+	messageRepo ports.ConnectionMessageRepository
+	storage     *db.Storage
+	publisher   pubsub.Publisher
 }
 
 // NewConnection returns a new connection service
-func NewConnection(connRepo ports.ConnectionRepository, claimsRepo ports.ClaimRepository, storage *db.Storage) ports.ConnectionService {
+func NewConnection(connRepo ports.ConnectionRepository, claimsRepo ports.ClaimRepository, messageRepo ports.ConnectionMessageRepository, storage *db.Storage, publisher pubsub.Publisher) ports.ConnectionService {
 	return &connection{
-		connRepo:   connRepo,
-		claimsRepo: claimsRepo,
-		storage:    storage,
+		connRepo:    connRepo,
+		claimsRepo:  claimsRepo,
+		messageRepo: messageRepo,
+		storage:     storage,
+		publisher:   publisher,
 	}
 }
 
@@ -109,6 +130,102 @@ func (c *connection) GetAllByIssuerID(ctx context.Context, issuerDID w3c.DID, fi
 	return conns, count, err
 }
 
+func (c *connection) Update(ctx context.Context, id uuid.UUID, issuerDID w3c.DID, request *ports.UpdateConnectionRequest) error {
+	return c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		conn, err := c.connRepo.GetByIDAndIssuerID(ctx, tx, id, issuerDID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+				return ErrConnectionDoesNotExist
+			}
+			return err
+		}
+
+		if request.Tags != nil {
+			conn.Tags = normalizeTags(*request.Tags)
+		}
+		if request.Notes != nil {
+			conn.Notes = request.Notes
+			if *request.Notes == "" {
+				conn.Notes = nil
+			}
+		}
+		conn.ModifiedAt = time.Now()
+
+		return c.connRepo.UpdateTagsAndNotes(ctx, tx, conn)
+	})
+}
+
+// SendMessage stores a pending iden3comm message for the connection and publishes an event so it is pushed to the user devices.
+func (c *connection) SendMessage(ctx context.Context, id uuid.UUID, issuerDID w3c.DID, request *ports.SendConnectionMessageRequest) (*domain.ConnectionMessage, error) {
+	if strings.TrimSpace(request.Type) == "" {
+		return nil, fmt.Errorf("%w: type is required", ErrInvalidConnectionMessage)
+	}
+
+	body := request.Body
+	if len(body) == 0 {
+		body = json.RawMessage(`{}`)
+	}
+	if !json.Valid(body) {
+		return nil, fmt.Errorf("%w: body is not a valid json", ErrInvalidConnectionMessage)
+	}
+
+	conn, err := c.GetByIDAndIssuerID(ctx, id, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+
+	var userDIDDoc verifiable.DIDDocument
+	if err := json.Unmarshal(conn.UserDoc, &userDIDDoc); err != nil {
+		return nil, ErrConnectionWithoutPushService
+	}
+	if _, err := notifications.FindNotificationService(userDIDDoc); err != nil {
+		return nil, ErrConnectionWithoutPushService
+	}
+
+	message := domain.NewConnectionMessage(issuerDID, conn.ID, request.Type, request.ThreadID, body)
+	if err := c.messageRepo.Save(ctx, c.storage.Pgx, message); err != nil {
+		log.Error(ctx, "saving connection message", "err", err, "connectionID", id)
+		return nil, err
+	}
+
+	err = c.publisher.Publish(ctx, event.ConnectionMessageEvent, &event.ConnectionMessage{MessageID: message.ID.String(), IssuerID: issuerDID.String()})
+	if err != nil {
+		log.Error(ctx, "publishing ConnectionMessageEvent", "err", err, "messageID", message.ID)
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func (c *connection) GetMessages(ctx context.Context, id uuid.UUID, issuerDID w3c.DID) ([]domain.ConnectionMessage, error) {
+	if _, err := c.GetByIDAndIssuerID(ctx, id, issuerDID); err != nil {
+		return nil, err
+	}
+
+	return c.messageRepo.GetByConnectionID(ctx, c.storage.Pgx, issuerDID, id)
+}
+
+func (c *connection) GetMessage(ctx context.Context, messageID uuid.UUID, issuerDID w3c.DID) (*domain.ConnectionMessage, error) {
+	message, err := c.messageRepo.GetByID(ctx, c.storage.Pgx, issuerDID, messageID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrConnectionMessageDoesNotExist) {
+			return nil, ErrConnectionMessageDoesNotExist
+		}
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func (c *connection) UpdateMessageStatus(ctx context.Context, message *domain.ConnectionMessage) error {
+	err := c.messageRepo.UpdateStatus(ctx, c.storage.Pgx, message)
+	if errors.Is(err, repositories.ErrConnectionMessageDoesNotExist) {
+		return ErrConnectionMessageDoesNotExist
+	}
+
+	return err
+}
+
 func (c *connection) delete(ctx context.Context, id uuid.UUID, issuerDID w3c.DID, pgx db.Querier) error {
 	err := c.connRepo.Delete(ctx, pgx, id, issuerDID)
 	if err != nil {
@@ -124,3 +241,21 @@ func (c *connection) delete(ctx context.Context, id uuid.UUID, issuerDID w3c.DID
 func (c *connection) deleteCredentials(ctx context.Context, id uuid.UUID, issuerID w3c.DID, pgx db.Querier) error {
 	return c.connRepo.DeleteCredentials(ctx, pgx, id, issuerID)
 }
+
+// normalizeTags trims the given tags and removes the empty and duplicated ones keeping the original order
+func normalizeTags(tags []string) []string {
+	resp := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		resp = append(resp, tag)
+	}
+	return resp
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrIdempotencyKeyReused is returned when a key is sent with a request different from the one it was first used with
+	ErrIdempotencyKeyReused = errors.New("the idempotency key was used with a different request")
+	// ErrIdempotencyKeyInProgress is returned when the request of the key is still being processed
+	ErrIdempotencyKeyInProgress = errors.New("a request with the idempotency key is in progress")
+)
+
+type idempotencyKey struct {
+	repo    ports.IdempotencyKeyRepository
+	storage *db.Storage
+	cfg     config.Idempotency
+}
+
+// NewIdempotencyKey returns the service that stores the idempotency keys and the responses of their requests
+func NewIdempotencyKey(repo ports.IdempotencyKeyRepository, storage *db.Storage, cfg config.Idempotency) ports.IdempotencyKeyService {
+	return &idempotencyKey{
+		repo:    repo,
+		storage: storage,
+		cfg:     cfg,
+	}
+}
+
+// Begin locks the key for the request, or returns the completed key to replay its response
+func (ik *idempotencyKey) Begin(ctx context.Context, identifier, operation, key string, request []byte) (*domain.IdempotencyKey, error) {
+	newKey := domain.NewIdempotencyKey(identifier, operation, key, request, ik.cfg.LockTimeout)
+	created, err := ik.repo.Create(ctx, ik.storage.Pgx, newKey)
+	if err != nil {
+		log.Error(ctx, "creating idempotency key", "err", err)
+		return nil, err
+	}
+	if created {
+		return newKey, nil
+	}
+
+	existing, err := ik.repo.Get(ctx, ik.storage.Pgx, identifier, operation, key)
+	if err != nil {
+		if errors.Is(err, repositories.ErrIdempotencyKeyNotFound) {
+			// it expired and was removed in between, the client can retry
+			return nil, ErrIdempotencyKeyInProgress
+		}
+		log.Error(ctx, "getting idempotency key", "err", err)
+		return nil, err
+	}
+	if existing.RequestHash != newKey.RequestHash {
+		return nil, ErrIdempotencyKeyReused
+	}
+	if !existing.Completed() {
+		return nil, ErrIdempotencyKeyInProgress
+	}
+	return existing, nil
+}
+
+// Complete stores the response of the request of the key
+func (ik *idempotencyKey) Complete(ctx context.Context, key *domain.IdempotencyKey, statusCode int, response []byte) error {
+	key.Complete(statusCode, response, ik.cfg.TTL)
+	if err := ik.repo.Complete(ctx, ik.storage.Pgx, key); err != nil {
+		log.Error(ctx, "completing idempotency key", "err", err, "key", key.Key)
+		return err
+	}
+	return nil
+}
+
+// Release removes the key of a request that failed
+func (ik *idempotencyKey) Release(ctx context.Context, key *domain.IdempotencyKey) error {
+	if err := ik.repo.Delete(ctx, ik.storage.Pgx, key); err != nil {
+		log.Error(ctx, "releasing idempotency key", "err", err, "key", key.Key)
+		return err
+	}
+	return nil
+}
+
+// DeleteExpired removes the expired keys
+func (ik *idempotencyKey) DeleteExpired(ctx context.Context) (int64, error) {
+	return ik.repo.DeleteExpired(ctx, ik.storage.Pgx, time.Now())
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"crypto/ecdsa"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -25,6 +26,8 @@ import (
 	"github.com/iden3/iden3comm/v2/protocol"
 	mtproof "github.com/iden3/merkletree-proof"
 	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
@@ -40,6 +43,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/internal/reversehash"
 	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
+	"github.com/polygonid/sh-id-platform/internal/tracing"
 	"github.com/polygonid/sh-id-platform/internal/urn"
 	"github.com/polygonid/sh-id-platform/internal/utils"
 	"github.com/polygonid/sh-id-platform/pkg/credentials/signature/circuit/signer"
@@ -74,6 +78,15 @@ var (
 
 	// ErrKeyNotFound - represents an error when the key is not found
 	ErrKeyNotFound = errors.New("key not found")
+
+	// ErrAuthSessionNotFound - represents an error when the authentication session does not exist or it has been removed
+	ErrAuthSessionNotFound = errors.New("authentication session not found")
+
+	// ErrAuthSessionExpired - represents an error when the authentication session is answered after its expiration
+	ErrAuthSessionExpired = errors.New("authentication session expired")
+
+	// ErrAuthSessionAlreadyAuthenticated - represents an error when the authentication session has already been answered
+	ErrAuthSessionAlreadyAuthenticated = errors.New("authentication session already authenticated")
 )
 
 type identity struct {
@@ -96,11 +109,12 @@ type identity struct {
 	networkResolver          network.Resolver
 	rhsFactory               reversehash.Factory
 	keyRepository            ports.KeyRepository
+	tenantService            ports.TenantService
 }
 
-// NewIdentity creates a new identity
+// NewIdentity creates a new identity. The identities quota of the tenants is not checked if tenantService is nil.
 // nolint
-func NewIdentity(kms kms.KMSType, identityRepository ports.IdentityRepository, imtRepository ports.IdentityMerkleTreeRepository, identityStateRepository ports.IdentityStateRepository, mtservice ports.MtService, qrService ports.QrStoreService, claimsRepository ports.ClaimRepository, revocationRepository ports.RevocationRepository, connectionsRepository ports.ConnectionRepository, storage *db.Storage, verifier *auth.Verifier, sessionRepository ports.SessionRepository, ps pubsub.Client, networkResolver network.Resolver, rhsFactory reversehash.Factory, revocationStatusResolver *revocationstatus.Resolver, keyRepository ports.KeyRepository) ports.IdentityService {
+func NewIdentity(kms kms.KMSType, identityRepository ports.IdentityRepository, imtRepository ports.IdentityMerkleTreeRepository, identityStateRepository ports.IdentityStateRepository, mtservice ports.MtService, qrService ports.QrStoreService, claimsRepository ports.ClaimRepository, revocationRepository ports.RevocationRepository, connectionsRepository ports.ConnectionRepository, storage *db.Storage, verifier *auth.Verifier, sessionRepository ports.SessionRepository, ps pubsub.Client, networkResolver network.Resolver, rhsFactory reversehash.Factory, revocationStatusResolver *revocationstatus.Resolver, keyRepository ports.KeyRepository, tenantService ports.TenantService) ports.IdentityService {
 	return &identity{
 		identityRepository:       identityRepository,
 		imtRepository:            imtRepository,
@@ -120,6 +134,7 @@ func NewIdentity(kms kms.KMSType, identityRepository ports.IdentityRepository, i
 		rhsFactory:               rhsFactory,
 		revocationStatusResolver: revocationStatusResolver,
 		keyRepository:            keyRepository,
+		tenantService:            tenantService,
 	}
 }
 
@@ -145,11 +160,19 @@ func (i *identity) GetByDID(ctx context.Context, identifier w3c.DID) (*domain.Id
 	return identity, nil
 }
 
-func (i *identity) Create(ctx context.Context, hostURL string, didOptions *ports.DIDCreationOptions) (*domain.Identity, error) {
+func (i *identity) Create(ctx context.Context, hostURL string, didOptions *ports.DIDCreationOptions) (_ *domain.Identity, err error) {
+	ctx, span := tracing.Start(ctx, "IdentityService.Create")
+	defer func() { tracing.End(span, err) }()
+
 	var identifier *w3c.DID
-	var err error
 	err = i.storage.Pgx.BeginFunc(ctx,
 		func(tx pgx.Tx) error {
+			if didOptions != nil && didOptions.TenantID != nil && i.tenantService != nil {
+				if err := i.tenantService.LockIdentityQuota(ctx, tx, *didOptions.TenantID); err != nil {
+					return err
+				}
+			}
+
 			var keyType kms.KeyType
 			if didOptions == nil || didOptions.KeyType == "" {
 				keyType = kms.KeyTypeBabyJubJub
@@ -180,13 +203,16 @@ func (i *identity) Create(ctx context.Context, hostURL string, didOptions *ports
 	return identityDB, nil
 }
 
-func (i *identity) SignClaimEntry(ctx context.Context, authClaim *domain.Claim, claimEntry *core.Claim) (*verifiable.BJJSignatureProof2021, error) {
+func (i *identity) SignClaimEntry(ctx context.Context, authClaim *domain.Claim, claimEntry *core.Claim) (_ *verifiable.BJJSignatureProof2021, err error) {
+	ctx, span := tracing.Start(ctx, "IdentityService.SignClaimEntry")
+	defer func() { tracing.End(span, err) }()
+
 	keyID, err := i.GetKeyIDFromAuthClaim(ctx, authClaim)
 	if err != nil {
 		return nil, err
 	}
 
-	bjjSigner, err := primitive.NewBJJSigner(i.kms, keyID)
+	bjjSigner, err := primitive.NewBJJSigner(i.kms, keyID, kms.SignPurposeCredential)
 	if err != nil {
 		return nil, err
 	}
@@ -238,9 +264,9 @@ func (i *identity) Exists(ctx context.Context, identifier w3c.DID) (bool, error)
 	return identity != nil, nil
 }
 
-// Get - returns all the identities
-func (i *identity) Get(ctx context.Context) (identities []domain.IdentityDisplayName, err error) {
-	return i.identityRepository.Get(ctx, i.storage.Pgx)
+// Get - returns all the identities, or the identities of the tenant if tenantID is not nil
+func (i *identity) Get(ctx context.Context, tenantID *uuid.UUID) (identities []domain.IdentityDisplayName, err error) {
+	return i.identityRepository.Get(ctx, i.storage.Pgx, tenantID)
 }
 
 // GetLatestStateByID get latest identity state by identifier
@@ -298,7 +324,10 @@ func (i *identity) GetKeyIDFromAuthClaim(ctx context.Context, authClaim *domain.
 	return keyID, errors.New("keyID not found")
 }
 
-func (i *identity) UpdateState(ctx context.Context, did w3c.DID) (*domain.IdentityState, error) {
+func (i *identity) UpdateState(ctx context.Context, did w3c.DID) (_ *domain.IdentityState, err error) {
+	ctx, span := tracing.Start(ctx, "IdentityService.UpdateState", trace.WithAttributes(attribute.String("identity", did.String())))
+	defer func() { tracing.End(span, err) }()
+
 	newState := &domain.IdentityState{
 		Identifier: did.String(),
 		Status:     domain.StatusCreated,
@@ -458,31 +487,41 @@ func (i *identity) UpdateIdentityState(ctx context.Context, state *domain.Identi
 }
 
 func (i *identity) AuthenticateWithRequest(ctx context.Context, sessionID *uuid.UUID, authReq protocol.AuthorizationRequestMessage, message string, serverURL string) (*protocol.AuthorizationResponseMessage, error) {
+	arm, _, err := i.authenticate(ctx, sessionID, authReq, message, serverURL)
+	return arm, err
+}
+
+func (i *identity) authenticate(ctx context.Context, sessionID *uuid.UUID, authReq protocol.AuthorizationRequestMessage, message string, serverURL string) (*protocol.AuthorizationResponseMessage, uuid.UUID, error) {
 	arm, err := i.verifier.FullVerify(ctx, message, authReq, pubsignals.WithAcceptedStateTransitionDelay(transitionDelay))
 	if err != nil {
 		log.Error(ctx, "authentication failed", "err", err)
-		return nil, err
+		return nil, uuid.Nil, err
 	}
 
 	from := authReq.From
 	issuerDID, err := w3c.ParseDID(from)
 	if err != nil {
 		log.Error(ctx, "failed to parse issuerDID", "err", err)
-		return nil, err
+		return nil, uuid.Nil, err
 	}
 
-	issuerDoc := newDIDDocument(serverURL, *issuerDID)
+	issuerDoc, err := i.GetDIDDocument(ctx, *issuerDID, serverURL)
+	if err != nil {
+		log.Warn(ctx, "failed to get issuerDoc, using the document without keys", "err", err)
+		doc := newDIDDocument(serverURL, *issuerDID)
+		issuerDoc = &doc
+	}
 	bytesIssuerDoc, err := json.Marshal(issuerDoc)
 	if err != nil {
 		log.Error(ctx, "failed to marshal issuerDoc", "err", err)
-		return nil, err
+		return nil, uuid.Nil, err
 	}
 
 	bytesIssuerDoc = sanitizeIssuerDoc(bytesIssuerDoc)
 	userDID, err := w3c.ParseDID(arm.From)
 	if err != nil {
 		log.Error(ctx, "failed to parse userDID", "err", err)
-		return nil, err
+		return nil, uuid.Nil, err
 	}
 
 	conn := &domain.Connection{
@@ -506,7 +545,7 @@ func (i *identity) AuthenticateWithRequest(ctx context.Context, sessionID *uuid.
 		}
 		return i.connectionsRepository.SaveUserAuthentication(ctx, i.storage.Pgx, connID, *sessionID, conn.CreatedAt)
 	}); err != nil {
-		return nil, err
+		return nil, uuid.Nil, err
 	}
 
 	if connID == conn.ID { // a connection has been created so previously created credentials have to be sent
@@ -515,19 +554,90 @@ func (i *identity) AuthenticateWithRequest(ctx context.Context, sessionID *uuid.
 			log.Error(ctx, "sending connection notification", "err", err.Error(), "connection", connID)
 		}
 	}
-	return arm, nil
+	return arm, connID, nil
 }
 
-func (i *identity) Authenticate(ctx context.Context, message string, sessionID uuid.UUID, serverURL string) (*protocol.AuthorizationResponseMessage, error) {
-	authReq, err := i.sessionManager.Get(ctx, sessionID.String())
+func (i *identity) Authenticate(ctx context.Context, message string, sessionID uuid.UUID, serverURL string) (_ *protocol.AuthorizationResponseMessage, err error) {
+	ctx, span := tracing.Start(ctx, "IdentityService.Authenticate")
+	defer func() { tracing.End(span, err) }()
+
+	session, err := i.getAuthSession(ctx, sessionID)
 	if err != nil {
-		log.Warn(ctx, "authentication session not found")
+		log.Warn(ctx, "authentication session not found", "err", err, "sessionID", sessionID)
 		return nil, err
 	}
-	return i.AuthenticateWithRequest(ctx, &sessionID, authReq, message, serverURL)
+
+	if session.Status == domain.AuthSessionStatusAuthenticated {
+		return nil, ErrAuthSessionAlreadyAuthenticated
+	}
+
+	// a failed session can be answered again until it expires
+	if session.Status == domain.AuthSessionStatusExpired || time.Now().After(session.ExpiresAt) {
+		if session.Status == domain.AuthSessionStatusPending {
+			session.Expired()
+			i.completeAuthSession(ctx, session)
+		}
+		return nil, ErrAuthSessionExpired
+	}
+
+	arm, connID, err := i.authenticate(ctx, &sessionID, session.Request, message, serverURL)
+	if err != nil {
+		session.Failed(err.Error())
+	} else {
+		session.Authenticated(connID, arm.From)
+	}
+	i.completeAuthSession(ctx, session)
+
+	return arm, err
 }
 
-func (i *identity) CreateAuthenticationQRCode(ctx context.Context, serverURL string, issuerDID w3c.DID) (*ports.CreateAuthenticationQRCodeResponse, error) {
+// GetAuthSession returns the authentication session with the given id started by the given issuer
+func (i *identity) GetAuthSession(ctx context.Context, issuerDID w3c.DID, sessionID uuid.UUID) (*domain.AuthSession, error) {
+	session, err := i.getAuthSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.IssuerDID != issuerDID.String() {
+		return nil, ErrAuthSessionNotFound
+	}
+	return session, nil
+}
+
+func (i *identity) getAuthSession(ctx context.Context, sessionID uuid.UUID) (*domain.AuthSession, error) {
+	session, err := i.sessionManager.Get(ctx, sessionID.String())
+	if err != nil {
+		if errors.Is(err, repositories.ErrAuthSessionNotFound) {
+			return nil, ErrAuthSessionNotFound
+		}
+		return nil, err
+	}
+	return session, nil
+}
+
+// completeAuthSession stores the outcome of the session and publishes it. Errors are only logged because the holder
+// answer has already been processed.
+func (i *identity) completeAuthSession(ctx context.Context, session *domain.AuthSession) {
+	if err := i.sessionManager.Set(ctx, session.ID.String(), session); err != nil {
+		log.Error(ctx, "storing authentication session", "err", err, "sessionID", session.ID)
+	}
+
+	ev := &event.AuthSession{
+		SessionID:     session.ID.String(),
+		IssuerID:      session.IssuerDID,
+		Status:        string(session.Status),
+		UserID:        session.UserDID,
+		FailureReason: session.FailureReason,
+		CompletedAt:   session.CompletedAt,
+	}
+	if session.ConnectionID != nil {
+		ev.ConnectionID = common.ToPointer(session.ConnectionID.String())
+	}
+	if err := i.pubsub.Publish(ctx, event.AuthSessionEvent, ev); err != nil {
+		log.Error(ctx, "publishing authentication session event", "err", err, "sessionID", session.ID)
+	}
+}
+
+func (i *identity) CreateAuthenticationQRCode(ctx context.Context, serverURL string, issuerDID w3c.DID, ttl time.Duration) (*ports.CreateAuthenticationQRCodeResponse, error) {
 	sessionID := uuid.New()
 	reqID := uuid.New().String()
 
@@ -543,7 +653,8 @@ func (i *identity) CreateAuthenticationQRCode(ctx context.Context, serverURL str
 			Scope:       make([]protocol.ZeroKnowledgeProofRequest, 0),
 		},
 	}
-	if err := i.sessionManager.Set(ctx, sessionID.String(), *qrCode); err != nil {
+	session := domain.NewAuthSession(sessionID, *qrCode, ttl)
+	if err := i.sessionManager.Set(ctx, sessionID.String(), session); err != nil {
 		return nil, err
 	}
 
@@ -559,6 +670,7 @@ func (i *identity) CreateAuthenticationQRCode(ctx context.Context, serverURL str
 		QRCodeURL: qrlink.NewDeepLink(serverURL, linkID, nil),
 		SessionID: sessionID,
 		QrID:      linkID,
+		ExpiresAt: session.ExpiresAt,
 	}, nil
 }
 
@@ -664,6 +776,7 @@ func (i *identity) createEthIdentity(ctx context.Context, tx db.Querier, hostURL
 	}
 
 	identity.DisplayName = didOptions.DisplayName
+	identity.TenantID = didOptions.TenantID
 
 	if err = i.identityRepository.Save(ctx, tx, identity); err != nil {
 		if errors.Is(err, repositories.ErrDisplayNameDuplicated) {
@@ -772,6 +885,7 @@ func (i *identity) createIdentity(ctx context.Context, tx db.Querier, hostURL st
 		return nil, nil, fmt.Errorf("can't add genesis claims to tree: %w", err)
 	}
 	identity.DisplayName = didOptions.DisplayName
+	identity.TenantID = didOptions.TenantID
 
 	claimsTree, err := mts.ClaimsTree()
 	if err != nil {
@@ -855,8 +969,10 @@ func (i *identity) createIdentity(ctx context.Context, tx db.Querier, hostURL st
 }
 
 // CreateAuthCredential creates a new auth credential
-func (i *identity) CreateAuthCredential(ctx context.Context, did *w3c.DID, keyID string, revNonce *uint64, expiration *time.Time, version *uint32, credentialStatusType verifiable.CredentialStatusType) (uuid.UUID, error) {
-	var err error
+func (i *identity) CreateAuthCredential(ctx context.Context, did *w3c.DID, keyID string, revNonce *uint64, expiration *time.Time, version *uint32, credentialStatusType verifiable.CredentialStatusType) (_ uuid.UUID, err error) {
+	ctx, span := tracing.Start(ctx, "IdentityService.CreateAuthCredential", trace.WithAttributes(attribute.String("identity", did.String())))
+	defer func() { tracing.End(span, err) }()
+
 	if revNonce == nil {
 		generatedRevNonce, err := common.RandInt64()
 		if err != nil {
@@ -938,6 +1054,10 @@ func (i *identity) CreateAuthCredential(ctx context.Context, did *w3c.DID, keyID
 				}
 				return errors.Join(err, errors.New("can't save auth claim"))
 			}
+
+			if expiration != nil {
+				return i.setKeyExpiration(ctx, tx, *did, kmsKeyID, *expiration)
+			}
 			return nil
 		})
 	if err != nil {
@@ -947,6 +1067,30 @@ func (i *identity) CreateAuthCredential(ctx context.Context, did *w3c.DID, keyID
 	return newAuthCoreClaimID, nil
 }
 
+// setKeyExpiration makes the key expire with its auth credential, unless the key already expires before
+func (i *identity) setKeyExpiration(ctx context.Context, tx pgx.Tx, did w3c.DID, keyID kms.KeyID, expiration time.Time) error {
+	publicKey, err := i.kms.PublicKey(keyID)
+	if err != nil {
+		return err
+	}
+	publicKeyString := hexutil.Encode(publicKey)
+	key, err := i.keyRepository.GetByPublicKey(ctx, did, publicKeyString)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrKeyNotFound) {
+			return err
+		}
+		key = domain.NewKey(did, publicKeyString, publicKeyString)
+	}
+	if key.ExpiresAt != nil && key.ExpiresAt.Before(expiration) {
+		return nil
+	}
+	key.ExpiresAt = &expiration
+	key.ExpiryWarnedAt = nil
+	key.ExpiredAt = nil
+	_, err = i.keyRepository.Save(ctx, tx, key)
+	return err
+}
+
 func (i *identity) createEthIdentityFromKeyID(ctx context.Context, mts *domain.IdentityMerkleTrees, key *kms.KeyID, didOptions *ports.DIDCreationOptions, tx db.Querier) (*domain.Identity, *w3c.DID, error) {
 	pubKey, err := ethPubKey(ctx, i.kms, *key)
 	if err != nil {
@@ -1351,6 +1495,93 @@ func newDIDDocument(serverURL string, issuerDID w3c.DID) verifiable.DIDDocument
 	}
 }
 
+// GetDIDDocument returns the DID document of the issuer with the iden3comm service and a verification method
+// for every secp256k1 and p256 key that has been published. Keys that cannot be read are logged and left out, so
+// one broken key does not break the document.
+func (i *identity) GetDIDDocument(ctx context.Context, did w3c.DID, serverURL string) (*verifiable.DIDDocument, error) {
+	didDoc := newDIDDocument(serverURL, did)
+	keyIDs, err := i.kms.KeysByIdentity(ctx, did)
+	if err != nil {
+		log.Error(ctx, "failed to get keys", "err", err, "did", did)
+		return nil, err
+	}
+
+	for _, keyID := range keyIDs {
+		if keyID.Type != kms.KeyTypeEthereum && keyID.Type != kms.KeyTypeP256 {
+			continue
+		}
+		publicKey, err := i.kms.PublicKey(keyID)
+		if err != nil {
+			log.Warn(ctx, "skipping key in did document, failed to get public key", "err", err, "keyID", keyID.ID)
+			continue
+		}
+		keyInfo, err := i.keyRepository.GetByPublicKey(ctx, did, hexutil.Encode(publicKey))
+		if err != nil {
+			if errors.Is(err, repositories.ErrKeyNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		if !keyInfo.Published {
+			continue
+		}
+
+		verificationMethod, err := newVerificationMethod(ctx, i.kms, did, keyID, keyInfo.ID)
+		if err != nil {
+			log.Warn(ctx, "skipping key in did document, failed to build the verification method", "err", err, "keyID", keyID.ID)
+			continue
+		}
+		assertionMethod := verifiable.Authentication{}
+		if err := json.Unmarshal([]byte(fmt.Sprintf("%q", verificationMethod.ID)), &assertionMethod); err != nil {
+			log.Warn(ctx, "skipping key in did document, failed to build the assertion method", "err", err, "keyID", keyID.ID)
+			continue
+		}
+		didDoc.VerificationMethod = append(didDoc.VerificationMethod, *verificationMethod)
+		didDoc.AssertionMethod = append(didDoc.AssertionMethod, assertionMethod)
+	}
+
+	return &didDoc, nil
+}
+
+// newVerificationMethod builds a JWK verification method for a secp256k1 or p256 key
+func newVerificationMethod(ctx context.Context, keyMS kms.KMSType, did w3c.DID, keyID kms.KeyID, id uuid.UUID) (*verifiable.CommonVerificationMethod, error) {
+	var (
+		pubKey   *ecdsa.PublicKey
+		vmType   string
+		jwkCurve string
+		err      error
+	)
+	switch keyID.Type {
+	case kms.KeyTypeEthereum:
+		pubKey, err = ethPubKey(ctx, keyMS, keyID)
+		vmType, jwkCurve = "EcdsaSecp256k1VerificationKey2019", "secp256k1"
+	case kms.KeyTypeP256:
+		var keyBytes []byte
+		keyBytes, err = keyMS.PublicKey(keyID)
+		if err == nil {
+			pubKey, err = kms.DecodeP256PubKey(keyBytes)
+		}
+		vmType, jwkCurve = "JsonWebKey2020", "P-256"
+	default:
+		return nil, errors.New("unsupported key type for a verification method")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &verifiable.CommonVerificationMethod{
+		ID:         fmt.Sprintf("%s#%s", did.String(), id),
+		Type:       vmType,
+		Controller: did.String(),
+		PublicKeyJwk: map[string]interface{}{
+			"kty": "EC",
+			"crv": jwkCurve,
+			"x":   base64.RawURLEncoding.EncodeToString(pubKey.X.FillBytes(make([]byte, 32))),
+			"y":   base64.RawURLEncoding.EncodeToString(pubKey.Y.FillBytes(make([]byte, 32))),
+		},
+	}, nil
+}
+
 func sanitizeIssuerDoc(issDoc []byte) []byte {
 	str := strings.Replace(string(issDoc), "\\u0000", "", -1)
 	return []byte(str)
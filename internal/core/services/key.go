@@ -6,6 +6,7 @@ import (
 	"errors"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -13,11 +14,15 @@ import (
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/config"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/pubsub"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/utils"
 )
 
 var (
@@ -28,29 +33,47 @@ var (
 	ErrKeyAssociatedWithIdentity = errors.New("key is associated with an identity")
 	// ErrDuplicateKeyName is returned when the key name already exists
 	ErrDuplicateKeyName = errors.New("duplicate key name")
+	// ErrInvalidBackupShares is returned when the number of shares or the threshold of a key backup are not valid
+	ErrInvalidBackupShares = errors.New("invalid backup shares, the threshold has to be between 2 and the number of shares")
+	// ErrNoKeysToBackup is returned when the identity has no keys that can be backed up
+	ErrNoKeysToBackup = errors.New("the identity has no keys to backup")
+	// ErrKeyNotPublishable is returned when a key that is not a secp256k1 or p256 key is published in the DID document
+	ErrKeyNotPublishable = errors.New("only secp256k1 and p256 keys can be published in the DID document")
+	// ErrInvalidKeyExpiration is returned when the expiration of a key is not in the future
+	ErrInvalidKeyExpiration = errors.New("the expiration of the key must be in the future")
 )
 
+const keyExpiredRevocationReason = "key expired"
+
 // Key is the service that manages keys
 type Key struct {
 	kms           *kms.KMS
 	claimService  ports.ClaimService
 	keyRepository ports.KeyRepository
+	publisher     pubsub.Publisher
+	cfg           config.KeyExpiry
 }
 
 // NewKey creates a new Key
-func NewKey(kms *kms.KMS, claimService ports.ClaimService, keyRepository ports.KeyRepository) ports.KeyService {
+func NewKey(kms *kms.KMS, claimService ports.ClaimService, keyRepository ports.KeyRepository, ps pubsub.Publisher, cfg config.KeyExpiry) ports.KeyService {
 	return &Key{
 		kms:           kms,
 		claimService:  claimService,
 		keyRepository: keyRepository,
+		publisher:     ps,
+		cfg:           cfg,
 	}
 }
 
-// Create creates a new key for the given DID
-func (ks *Key) Create(ctx context.Context, did *w3c.DID, keyType kms.KeyType, name string) (kms.KeyID, error) {
+// Create creates a new key for the given DID. If expiresAt is not nil the key is valid until that time.
+func (ks *Key) Create(ctx context.Context, did *w3c.DID, keyType kms.KeyType, name string, expiresAt *time.Time) (kms.KeyID, error) {
 	var keyID kms.KeyID
 	var err error
 
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return kms.KeyID{}, ErrInvalidKeyExpiration
+	}
+
 	keyWithName, err := ks.keyRepository.GetByName(ctx, *did, name)
 	if keyWithName != nil {
 		return kms.KeyID{}, ErrDuplicateKeyName
@@ -69,7 +92,7 @@ func (ks *Key) Create(ctx context.Context, did *w3c.DID, keyType kms.KeyType, na
 		}
 	}
 
-	if keyType == kms.KeyTypeEthereum || keyType == kms.KeyTypeEd25519 {
+	if keyType == kms.KeyTypeEthereum || keyType == kms.KeyTypeEd25519 || keyType == kms.KeyTypeP256 {
 		keyID, err = ks.kms.CreateKey(keyType, nil)
 		if err != nil {
 			log.Error(ctx, "failed to create key", "err", err)
@@ -93,6 +116,7 @@ func (ks *Key) Create(ctx context.Context, did *w3c.DID, keyType kms.KeyType, na
 		publicKey = solana.PublicKey(publicKeyAsBytes).String()
 	}
 	keyToSave := domain.NewKey(*did, publicKey, name)
+	keyToSave.ExpiresAt = expiresAt
 	_, err = ks.keyRepository.Save(ctx, nil, keyToSave)
 	if err != nil {
 		log.Error(ctx, "failed to save key", "err", err)
@@ -105,14 +129,24 @@ func (ks *Key) Create(ctx context.Context, did *w3c.DID, keyType kms.KeyType, na
 	return keyID, nil
 }
 
-// Update updates the key with the given keyID
-func (ks *Key) Update(ctx context.Context, did *w3c.DID, keyID string, name string) error {
+// Update updates the name of the key with the given keyID and, if published is not nil, whether the key is
+// published as a verification method in the DID document of the identity. If expiresAt is not nil the validity
+// period of the key is extended or shortened to that time.
+func (ks *Key) Update(ctx context.Context, did *w3c.DID, keyID string, name string, published *bool, expiresAt *time.Time) error {
 	keyType, err := getKeyType(keyID)
 	if err != nil {
 		log.Error(ctx, "failed to get key type", "err", err)
 		return err
 	}
 
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return ErrInvalidKeyExpiration
+	}
+
+	if published != nil && *published && keyType != kms.KeyTypeEthereum && keyType != kms.KeyTypeP256 {
+		return ErrKeyNotPublishable
+	}
+
 	kmsKeyID := kms.KeyID{
 		ID:   keyID,
 		Type: keyType,
@@ -149,6 +183,14 @@ func (ks *Key) Update(ctx context.Context, did *w3c.DID, keyID string, name stri
 		keyInfo = domain.NewKey(*did, publicKeyString, name)
 	}
 	keyInfo.Name = name
+	if published != nil {
+		keyInfo.Published = *published
+	}
+	if expiresAt != nil {
+		keyInfo.ExpiresAt = expiresAt
+		keyInfo.ExpiryWarnedAt = nil
+		keyInfo.ExpiredAt = nil
+	}
 	_, err = ks.keyRepository.Save(ctx, nil, keyInfo)
 	return err
 }
@@ -196,7 +238,7 @@ func (ks *Key) Get(ctx context.Context, did *w3c.DID, keyID string) (*ports.KMSK
 			log.Error(ctx, "failed to check if key has associated auth credential", "err", err)
 			return nil, err
 		}
-	case kms.KeyTypeEd25519:
+	case kms.KeyTypeEd25519, kms.KeyTypeP256:
 	default:
 		return nil, ErrInvalidKeyType
 	}
@@ -222,6 +264,9 @@ func (ks *Key) Get(ctx context.Context, did *w3c.DID, keyID string) (*ports.KMSK
 		PublicKey:                   pubKeyString,
 		HasAssociatedAuthCredential: hasAssociatedAuthCredential,
 		Name:                        keyInfo.Name,
+		Published:                   keyInfo.Published,
+		ExpiresAt:                   keyInfo.ExpiresAt,
+		Expired:                     keyInfo.ExpiredAt != nil,
 	}, nil
 }
 
@@ -335,7 +380,7 @@ func (ks *Key) Delete(ctx context.Context, did *w3c.DID, keyID string) error {
 			log.Info(ctx, "can not be deleted because it is associated with the identity")
 			return ErrKeyAssociatedWithIdentity
 		}
-	case kms.KeyTypeEd25519:
+	case kms.KeyTypeEd25519, kms.KeyTypeP256:
 	default:
 		return ErrInvalidKeyType
 	}
@@ -376,6 +421,8 @@ func getKeyType(keyID string) (kms.KeyType, error) {
 		keyType = kms.KeyTypeEthereum
 	} else if strings.Contains(keyID, "Ed25519") {
 		keyType = kms.KeyTypeEd25519
+	} else if strings.Contains(keyID, "P256") {
+		keyType = kms.KeyTypeP256
 	} else {
 		return keyType, ErrInvalidKeyType
 	}
@@ -418,3 +465,126 @@ func (ks *Key) isAssociatedWithIdentity(ctx context.Context, did *w3c.DID, publi
 
 	return hasAssociatedAuthCredential, nil
 }
+
+// ProcessExpirations publishes a keyExpiringEvent for the keys that expire within the warning period. The keys that
+// have expired are removed from the DID document, their auth credential is revoked and a keyExpiredEvent is published.
+// The revocation is included in the next state transition of the identity. The auth credential of an expired key
+// is not revoked while it is the last non revoked one of the identity.
+func (ks *Key) ProcessExpirations(ctx context.Context) error {
+	now := time.Now()
+	keys, err := ks.keyRepository.GetExpiring(ctx, now.Add(ks.cfg.WarningPeriod))
+	if err != nil {
+		log.Error(ctx, "failed to get expiring keys", "err", err)
+		return err
+	}
+
+	for _, key := range keys {
+		if key.IsExpired(now) {
+			err = ks.expire(ctx, key, now)
+		} else if key.ExpiryWarnedAt == nil {
+			err = ks.warnExpiry(ctx, key, now)
+		}
+		if err != nil {
+			log.Error(ctx, "failed to process key expiration", "err", err, "issuer", key.IssuerCoreDID().String(), "publicKey", key.PublicKey)
+		}
+	}
+	return nil
+}
+
+func (ks *Key) warnExpiry(ctx context.Context, key *domain.Key, now time.Time) error {
+	if err := ks.publisher.Publish(ctx, event.KeyExpiringEvent, newKeyExpiryEvent(key)); err != nil {
+		return err
+	}
+	key.ExpiryWarnedAt = &now
+	_, err := ks.keyRepository.Save(ctx, nil, key)
+	return err
+}
+
+func (ks *Key) expire(ctx context.Context, key *domain.Key, now time.Time) error {
+	did := key.IssuerCoreDID()
+	expiryEvent := newKeyExpiryEvent(key)
+
+	// ed25519 public keys are base58 encoded and can't have an auth credential
+	if publicKey, err := hexutil.Decode(key.PublicKey); err == nil {
+		authCredential, err := ks.claimService.GetAuthCredentialByPublicKey(ctx, did, publicKey)
+		if err != nil {
+			return err
+		}
+		if authCredential != nil && !authCredential.Revoked {
+			remains, err := ks.hasOtherAuthCredential(ctx, did, authCredential, now)
+			if err != nil {
+				return err
+			}
+			if !remains {
+				log.Warn(ctx, "expired key is the last non revoked auth credential of the identity. Add another auth credential to revoke it", "issuer", did.String(), "publicKey", key.PublicKey)
+				return nil
+			}
+			if err := ks.claimService.Revoke(ctx, *did, uint64(authCredential.RevNonce), keyExpiredRevocationReason); err != nil {
+				return err
+			}
+			expiryEvent.AuthCredentialID = common.ToPointer(authCredential.ID.String())
+		}
+	}
+
+	key.Published = false
+	key.ExpiredAt = &now
+	if _, err := ks.keyRepository.Save(ctx, nil, key); err != nil {
+		return err
+	}
+	log.Info(ctx, "key expired", "issuer", did.String(), "publicKey", key.PublicKey, "authCredentialID", expiryEvent.AuthCredentialID)
+	return ks.publisher.Publish(ctx, event.KeyExpiredEvent, expiryEvent)
+}
+
+// hasOtherAuthCredential checks if the identity has a non revoked auth credential other than the given one whose
+// key is still valid. The auth credentials of the keys that expire at now, so they are expired in the same run, or
+// that were already expired although their auth credential could not be revoked don't count.
+func (ks *Key) hasOtherAuthCredential(ctx context.Context, did *w3c.DID, authCredential *domain.Claim, now time.Time) (bool, error) {
+	authCredentials, err := ks.claimService.GetAuthCredentials(ctx, did)
+	if err != nil {
+		return false, err
+	}
+	for _, other := range authCredentials {
+		if other.ID == authCredential.ID || other.Revoked {
+			continue
+		}
+		key, err := ks.keyRepository.GetByPublicKey(ctx, *did, utils.GetPublicKeyFromClaim(other).String())
+		if err != nil {
+			// keys created without expiration may have no record
+			if errors.Is(err, repositories.ErrKeyNotFound) {
+				return true, nil
+			}
+			return false, err
+		}
+		if key.ExpiredAt == nil && !key.IsExpired(now) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func newKeyExpiryEvent(key *domain.Key) *event.KeyExpiry {
+	return &event.KeyExpiry{
+		IssuerID:  key.IssuerCoreDID().String(),
+		PublicKey: key.PublicKey,
+		Name:      key.Name,
+		ExpiresAt: *key.ExpiresAt,
+	}
+}
+
+// Backup returns the encrypted backup of the keys of the identity, whose encryption key is split in shares.
+// Any threshold of the shares can restore the backup with the kms_backup tool.
+func (ks *Key) Backup(ctx context.Context, did *w3c.DID, shares, threshold int) (*kms.KeyBackup, [][]byte, error) {
+	backup, keyShares, err := ks.kms.BackupIdentityKeys(ctx, *did, shares, threshold)
+	if err != nil {
+		switch {
+		case errors.Is(err, kms.ErrInvalidShares):
+			return nil, nil, ErrInvalidBackupShares
+		case errors.Is(err, kms.ErrNoKeysToBackup):
+			return nil, nil, ErrNoKeysToBackup
+		}
+		log.Error(ctx, "failed to backup keys", "err", err, "did", did.String())
+		return nil, nil, err
+	}
+	log.Info(ctx, "keys backup created", "did", did.String(), "keys", backup.Keys, "shares", shares, "threshold", threshold)
+	return backup, keyShares, nil
+}
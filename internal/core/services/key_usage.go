@@ -0,0 +1,226 @@
+package services
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrKeyPolicyNotFound is returned when the key has no policy
+	ErrKeyPolicyNotFound = errors.New("key policy not found")
+	// ErrInvalidKeyPolicy is returned when the policy purposes or rate limit are not valid
+	ErrInvalidKeyPolicy = errors.New("invalid key policy")
+)
+
+type keyUsage struct {
+	kms         *kms.KMS
+	usageRepo   ports.KeyUsageRepository
+	policyRepo  ports.KeyPolicyRepository
+	paymentRepo ports.PaymentRepository
+	globalKeys  []kms.KeyID
+	storage     *db.Storage
+}
+
+// NewKeyUsage returns the service that records the sign requests of the kms keys and enforces their policies.
+// It has to be set as the sign auditor of the kms. globalKeys are the keys shared by all the identities, like the
+// publishing key, that can have a policy although they are not bound to an identity.
+func NewKeyUsage(keyMS *kms.KMS, usageRepo ports.KeyUsageRepository, policyRepo ports.KeyPolicyRepository, paymentRepo ports.PaymentRepository, globalKeys []kms.KeyID, storage *db.Storage) ports.KeyUsageService {
+	return &keyUsage{
+		kms:         keyMS,
+		usageRepo:   usageRepo,
+		policyRepo:  policyRepo,
+		paymentRepo: paymentRepo,
+		globalKeys:  globalKeys,
+		storage:     storage,
+	}
+}
+
+// Allow checks the policy of the key. Keys without policy can sign for any purpose.
+func (k *keyUsage) Allow(ctx context.Context, keyID kms.KeyID, purpose kms.SignPurpose) error {
+	policy, err := k.policyRepo.GetByKeyID(ctx, k.storage.Pgx, keyID.ID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrKeyPolicyNotFound) {
+			return nil
+		}
+		log.Error(ctx, "getting key policy", "err", err, "keyID", keyID.ID)
+		return err
+	}
+
+	if !policy.Allows(purpose) {
+		log.Warn(ctx, "sign purpose not allowed for key", "keyID", keyID.ID, "purpose", purpose)
+		return fmt.Errorf("%w: %s", kms.ErrSignPurposeNotAllowed, purpose)
+	}
+
+	if policy.IsRateLimited() {
+		count, err := k.usageRepo.CountSigned(ctx, k.storage.Pgx, keyID.ID, time.Now().Add(-policy.RateLimitWindow))
+		if err != nil {
+			log.Error(ctx, "counting key signatures", "err", err, "keyID", keyID.ID)
+			return err
+		}
+		if count >= policy.RateLimit {
+			log.Warn(ctx, "sign rate limit exceeded for key", "keyID", keyID.ID, "count", count, "limit", policy.RateLimit)
+			return fmt.Errorf("%w: %d signatures in %s", kms.ErrSignRateLimitExceeded, count, policy.RateLimitWindow)
+		}
+	}
+	return nil
+}
+
+// Record appends the sign request to the key usages
+func (k *keyUsage) Record(ctx context.Context, record kms.SignRecord) error {
+	return k.usageRepo.Save(ctx, k.storage.Pgx, domain.NewKeyUsage(record))
+}
+
+// GetUsages returns the recorded sign requests of the key, newest first
+func (k *keyUsage) GetUsages(ctx context.Context, issuerDID w3c.DID, keyID string, filter ports.KeyUsageFilter) ([]domain.KeyUsage, uint, error) {
+	return k.usageRepo.GetAll(ctx, k.storage.Pgx, issuerDID, keyID, filter)
+}
+
+// GetPolicy returns the policy of the key
+func (k *keyUsage) GetPolicy(ctx context.Context, issuerDID w3c.DID, keyID string) (*domain.KeyPolicy, error) {
+	policy, err := k.policyRepo.GetByKeyID(ctx, k.storage.Pgx, keyID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrKeyPolicyNotFound) {
+			return nil, ErrKeyPolicyNotFound
+		}
+		return nil, err
+	}
+	if policy.IssuerDID.String() != issuerDID.String() {
+		return nil, ErrKeyPolicyNotFound
+	}
+	return policy, nil
+}
+
+// SetPolicy sets the policy of a key of the identity, replacing the previous one. The keys of the identity are the
+// ones bound to it, the global keys and the signing keys of its payment options. The policy of a shared key applies
+// to all the identities that use it, and only the identity that set it can change it.
+func (k *keyUsage) SetPolicy(ctx context.Context, issuerDID w3c.DID, keyID string, req ports.KeyPolicyRequest) (*domain.KeyPolicy, error) {
+	for _, purpose := range req.AllowedPurposes {
+		if !slices.Contains(kms.SignPurposes(), purpose) {
+			return nil, fmt.Errorf("%w: unknown purpose %s", ErrInvalidKeyPolicy, purpose)
+		}
+	}
+	if req.RateLimit < 0 || (req.RateLimit > 0 && req.RateLimitWindow <= 0) {
+		return nil, fmt.Errorf("%w: the rate limit needs a positive window", ErrInvalidKeyPolicy)
+	}
+
+	if err := k.checkKey(ctx, issuerDID, keyID); err != nil {
+		return nil, err
+	}
+
+	policy := domain.NewKeyPolicy(issuerDID, keyID, req.AllowedPurposes, req.RateLimit, req.RateLimitWindow)
+	current, err := k.policyRepo.GetByKeyID(ctx, k.storage.Pgx, keyID)
+	switch {
+	case err == nil && current.IssuerDID.String() != issuerDID.String():
+		log.Warn(ctx, "key policy set by another identity", "keyID", keyID, "owner", current.IssuerDID.String())
+		return nil, ErrKeyNotFound
+	case err == nil:
+		policy.CreatedAt = current.CreatedAt
+	case !errors.Is(err, repositories.ErrKeyPolicyNotFound):
+		log.Error(ctx, "getting key policy", "err", err, "keyID", keyID)
+		return nil, err
+	}
+	if err := k.policyRepo.Save(ctx, k.storage.Pgx, policy); err != nil {
+		log.Error(ctx, "saving key policy", "err", err, "keyID", keyID)
+		return nil, err
+	}
+	return policy, nil
+}
+
+// DeletePolicy removes the policy of the key, so it can sign for any purpose again
+func (k *keyUsage) DeletePolicy(ctx context.Context, issuerDID w3c.DID, keyID string) error {
+	if err := k.policyRepo.Delete(ctx, k.storage.Pgx, issuerDID, keyID); err != nil {
+		if errors.Is(err, repositories.ErrKeyPolicyNotFound) {
+			return ErrKeyPolicyNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// checkKey returns ErrKeyNotFound if the key does not exist or it is not one of the keys of the identity
+func (k *keyUsage) checkKey(ctx context.Context, issuerDID w3c.DID, keyID string) error {
+	key, err := k.identityKey(ctx, issuerDID, keyID)
+	if err != nil {
+		return err
+	}
+	exists, err := k.kms.Exists(ctx, key)
+	if err != nil {
+		log.Error(ctx, "checking if key exists", "err", err, "keyID", keyID)
+		return err
+	}
+	if !exists {
+		return ErrKeyNotFound
+	}
+	return nil
+}
+
+// identityKey returns the kms key of the identity with the given id. The keys bound to the identity have their type
+// in the id, the global keys and the payment keys, that are not bound to an identity, are resolved explicitly.
+func (k *keyUsage) identityKey(ctx context.Context, issuerDID w3c.DID, keyID string) (kms.KeyID, error) {
+	for _, key := range k.globalKeys {
+		if key.ID == keyID {
+			return key, nil
+		}
+	}
+
+	keyType, typeErr := getKeyType(keyID)
+	if typeErr == nil && strings.Contains(keyID, issuerDID.String()) {
+		return kms.KeyID{ID: keyID, Type: keyType}, nil
+	}
+
+	isPaymentKey, err := k.isPaymentKey(ctx, issuerDID, keyID)
+	if err != nil {
+		return kms.KeyID{}, err
+	}
+	if !isPaymentKey {
+		if typeErr != nil {
+			return kms.KeyID{}, typeErr
+		}
+		return kms.KeyID{}, ErrKeyNotFound
+	}
+	if typeErr == nil {
+		return kms.KeyID{ID: keyID, Type: keyType}, nil
+	}
+	// payment keys created out of the node have no type in their id, they are ethereum or solana (ed25519) keys
+	for _, keyType := range []kms.KeyType{kms.KeyTypeEthereum, kms.KeyTypeEd25519} {
+		key := kms.KeyID{ID: keyID, Type: keyType}
+		if exists, err := k.kms.Exists(ctx, key); err == nil && exists {
+			return key, nil
+		}
+	}
+	return kms.KeyID{}, ErrKeyNotFound
+}
+
+// isPaymentKey returns true if the key signs the payment requests of one of the payment options of the identity
+func (k *keyUsage) isPaymentKey(ctx context.Context, issuerDID w3c.DID, keyID string) (bool, error) {
+	if k.paymentRepo == nil {
+		return false, nil
+	}
+	options, err := k.paymentRepo.GetAllPaymentOptions(ctx, issuerDID)
+	if err != nil {
+		log.Error(ctx, "getting payment options", "err", err, "issuerDID", issuerDID.String())
+		return false, err
+	}
+	for _, option := range options {
+		for _, item := range option.Config.PaymentOptions {
+			if decoded, err := b64.StdEncoding.DecodeString(item.SigningKeyID); err == nil && string(decoded) == keyID {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -18,11 +18,13 @@ import (
 
 	"github.com/polygonid/sh-id-platform/internal/config"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/jsonschema"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/metrics"
 	"github.com/polygonid/sh-id-platform/internal/network"
 	"github.com/polygonid/sh-id-platform/internal/notifications"
 	"github.com/polygonid/sh-id-platform/internal/pubsub"
@@ -57,10 +59,11 @@ type Link struct {
 	publisher        pubsub.Publisher
 	identityService  ports.IdentityService
 	networkResolver  network.Resolver
+	tenantService    ports.TenantService
 }
 
-// NewLinkService - constructor
-func NewLinkService(storage *db.Storage, claimsService ports.ClaimService, qrService ports.QrStoreService, claimRepository ports.ClaimRepository, linkRepository ports.LinkRepository, schemaRepository ports.SchemaRepository, ld loader.DocumentLoader, sessionManager ports.SessionRepository, publisher pubsub.Publisher, identityService ports.IdentityService, networkResolver network.Resolver, cfg config.UniversalLinks) ports.LinkService {
+// NewLinkService - constructor. The credentials quota of the tenants is not checked if tenantService is nil.
+func NewLinkService(storage *db.Storage, claimsService ports.ClaimService, qrService ports.QrStoreService, claimRepository ports.ClaimRepository, linkRepository ports.LinkRepository, schemaRepository ports.SchemaRepository, ld loader.DocumentLoader, sessionManager ports.SessionRepository, publisher pubsub.Publisher, identityService ports.IdentityService, networkResolver network.Resolver, cfg config.UniversalLinks, tenantService ports.TenantService) ports.LinkService {
 	return &Link{
 		storage:          storage,
 		claimsService:    claimsService,
@@ -74,6 +77,7 @@ func NewLinkService(storage *db.Storage, claimsService ports.ClaimService, qrSer
 		identityService:  identityService,
 		networkResolver:  networkResolver,
 		cfg:              cfg,
+		tenantService:    tenantService,
 	}
 }
 
@@ -300,13 +304,18 @@ func (ls *Link) IssueOrFetchClaim(ctx context.Context, issuerDID w3c.DID, userDI
 
 		err = ls.storage.Pgx.BeginFunc(ctx,
 			func(tx pgx.Tx) error {
+				if ls.tenantService != nil {
+					if err := ls.tenantService.LockCredentialQuota(ctx, tx, issuerDID); err != nil {
+						return err
+					}
+				}
 				link.IssuedClaims += 1
-				_, err := ls.linkRepository.Save(ctx, ls.storage.Pgx, link)
+				_, err := ls.linkRepository.Save(ctx, tx, link)
 				if err != nil {
 					return err
 				}
 
-				credentialIssuedID, err = ls.claimRepository.Save(ctx, ls.storage.Pgx, credentialIssued)
+				credentialIssuedID, err = ls.claimRepository.Save(ctx, tx, credentialIssued)
 				if err != nil {
 					return err
 				}
@@ -316,6 +325,17 @@ func (ls *Link) IssueOrFetchClaim(ctx context.Context, issuerDID w3c.DID, userDI
 		if err != nil {
 			return nil, err
 		}
+		metrics.CredentialIssued(credentialIssued.SchemaType)
+
+		created := &event.CredentialCreated{CredentialID: credentialIssuedID.String(), IssuerID: issuerDID.String(), SchemaType: credentialIssued.SchemaType}
+		if err := ls.publisher.Publish(ctx, event.CredentialCreatedEvent, created); err != nil {
+			log.Error(ctx, "publish CredentialCreatedEvent", "err", err.Error(), "credential", credentialIssuedID.String())
+		}
+
+		redeemed := &event.LinkRedeemed{LinkID: linkID.String(), IssuerID: issuerDID.String(), UserID: userDID.String(), CredentialID: credentialIssuedID.String()}
+		if err := ls.publisher.Publish(ctx, event.LinkRedeemedEvent, redeemed); err != nil {
+			log.Error(ctx, "publish LinkRedeemedEvent", "err", err.Error(), "link", linkID.String())
+		}
 	} else {
 		credentialIssuedID = issuedByUser[0].ID
 		credentialIssued = issuedByUser[0]
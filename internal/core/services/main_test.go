@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/vault/api"
 	"github.com/iden3/iden3comm/v2"
@@ -126,7 +127,7 @@ func TestMain(m *testing.M) {
 	identityRepository := repositories.NewIdentity()
 	idenMerkleTreeRepository := repositories.NewIdentityMerkleTreeRepository()
 	identityStateRepository := repositories.NewIdentityState()
-	sessionsRepository := repositories.NewSessionCached(cachex)
+	sessionsRepository := repositories.NewSessionCached(cachex, time.Hour)
 	revocationRepository := repositories.NewRevocation()
 	keyRepository := repositories.NewKey(*storage)
 
@@ -147,8 +148,8 @@ func TestMain(m *testing.M) {
 		true,
 	)
 	schemaLoader := loader.NewDocumentLoader(ipfsGatewayURL, false)
-	identityService = NewIdentity(keyStore, identityRepository, idenMerkleTreeRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, connectionRepository, s, nil, sessionsRepository, pubSub, *networkResolver, rhsFactory, revocationStatusResolver, keyRepository)
-	claimsService = NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, pubSub, ipfsGatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	identityService = NewIdentity(keyStore, identityRepository, idenMerkleTreeRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, connectionRepository, s, nil, sessionsRepository, pubSub, *networkResolver, rhsFactory, revocationStatusResolver, keyRepository, nil)
+	claimsService = NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, pubSub, ipfsGatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks, nil)
 
 	m.Run()
 }
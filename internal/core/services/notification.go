@@ -60,6 +60,15 @@ func (n *notification) SendCreateConnectionNotification(ctx context.Context, e p
 	return n.sendCreateConnectionNotification(ctx, cEvent.IssuerID, cEvent.ConnectionID)
 }
 
+func (n *notification) SendConnectionMessageNotification(ctx context.Context, e pubsub.Message) error {
+	var mEvent event.ConnectionMessage
+	if err := mEvent.Unmarshal(e); err != nil {
+		return errors.New("sendConnectionMessageNotification unexpected data type")
+	}
+
+	return n.sendConnectionMessageNotification(ctx, mEvent.IssuerID, mEvent.MessageID)
+}
+
 func (n *notification) sendRevokeCredentialNotification(ctx context.Context, state string) error {
 	rCreds, err := n.credService.GetRevoked(ctx, state)
 	if err != nil {
@@ -196,6 +205,60 @@ func (n *notification) sendCreateConnectionNotification(ctx context.Context, iss
 	return n.send(ctx, credOfferBytes, subjectDIDDoc)
 }
 
+func (n *notification) sendConnectionMessageNotification(ctx context.Context, issuerID string, messageID string) error {
+	issuerDID, err := w3c.ParseDID(issuerID)
+	if err != nil {
+		log.Error(ctx, "sendConnectionMessageNotification: failed to parse issuerID", "err", err.Error(), "issuerID", issuerID, "messageID", messageID)
+		return err
+	}
+
+	msgUUID, err := uuid.Parse(messageID)
+	if err != nil {
+		log.Error(ctx, "sendConnectionMessageNotification: failed to parse messageID", "err", err.Error(), "issuerID", issuerID, "messageID", messageID)
+		return err
+	}
+
+	message, err := n.connService.GetMessage(ctx, msgUUID, *issuerDID)
+	if err != nil {
+		log.Error(ctx, "sendConnectionMessageNotification: failed to retrieve the message", "err", err.Error(), "issuerID", issuerID, "messageID", messageID)
+		return err
+	}
+
+	sendErr := n.sendConnectionMessage(ctx, message)
+	if sendErr != nil {
+		log.Error(ctx, "sendConnectionMessageNotification: send notification", "err", sendErr.Error(), "issuerID", issuerID, "messageID", messageID)
+		message.Failed(sendErr.Error())
+	} else {
+		message.Delivered()
+	}
+
+	if err := n.connService.UpdateMessageStatus(ctx, message); err != nil {
+		log.Error(ctx, "sendConnectionMessageNotification: failed to update the message status", "err", err.Error(), "issuerID", issuerID, "messageID", messageID)
+		return err
+	}
+
+	return sendErr
+}
+
+func (n *notification) sendConnectionMessage(ctx context.Context, message *domain.ConnectionMessage) error {
+	conn, err := n.connService.GetByIDAndIssuerID(ctx, message.ConnectionID, message.IssuerDID)
+	if err != nil {
+		return fmt.Errorf("retrieving the connection, err: %v", err.Error())
+	}
+
+	msgBytes, err := notifications2.NewBasicMsg(conn, message)
+	if err != nil {
+		return fmt.Errorf("newBasicMsg, err: %v", err.Error())
+	}
+
+	var subjectDIDDoc verifiable.DIDDocument
+	if err := json.Unmarshal(conn.UserDoc, &subjectDIDDoc); err != nil {
+		return fmt.Errorf("unmarshal subjectDIDDoc, err: %v", err.Error())
+	}
+
+	return n.send(ctx, msgBytes, subjectDIDDoc)
+}
+
 func (n *notification) send(ctx context.Context, credOfferBytes []byte, subjectDIDDoc verifiable.DIDDocument) error {
 	res, err := n.notificationGateway.Notify(ctx, credOfferBytes, subjectDIDDoc)
 	if err != nil {
@@ -206,7 +269,7 @@ func (n *notification) send(ctx context.Context, credOfferBytes []byte, subjectD
 		if nr.Status != domain.DeviceNotificationStatusSuccess {
 			log.Error(ctx, "failed to send push notification to certain user device",
 				"device encrypted info", nr.Device.Ciphertext, "reason", nr.Reason)
-			return errors.New("failed to send push notification")
+			return fmt.Errorf("failed to send push notification: %s", nr.Reason)
 		}
 	}
 
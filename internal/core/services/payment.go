@@ -29,12 +29,14 @@ import (
 	"github.com/near/borsh-go"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/eth"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/network"
 	"github.com/polygonid/sh-id-platform/internal/payments"
+	"github.com/polygonid/sh-id-platform/internal/pubsub"
 )
 
 const (
@@ -53,12 +55,13 @@ type payment struct {
 	schemaService                        ports.SchemaService
 	paymentsStore                        ports.PaymentRepository
 	kms                                  kms.KMSType
+	publisher                            pubsub.Publisher
 	iden3PaymentRailsRequestV1Types      apitypes.Types
 	iden3PaymentRailsERC20RequestV1Types apitypes.Types
 }
 
 // NewPaymentService creates a new payment service
-func NewPaymentService(payOptsRepo ports.PaymentRepository, resolver network.Resolver, schemaSrv ports.SchemaService, settings *payments.Config, kms kms.KMSType) (ports.PaymentService, error) {
+func NewPaymentService(payOptsRepo ports.PaymentRepository, resolver network.Resolver, schemaSrv ports.SchemaService, settings *payments.Config, kms kms.KMSType, publisher pubsub.Publisher) (ports.PaymentService, error) {
 	iden3PaymentRailsRequestV1Types := apitypes.Types{}
 	iden3PaymentRailsERC20RequestV1Types := apitypes.Types{}
 	err := json.Unmarshal([]byte(domain.Iden3PaymentRailsRequestV1SchemaJSON), &iden3PaymentRailsRequestV1Types)
@@ -77,6 +80,7 @@ func NewPaymentService(payOptsRepo ports.PaymentRepository, resolver network.Res
 		schemaService:                        schemaSrv,
 		paymentsStore:                        payOptsRepo,
 		kms:                                  kms,
+		publisher:                            publisher,
 		iden3PaymentRailsRequestV1Types:      iden3PaymentRailsRequestV1Types,
 		iden3PaymentRailsERC20RequestV1Types: iden3PaymentRailsERC20RequestV1Types,
 	}, nil
@@ -352,6 +356,17 @@ func (p *payment) VerifyPayment(ctx context.Context, issuerDID w3c.DID, nonce *b
 			return status, paymentReqItem.PaymentRequestID, err
 		}
 
+		verified := &event.PaymentVerified{
+			PaymentRequestID: paymentReq.ID.String(),
+			IssuerID:         issuerDID.String(),
+			UserID:           paymentReq.UserDID.String(),
+			Nonce:            nonce.String(),
+			Status:           string(paymentReqStatus),
+		}
+		if err := p.publisher.Publish(ctx, event.PaymentVerifiedEvent, verified); err != nil {
+			log.Error(ctx, "publish PaymentVerifiedEvent", "err", err.Error(), "paymentRequest", paymentReq.ID.String())
+		}
+
 	}
 
 	return status, paymentReqItem.PaymentRequestID, nil
@@ -790,7 +805,7 @@ func (p *payment) eip712PaymentRequestSignature(
 		return nil, err
 	}
 
-	signature, err := p.kms.Sign(ctx, keyID, typedDataBytes)
+	signature, err := p.kms.Sign(ctx, keyID, kms.SignPurposePaymentRequest, typedDataBytes)
 	if err != nil {
 		log.Error(ctx, "failed to sign typed data hash", "err", err, "keyId", keyID)
 		return nil, err
@@ -960,7 +975,7 @@ func (p *payment) ed25519PaymentRequestSignature(
 		ID:   string(decodedKeyID),
 	}
 
-	signature, err = p.kms.Sign(ctx, keyID, serialized)
+	signature, err = p.kms.Sign(ctx, keyID, kms.SignPurposePaymentRequest, serialized)
 	if err != nil {
 		log.Error(ctx, "failed to sign typed data hash", "err", err, "keyId", keyID)
 		return nil, fmt.Errorf("failed to sign serialized data (ed25519): %w", err)
@@ -0,0 +1,459 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	auth "github.com/iden3/go-iden3-auth/v2"
+	"github.com/iden3/go-iden3-auth/v2/pubsignals"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/qrlink"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const pendingActionReason = "pending action approval"
+
+var (
+	// ErrPendingActionDoesNotExist pending action does not exist
+	ErrPendingActionDoesNotExist = errors.New("pending action does not exist")
+	// ErrPendingActionNotPending the pending action has already been executed, rejected or it has expired
+	ErrPendingActionNotPending = errors.New("the action is not pending anymore")
+	// ErrPendingActionAlreadyDecided the approver already approved or rejected the pending action
+	ErrPendingActionAlreadyDecided = errors.New("the approver already decided on the action")
+	// ErrInvalidApprover the answer of the approver could not be verified or the DID is not an approver
+	ErrInvalidApprover = errors.New("invalid approver")
+)
+
+// PendingActionConfig configures which operations need to be approved and by whom. The caller that asked for an
+// action can not approve it.
+// RequiredApprovals: number of approvers (M) that have to confirm an action. Zero disables approvals.
+// Approvers: api keys (apikey:<id>) and bearer token subjects (oidc:<subject>) that approve an action with the API.
+// They also need the pending-actions:approve scope.
+// ApproverDIDs: DIDs of the approvers that confirm an action by answering its QR code.
+// Operations: operations that need approval.
+// RevocationThreshold: revocations of up to this number of credentials do not need approval.
+// Expiration: time the approvers have to confirm an action.
+type PendingActionConfig struct {
+	RequiredApprovals   int
+	Approvers           []string
+	ApproverDIDs        []string
+	Operations          []domain.PendingActionType
+	RevocationThreshold int
+	Expiration          time.Duration
+}
+
+type pendingAction struct {
+	cfg               PendingActionConfig
+	repo              ports.PendingActionRepository
+	publisher         ports.Publisher
+	claimService      ports.ClaimService
+	connectionService ports.ConnectionService
+	keyService        ports.KeyService
+	paymentService    ports.PaymentService
+	qrService         ports.QrStoreService
+	verifier          *auth.Verifier
+	storage           *db.Storage
+}
+
+// NewPendingAction returns a new pending action service
+func NewPendingAction(cfg PendingActionConfig, repo ports.PendingActionRepository, publisher ports.Publisher, claimService ports.ClaimService, connectionService ports.ConnectionService, keyService ports.KeyService, paymentService ports.PaymentService, qrService ports.QrStoreService, verifier *auth.Verifier, storage *db.Storage) ports.PendingActionService {
+	return &pendingAction{
+		cfg:               cfg,
+		repo:              repo,
+		publisher:         publisher,
+		claimService:      claimService,
+		connectionService: connectionService,
+		keyService:        keyService,
+		paymentService:    paymentService,
+		qrService:         qrService,
+		verifier:          verifier,
+		storage:           storage,
+	}
+}
+
+// IsRequired returns true if the given operation has to be approved. credentials is the number of credentials
+// revoked by revocation operations and it is ignored for the rest. Deleting a connection is held as the revocation
+// of its credentials.
+func (p *pendingAction) IsRequired(actionType domain.PendingActionType, credentials int) bool {
+	if actionType == domain.PendingActionTypeDeleteConnection {
+		actionType = domain.PendingActionTypeRevokeCredentials
+	}
+	if p.cfg.RequiredApprovals <= 0 || !slices.Contains(p.cfg.Operations, actionType) {
+		return false
+	}
+	if actionType == domain.PendingActionTypeRevokeCredentials {
+		return credentials > p.cfg.RevocationThreshold
+	}
+	return true
+}
+
+// Hold stores the operation as a pending action until it is approved. requestedBy is the caller that asked for it,
+// if known. If there are DID approvers, a QR code is created so they can approve it.
+func (p *pendingAction) Hold(ctx context.Context, issuerDID w3c.DID, actionType domain.PendingActionType, requestedBy *string, payload any, serverURL string) (*domain.PendingAction, error) {
+	action, err := domain.NewPendingAction(issuerDID, actionType, requestedBy, payload, p.cfg.RequiredApprovals, time.Now().Add(p.cfg.Expiration))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(p.cfg.ApproverDIDs) > 0 {
+		authReq := auth.CreateAuthorizationRequest(pendingActionReason, issuerDID.String(), fmt.Sprintf(ports.PendingActionCallbackURL, serverURL, action.ID))
+		authReq.Body.Scope = make([]protocol.ZeroKnowledgeProofRequest, 0)
+		action.Request = &authReq
+
+		raw, err := json.Marshal(authReq)
+		if err != nil {
+			return nil, err
+		}
+		linkID, err := p.qrService.Store(ctx, raw, p.cfg.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		action.QRCodeURL = common.ToPointer(qrlink.NewDeepLink(serverURL, linkID, nil))
+	}
+
+	if err := p.repo.Save(ctx, p.storage.Pgx, action); err != nil {
+		log.Error(ctx, "saving pending action", "err", err, "type", actionType)
+		return nil, err
+	}
+
+	log.Info(ctx, "action held until approved", "id", action.ID, "type", actionType, "issuer", issuerDID.String())
+	return action, nil
+}
+
+// Approve records the approval of an approver authorized by the API, identified by its api key or bearer token
+// subject. The action is executed when it reaches the required approvals.
+func (p *pendingAction) Approve(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, approver string, reason *string) (*domain.PendingAction, error) {
+	if !p.isApprover(ctx, approver) {
+		return nil, ErrInvalidApprover
+	}
+	if _, err := p.GetByID(ctx, issuerDID, id); err != nil {
+		return nil, err
+	}
+	return p.decide(ctx, id, approver, domain.PendingActionDecisionApproved, reason)
+}
+
+// Reject records the rejection of an approver authorized by the API. A single rejection rejects the action.
+func (p *pendingAction) Reject(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, approver string, reason *string) (*domain.PendingAction, error) {
+	if !p.isApprover(ctx, approver) {
+		return nil, ErrInvalidApprover
+	}
+	if _, err := p.GetByID(ctx, issuerDID, id); err != nil {
+		return nil, err
+	}
+	return p.decide(ctx, id, approver, domain.PendingActionDecisionRejected, reason)
+}
+
+// Verify verifies the answer of a DID approver to the QR code of a pending action and records the approval
+func (p *pendingAction) Verify(ctx context.Context, id uuid.UUID, token string) (*domain.PendingAction, error) {
+	action, err := p.getByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if action.Request == nil {
+		return nil, ErrInvalidApprover
+	}
+
+	if p.verifier == nil {
+		return nil, errors.New("verifier not configured")
+	}
+
+	arm, err := p.verifier.FullVerify(ctx, token, *action.Request, pubsignals.WithAcceptedStateTransitionDelay(transitionDelay))
+	if err != nil {
+		log.Warn(ctx, "pending action approval verification failed", "err", err, "id", id)
+		return nil, fmt.Errorf("%w: %s", ErrInvalidApprover, err)
+	}
+
+	if !slices.Contains(p.cfg.ApproverDIDs, arm.From) {
+		log.Warn(ctx, "pending action approved by a DID that is not an approver", "id", id, "did", arm.From)
+		return nil, ErrInvalidApprover
+	}
+
+	return p.decide(ctx, id, arm.From, domain.PendingActionDecisionApproved, nil)
+}
+
+// GetByID returns the pending action with the given id
+func (p *pendingAction) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.PendingAction, error) {
+	action, err := p.getByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if action.IssuerDID.String() != issuerDID.String() {
+		return nil, ErrPendingActionDoesNotExist
+	}
+	return action, nil
+}
+
+// GetAll returns the pending actions of the issuer, optionally filtered by status
+func (p *pendingAction) GetAll(ctx context.Context, issuerDID w3c.DID, status *domain.PendingActionStatus) ([]domain.PendingAction, error) {
+	// actions are marked as expired when they are read, so expired actions may still be stored as pending
+	repoStatus := status
+	if status != nil && (*status == domain.PendingActionStatusPending || *status == domain.PendingActionStatusExpired) {
+		repoStatus = nil
+	}
+
+	actions, err := p.repo.GetAll(ctx, p.storage.Pgx, issuerDID, repoStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.PendingAction, 0, len(actions))
+	for i := range actions {
+		p.expire(ctx, &actions[i])
+		if status != nil && actions[i].Status != *status {
+			continue
+		}
+		result = append(result, actions[i])
+	}
+	return result, nil
+}
+
+// isApprover returns true if the API caller is one of the configured approvers
+func (p *pendingAction) isApprover(ctx context.Context, approver string) bool {
+	if approver == "" || !slices.Contains(p.cfg.Approvers, approver) {
+		log.Warn(ctx, "pending action decided by a caller that is not an approver", "approver", approver)
+		return false
+	}
+	return true
+}
+
+func (p *pendingAction) getByID(ctx context.Context, id uuid.UUID) (*domain.PendingAction, error) {
+	action, err := p.repo.GetByID(ctx, p.storage.Pgx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrPendingActionDoesNotExist) {
+			return nil, ErrPendingActionDoesNotExist
+		}
+		return nil, err
+	}
+	p.expire(ctx, action)
+	return action, nil
+}
+
+// decide stores the decision of the approver. The action is locked while the decision is counted, and it is
+// executed right after the approval that reaches the required approvals is stored.
+func (p *pendingAction) decide(ctx context.Context, id uuid.UUID, approver string, decision domain.PendingActionDecision, reason *string) (*domain.PendingAction, error) {
+	var action *domain.PendingAction
+	err := p.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var err error
+		if action, err = p.repo.GetByIDForUpdate(ctx, tx, id); err != nil {
+			if errors.Is(err, repositories.ErrPendingActionDoesNotExist) {
+				return ErrPendingActionDoesNotExist
+			}
+			return err
+		}
+
+		if action.IsExpired() {
+			action.Finished(domain.PendingActionStatusExpired, "the action was not approved in time")
+			if err := p.repo.Save(ctx, tx, action); err != nil {
+				return err
+			}
+			return nil
+		}
+		if action.Status != domain.PendingActionStatusPending {
+			return ErrPendingActionNotPending
+		}
+		if action.IsRequester(approver) {
+			return ErrInvalidApprover
+		}
+		if action.HasDecided(approver) {
+			return ErrPendingActionAlreadyDecided
+		}
+
+		approval := domain.PendingActionApproval{Approver: approver, Decision: decision, Reason: reason, CreatedAt: time.Now()}
+		if err := p.repo.AddApproval(ctx, tx, id, approval); err != nil {
+			if errors.Is(err, repositories.ErrPendingActionAlreadyDecided) {
+				return ErrPendingActionAlreadyDecided
+			}
+			return err
+		}
+		action.Approvals = append(action.Approvals, approval)
+
+		switch {
+		case decision == domain.PendingActionDecisionRejected:
+			action.Finished(domain.PendingActionStatusRejected, fmt.Sprintf("rejected by %s", approver))
+		case action.ApprovalsCount() >= action.RequiredApprovals:
+			action.Status = domain.PendingActionStatusApproved
+		default:
+			return nil
+		}
+		return p.repo.Save(ctx, tx, action)
+	})
+	if err != nil {
+		log.Error(ctx, "deciding on pending action", "err", err, "id", id, "approver", approver)
+		return nil, err
+	}
+
+	if action.Status == domain.PendingActionStatusExpired {
+		return nil, ErrPendingActionNotPending
+	}
+
+	log.Info(ctx, "pending action decision", "id", id, "approver", approver, "decision", decision, "approvals", action.ApprovalsCount(), "required", action.RequiredApprovals)
+
+	if action.Status == domain.PendingActionStatusApproved {
+		if executed, err := p.execute(ctx, id); err != nil {
+			log.Error(ctx, "executing approved action, it will be retried", "err", err, "id", id)
+		} else {
+			action = executed
+		}
+	}
+
+	return action, nil
+}
+
+// ExecuteApproved executes the actions that were approved but not executed, because the node stopped before
+// running them or storing their outcome
+func (p *pendingAction) ExecuteApproved(ctx context.Context) error {
+	ids, err := p.repo.GetApproved(ctx, p.storage.Pgx)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if _, err := p.execute(ctx, id); err != nil {
+			log.Error(ctx, "executing approved action", "err", err, "id", id)
+		}
+	}
+	return nil
+}
+
+// execute runs the approved operation and stores its outcome. The action is locked until its outcome is stored,
+// so it is executed once even if it is also picked by ExecuteApproved, and it stays approved to be run again if
+// the outcome is not stored. Failures of the operation are kept in the action, they are not returned because the
+// approval itself succeeded.
+func (p *pendingAction) execute(ctx context.Context, id uuid.UUID) (*domain.PendingAction, error) {
+	var action *domain.PendingAction
+	err := p.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var err error
+		if action, err = p.repo.GetByIDForUpdate(ctx, tx, id); err != nil {
+			return err
+		}
+		if action.Status != domain.PendingActionStatusApproved {
+			return nil
+		}
+
+		result, err := p.run(ctx, action)
+		if err != nil {
+			log.Error(ctx, "executing approved action", "err", err, "id", action.ID, "type", action.Type)
+			action.Finished(domain.PendingActionStatusFailed, err.Error())
+		} else {
+			log.Info(ctx, "approved action executed", "id", action.ID, "type", action.Type)
+			action.Executed(result)
+		}
+		return p.repo.Save(ctx, tx, action)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+func (p *pendingAction) run(ctx context.Context, action *domain.PendingAction) (json.RawMessage, error) {
+	issuerDID := action.IssuerDID
+	switch action.Type {
+	case domain.PendingActionTypePublishState:
+		var payload domain.PublishStatePayload
+		if err := json.Unmarshal(action.Payload, &payload); err != nil {
+			return nil, err
+		}
+		publish := p.publisher.PublishState
+		if payload.Retry {
+			publish = p.publisher.RetryPublishState
+		}
+		published, err := publish(ctx, &issuerDID)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(map[string]*string{
+			"txID":               published.TxID,
+			"state":              published.State,
+			"claimsTreeRoot":     published.ClaimsTreeRoot,
+			"revocationTreeRoot": published.RevocationTreeRoot,
+			"rootOfRoots":        published.RootOfRoots,
+		})
+	case domain.PendingActionTypeRevokeCredentials:
+		var payload domain.RevokeCredentialsPayload
+		if err := json.Unmarshal(action.Payload, &payload); err != nil {
+			return nil, err
+		}
+		if err := p.revoke(ctx, issuerDID, payload.Nonces); err != nil {
+			return nil, err
+		}
+		return json.Marshal(map[string]int{"revoked": len(payload.Nonces)})
+	case domain.PendingActionTypeDeleteConnection:
+		var payload domain.DeleteConnectionPayload
+		if err := json.Unmarshal(action.Payload, &payload); err != nil {
+			return nil, err
+		}
+		if err := p.revoke(ctx, issuerDID, payload.Nonces); err != nil {
+			return nil, err
+		}
+		if err := p.connectionService.Delete(ctx, payload.ConnectionID, payload.DeleteCredentials, issuerDID); err != nil {
+			return nil, fmt.Errorf("deleting connection: %w", err)
+		}
+		return json.Marshal(map[string]any{"revoked": len(payload.Nonces), "connectionID": payload.ConnectionID})
+	case domain.PendingActionTypeCreateKey:
+		var payload domain.CreateKeyPayload
+		if err := json.Unmarshal(action.Payload, &payload); err != nil {
+			return nil, err
+		}
+		keyID, err := p.keyService.Create(ctx, &issuerDID, payload.KeyType, payload.Name, payload.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(map[string]string{"id": keyID.ID})
+	case domain.PendingActionTypeDeleteKey:
+		var payload domain.DeleteKeyPayload
+		if err := json.Unmarshal(action.Payload, &payload); err != nil {
+			return nil, err
+		}
+		if err := p.keyService.Delete(ctx, &issuerDID, payload.KeyID); err != nil {
+			return nil, err
+		}
+		return json.Marshal(map[string]string{"id": payload.KeyID})
+	case domain.PendingActionTypeCreatePaymentOption:
+		var payload domain.CreatePaymentOptionPayload
+		if err := json.Unmarshal(action.Payload, &payload); err != nil {
+			return nil, err
+		}
+		id, err := p.paymentService.CreatePaymentOption(ctx, &issuerDID, payload.Name, payload.Description, &payload.Config)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(map[string]string{"id": id.String()})
+	default:
+		return nil, fmt.Errorf("unknown pending action type %s", action.Type)
+	}
+}
+
+// expire marks the action as expired if it was not approved in time
+func (p *pendingAction) expire(ctx context.Context, action *domain.PendingAction) {
+	if !action.IsExpired() {
+		return
+	}
+	action.Finished(domain.PendingActionStatusExpired, "the action was not approved in time")
+	if err := p.repo.Save(ctx, p.storage.Pgx, action); err != nil {
+		log.Error(ctx, "saving expired pending action", "err", err, "id", action.ID)
+	}
+}
+
+// revoke revokes the credentials with the given nonces
+func (p *pendingAction) revoke(ctx context.Context, issuerDID w3c.DID, nonces []uint64) error {
+	for _, nonce := range nonces {
+		if err := p.claimService.Revoke(ctx, issuerDID, nonce, ""); err != nil {
+			return fmt.Errorf("revoking credential with nonce %d: %w", nonce, err)
+		}
+	}
+	return nil
+}
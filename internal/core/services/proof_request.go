@@ -0,0 +1,419 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-circuits/v2"
+	auth "github.com/iden3/go-iden3-auth/v2"
+	"github.com/iden3/go-iden3-auth/v2/pubsignals"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/qrlink"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const proofRequestReason = "proof request"
+
+var (
+	// ErrProofRequestDoesNotExist proof request does not exist
+	ErrProofRequestDoesNotExist = errors.New("proof request does not exist")
+	// ErrInvalidProofRequest the proof request to create is not valid
+	ErrInvalidProofRequest = errors.New("invalid proof request")
+	// ErrProofRequestAlreadyProcessed the proof request has already been answered
+	ErrProofRequestAlreadyProcessed = errors.New("proof request already processed")
+	// ErrProofRequestVerificationFailed the holder answer could not be verified
+	ErrProofRequestVerificationFailed = errors.New("proof request verification failed")
+)
+
+type proofRequest struct {
+	repo        ports.ProofRequestRepository
+	connService ports.ConnectionService
+	qrService   ports.QrStoreService
+	verifier    *auth.Verifier
+	storage     *db.Storage
+}
+
+// NewProofRequest returns a new proof request service
+func NewProofRequest(repo ports.ProofRequestRepository, connService ports.ConnectionService, qrService ports.QrStoreService, verifier *auth.Verifier, storage *db.Storage) ports.ProofRequestService {
+	return &proofRequest{
+		repo:        repo,
+		connService: connService,
+		qrService:   qrService,
+		verifier:    verifier,
+		storage:     storage,
+	}
+}
+
+// Create builds an authorization request with the given scopes. If a connection is provided the request is sent
+// to it as an iden3comm message, otherwise a public QR code is created.
+func (p *proofRequest) Create(ctx context.Context, issuerDID w3c.DID, req *ports.CreateProofRequestRequest, serverURL string) (*domain.ProofRequest, error) {
+	if len(req.Scope) == 0 {
+		return nil, fmt.Errorf("%w: at least one scope is required", ErrInvalidProofRequest)
+	}
+
+	if req.ConnectionID != nil {
+		if _, err := p.connService.GetByIDAndIssuerID(ctx, *req.ConnectionID, issuerDID); err != nil {
+			return nil, err
+		}
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		reason = proofRequestReason
+	}
+
+	id := uuid.New()
+	authReq := auth.CreateAuthorizationRequest(reason, issuerDID.String(), fmt.Sprintf(ports.ProofRequestCallbackURL, serverURL, id))
+	authReq.Body.Scope = req.Scope
+	if err := auth.ValidateAuthRequest(authReq); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidProofRequest, err)
+	}
+
+	request := &domain.ProofRequest{
+		ID:           id,
+		IssuerDID:    issuerDID,
+		ConnectionID: req.ConnectionID,
+		Reason:       reason,
+		Message:      authReq,
+		Status:       domain.ProofRequestStatusPending,
+		CreatedAt:    time.Now(),
+	}
+
+	if req.ConnectionID == nil {
+		raw, err := json.Marshal(authReq)
+		if err != nil {
+			return nil, err
+		}
+		linkID, err := p.qrService.Store(ctx, raw, DefaultQRBodyTTL)
+		if err != nil {
+			return nil, err
+		}
+		request.QRCodeURL = common.ToPointer(qrlink.NewDeepLink(serverURL, linkID, nil))
+	}
+
+	if err := p.repo.Save(ctx, p.storage.Pgx, request); err != nil {
+		log.Error(ctx, "saving proof request", "err", err)
+		return nil, err
+	}
+
+	if req.ConnectionID != nil {
+		if err := p.sendToConnection(ctx, request); err != nil {
+			log.Error(ctx, "sending proof request to the connection", "err", err, "id", request.ID)
+			request.Failed(err.Error())
+			if err := p.repo.Save(ctx, p.storage.Pgx, request); err != nil {
+				log.Error(ctx, "saving proof request", "err", err, "id", request.ID)
+			}
+			return nil, err
+		}
+	}
+
+	return request, nil
+}
+
+// Verify verifies the holder answer to a proof request and stores the outcome on the request and the holder connection.
+// The callback is public, so an answer that cannot be verified is rejected without changing the request. A request
+// sent to a connection is answered once, a public one keeps the answer of each holder.
+func (p *proofRequest) Verify(ctx context.Context, id uuid.UUID, token string, serverURL string) (*domain.ProofRequest, error) {
+	request, err := p.repo.GetByID(ctx, p.storage.Pgx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProofRequestDoesNotExist) {
+			return nil, ErrProofRequestDoesNotExist
+		}
+		return nil, err
+	}
+
+	if !request.IsOpen() {
+		return nil, ErrProofRequestAlreadyProcessed
+	}
+
+	if p.verifier == nil {
+		return nil, errors.New("verifier not configured")
+	}
+
+	arm, err := p.verifier.FullVerify(ctx, token, request.Message, pubsignals.WithAcceptedStateTransitionDelay(transitionDelay))
+	if err != nil {
+		log.Warn(ctx, "proof request verification failed", "err", err, "id", id)
+		return nil, fmt.Errorf("%w: %s", ErrProofRequestVerificationFailed, err)
+	}
+
+	results, err := proofRequestResults(request.Message.Body.Scope, arm.Body.Scope)
+	if err != nil {
+		log.Warn(ctx, "reading proof request results", "err", err, "id", id)
+		return nil, fmt.Errorf("%w: %s", ErrProofRequestVerificationFailed, err)
+	}
+
+	connectionID, err := p.holderConnection(ctx, request, arm, serverURL)
+	if err != nil {
+		return nil, err
+	}
+
+	response := request.Verified(arm.From, connectionID, results)
+	err = p.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := p.repo.SaveResponse(ctx, tx, response); err != nil {
+			return err
+		}
+		return p.repo.Save(ctx, tx, request)
+	})
+	if err != nil {
+		if errors.Is(err, repositories.ErrProofRequestResponseAlreadyExists) {
+			return nil, ErrProofRequestAlreadyProcessed
+		}
+		log.Error(ctx, "saving proof request", "err", err, "id", id)
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func (p *proofRequest) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.ProofRequest, error) {
+	request, err := p.repo.GetByID(ctx, p.storage.Pgx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProofRequestDoesNotExist) {
+			return nil, ErrProofRequestDoesNotExist
+		}
+		return nil, err
+	}
+
+	if request.IssuerDID.String() != issuerDID.String() {
+		return nil, ErrProofRequestDoesNotExist
+	}
+
+	if err := p.addResponses(ctx, request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func (p *proofRequest) GetAll(ctx context.Context, issuerDID w3c.DID, connectionID *uuid.UUID) ([]domain.ProofRequest, error) {
+	requests, err := p.repo.GetAll(ctx, p.storage.Pgx, issuerDID, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]*domain.ProofRequest, len(requests))
+	for i := range requests {
+		refs[i] = &requests[i]
+	}
+	if err := p.addResponses(ctx, refs...); err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+// addResponses reads the answers of the given requests with a single query
+func (p *proofRequest) addResponses(ctx context.Context, requests ...*domain.ProofRequest) error {
+	if len(requests) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*domain.ProofRequest, len(requests))
+	ids := make([]uuid.UUID, len(requests))
+	for i, request := range requests {
+		request.Responses = make([]domain.ProofRequestResponse, 0)
+		byID[request.ID] = request
+		ids[i] = request.ID
+	}
+
+	responses, err := p.repo.GetResponses(ctx, p.storage.Pgx, ids)
+	if err != nil {
+		log.Error(ctx, "getting proof request responses", "err", err)
+		return err
+	}
+	for _, response := range responses {
+		request := byID[response.ProofRequestID]
+		request.Responses = append(request.Responses, response)
+	}
+
+	return nil
+}
+
+func (p *proofRequest) sendToConnection(ctx context.Context, request *domain.ProofRequest) error {
+	body, err := json.Marshal(request.Message.Body)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.connService.SendMessage(ctx, *request.ConnectionID, request.IssuerDID, &ports.SendConnectionMessageRequest{
+		Type:     string(protocol.AuthorizationRequestMessageType),
+		ThreadID: request.Message.ThreadID,
+		Body:     body,
+	})
+	return err
+}
+
+// holderConnection returns the connection of the identity that answered the request.
+// For public requests the connection is created if the holder is not connected yet.
+func (p *proofRequest) holderConnection(ctx context.Context, request *domain.ProofRequest, arm *protocol.AuthorizationResponseMessage, serverURL string) (*uuid.UUID, error) {
+	userDID, err := w3c.ParseDID(arm.From)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrProofRequestVerificationFailed, err)
+	}
+
+	if !request.IsPublic() {
+		if request.ConnectionID == nil {
+			return nil, fmt.Errorf("%w: the connection of the request was deleted", ErrProofRequestVerificationFailed)
+		}
+		conn, err := p.connService.GetByIDAndIssuerID(ctx, *request.ConnectionID, request.IssuerDID)
+		if err != nil {
+			return nil, err
+		}
+		if conn.UserDID.String() != userDID.String() {
+			return nil, fmt.Errorf("%w: proofs presented by an identity different from the connection one", ErrProofRequestVerificationFailed)
+		}
+		return &conn.ID, nil
+	}
+
+	conn, err := p.connService.GetByUserID(ctx, request.IssuerDID, *userDID)
+	if err == nil {
+		return &conn.ID, nil
+	}
+	if !errors.Is(err, ErrConnectionDoesNotExist) {
+		return nil, err
+	}
+
+	issuerDoc, err := json.Marshal(newDIDDocument(serverURL, request.IssuerDID))
+	if err != nil {
+		return nil, err
+	}
+
+	newConn := &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  request.IssuerDID,
+		UserDID:    *userDID,
+		IssuerDoc:  sanitizeIssuerDoc(issuerDoc),
+		UserDoc:    arm.Body.DIDDoc,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	}
+	if err := p.connService.Create(ctx, newConn); err != nil {
+		return nil, err
+	}
+
+	conn, err = p.connService.GetByUserID(ctx, request.IssuerDID, *userDID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &conn.ID, nil
+}
+
+// proofRequestResults decodes the public outputs of every presented proof. Values of selective disclosure
+// queries are returned as field elements, keyed by the credentialSubject field name.
+func proofRequestResults(scope []protocol.ZeroKnowledgeProofRequest, proofs []protocol.ZeroKnowledgeProofResponse) ([]domain.ProofRequestResult, error) {
+	results := make([]domain.ProofRequestResult, 0, len(proofs))
+	for _, proof := range proofs {
+		signals, err := json.Marshal(proof.PubSignals)
+		if err != nil {
+			return nil, err
+		}
+
+		rawOutputs, err := circuits.UnmarshalCircuitOutput(circuits.CircuitID(proof.CircuitID), signals)
+		if err != nil {
+			return nil, err
+		}
+
+		outputs, err := normalizeCircuitOutputs(rawOutputs)
+		if err != nil {
+			return nil, err
+		}
+
+		result := domain.ProofRequestResult{
+			ID:        proof.ID,
+			CircuitID: proof.CircuitID,
+			Outputs:   outputs,
+		}
+
+		for _, req := range scope {
+			if req.ID == proof.ID {
+				result.Disclosed = disclosedValues(req.Query, outputs)
+				break
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// normalizeCircuitOutputs converts the circuit outputs to plain json values keeping big numbers as strings
+func normalizeCircuitOutputs(outputs map[string]interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var normalized map[string]interface{}
+	if err := decoder.Decode(&normalized); err != nil {
+		return nil, err
+	}
+
+	return numbersToStrings(normalized).(map[string]interface{}), nil
+}
+
+func numbersToStrings(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		return v.String()
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = numbersToStrings(v[k])
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = numbersToStrings(v[i])
+		}
+	}
+	return value
+}
+
+func disclosedValues(query map[string]interface{}, outputs map[string]interface{}) map[string]string {
+	credentialSubject, ok := query["credentialSubject"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var disclosed map[string]string
+	for field, operators := range credentialSubject {
+		ops, ok := operators.(map[string]interface{})
+		if !ok || len(ops) != 0 {
+			continue
+		}
+
+		value, ok := outputs["operatorOutput"].(string)
+		if !ok {
+			values, _ := outputs["value"].([]interface{})
+			if len(values) == 0 {
+				continue
+			}
+			if value, ok = values[0].(string); !ok {
+				continue
+			}
+		}
+
+		if disclosed == nil {
+			disclosed = make(map[string]string)
+		}
+		disclosed[field] = value
+	}
+
+	return disclosed
+}
@@ -0,0 +1,256 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrTenantNotFound is returned when the tenant does not exist
+	ErrTenantNotFound = errors.New("tenant not found")
+	// ErrInvalidTenantRequest is returned when the name or the quotas of the tenant are not valid
+	ErrInvalidTenantRequest = errors.New("invalid tenant request")
+	// ErrTenantQuotaExceeded is returned when the operation exceeds a quota of the tenant
+	ErrTenantQuotaExceeded = errors.New("tenant quota exceeded")
+	// ErrTenantIdentityNotFound is returned when the identity added to a tenant does not exist
+	ErrTenantIdentityNotFound = errors.New("identity not found")
+	// ErrIdentityInAnotherTenant is returned when the identity added to a tenant belongs to another one
+	ErrIdentityInAnotherTenant = errors.New("the identity belongs to another tenant")
+)
+
+type tenant struct {
+	repo    ports.TenantRepository
+	storage *db.Storage
+}
+
+// NewTenant returns the service that manages the tenants of the node
+func NewTenant(repo ports.TenantRepository, storage *db.Storage) ports.TenantService {
+	return &tenant{
+		repo:    repo,
+		storage: storage,
+	}
+}
+
+// Create validates the request and stores a new tenant
+func (t *tenant) Create(ctx context.Context, req ports.TenantRequest) (*domain.Tenant, error) {
+	if req.Name == nil {
+		return nil, fmt.Errorf("%w: the name is required", ErrInvalidTenantRequest)
+	}
+	tenant := domain.NewTenant("", nil, nil)
+	if err := applyTenantRequest(tenant, req); err != nil {
+		return nil, err
+	}
+	if err := t.save(ctx, tenant); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "tenant created", "id", tenant.ID, "name", tenant.Name)
+	return tenant, nil
+}
+
+// Update changes the name and quotas of the tenant given in the request
+func (t *tenant) Update(ctx context.Context, id uuid.UUID, req ports.TenantRequest) (*domain.Tenant, error) {
+	tenant, err := t.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyTenantRequest(tenant, req); err != nil {
+		return nil, err
+	}
+	if err := t.save(ctx, tenant); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "tenant updated", "id", tenant.ID, "name", tenant.Name)
+	return tenant, nil
+}
+
+// Get returns the tenant with the given id
+func (t *tenant) Get(ctx context.Context, id uuid.UUID) (*domain.Tenant, error) {
+	tenant, err := t.repo.GetByID(ctx, t.storage.Pgx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTenantNotFound) {
+			return nil, ErrTenantNotFound
+		}
+		return nil, err
+	}
+	return tenant, nil
+}
+
+// GetAll returns all the tenants
+func (t *tenant) GetAll(ctx context.Context) ([]domain.Tenant, error) {
+	return t.repo.GetAll(ctx, t.storage.Pgx)
+}
+
+// GetUsage returns the identities of the tenant and the credentials issued by them this month
+func (t *tenant) GetUsage(ctx context.Context, id uuid.UUID) (*domain.TenantUsage, error) {
+	identities, err := t.repo.CountIdentities(ctx, t.storage.Pgx, id)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := t.repo.CountCredentialsSince(ctx, t.storage.Pgx, id, monthStart(time.Now()))
+	if err != nil {
+		return nil, err
+	}
+	return &domain.TenantUsage{Identities: identities, CredentialsThisMonth: credentials}, nil
+}
+
+// GetIdentities returns the identifiers of the identities of the tenant
+func (t *tenant) GetIdentities(ctx context.Context, id uuid.UUID) ([]string, error) {
+	if _, err := t.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	return t.repo.GetIdentities(ctx, t.storage.Pgx, id)
+}
+
+// AddIdentity assigns an existing identity without tenant to the tenant, if the identities quota allows it
+func (t *tenant) AddIdentity(ctx context.Context, id uuid.UUID, identifier w3c.DID) error {
+	err := t.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := t.LockIdentityQuota(ctx, tx, id); err != nil {
+			return err
+		}
+		return t.repo.AddIdentity(ctx, tx, id, identifier.String())
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrTenantNotFound), errors.Is(err, ErrTenantQuotaExceeded):
+			return err
+		case errors.Is(err, repositories.ErrIdentityNotFound):
+			return ErrTenantIdentityNotFound
+		case errors.Is(err, repositories.ErrIdentityInAnotherTenant):
+			return ErrIdentityInAnotherTenant
+		}
+		log.Error(ctx, "adding identity to tenant", "err", err, "id", id, "identifier", identifier)
+		return err
+	}
+	log.Info(ctx, "identity added to tenant", "id", id, "identifier", identifier)
+	return nil
+}
+
+// CheckIdentityQuota returns ErrTenantQuotaExceeded if the tenant can't have more identities. It fails early,
+// before the identity is created, the quota is enforced by LockIdentityQuota when the identity is stored.
+func (t *tenant) CheckIdentityQuota(ctx context.Context, id uuid.UUID) error {
+	return t.LockIdentityQuota(ctx, t.storage.Pgx, id)
+}
+
+// LockIdentityQuota returns ErrTenantQuotaExceeded if the tenant can't have more identities. The tenant is locked
+// until tx ends, so the identities stored by concurrent transactions are counted one after the other. It has to be
+// called in the transaction that stores the identity.
+func (t *tenant) LockIdentityQuota(ctx context.Context, tx db.Querier, id uuid.UUID) error {
+	tenant, err := t.repo.GetByIDForUpdate(ctx, tx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTenantNotFound) {
+			return ErrTenantNotFound
+		}
+		return err
+	}
+	if tenant.MaxIdentities == nil {
+		return nil
+	}
+	identities, err := t.repo.CountIdentities(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+	if !tenant.AllowsNewIdentity(identities) {
+		return fmt.Errorf("%w: the tenant can't have more than %d identities", ErrTenantQuotaExceeded, *tenant.MaxIdentities)
+	}
+	return nil
+}
+
+// CheckCredentialQuota returns ErrTenantQuotaExceeded if the tenant of the identity has issued all the credentials
+// of its monthly quota. Identities without tenant have no quota. It fails early, before the credential is created,
+// the quota is enforced by LockCredentialQuota when the credential is stored.
+func (t *tenant) CheckCredentialQuota(ctx context.Context, identifier w3c.DID) error {
+	return t.LockCredentialQuota(ctx, t.storage.Pgx, identifier)
+}
+
+// LockCredentialQuota returns ErrTenantQuotaExceeded if the tenant of the identity has issued all the credentials
+// of its monthly quota. The tenant is locked until tx ends, so the credentials stored by concurrent transactions are
+// counted one after the other. It has to be called in the transaction that stores the credential.
+func (t *tenant) LockCredentialQuota(ctx context.Context, tx db.Querier, identifier w3c.DID) error {
+	tenant, err := t.repo.GetByIdentity(ctx, tx, identifier.String())
+	if err != nil {
+		if errors.Is(err, repositories.ErrTenantNotFound) {
+			return nil
+		}
+		return err
+	}
+	if tenant.MaxCredentialsPerMonth == nil {
+		return nil
+	}
+	if tenant, err = t.repo.GetByIDForUpdate(ctx, tx, tenant.ID); err != nil {
+		return err
+	}
+	if tenant.MaxCredentialsPerMonth == nil {
+		return nil
+	}
+	credentials, err := t.repo.CountCredentialsSince(ctx, tx, tenant.ID, monthStart(time.Now()))
+	if err != nil {
+		return err
+	}
+	if !tenant.AllowsNewCredential(credentials) {
+		return fmt.Errorf("%w: the tenant can't issue more than %d credentials per month", ErrTenantQuotaExceeded, *tenant.MaxCredentialsPerMonth)
+	}
+	return nil
+}
+
+func (t *tenant) save(ctx context.Context, tenant *domain.Tenant) error {
+	if err := t.repo.Save(ctx, t.storage.Pgx, tenant); err != nil {
+		if errors.Is(err, repositories.ErrTenantNameDuplicated) {
+			return fmt.Errorf("%w: %w", ErrInvalidTenantRequest, err)
+		}
+		log.Error(ctx, "saving tenant", "err", err, "id", tenant.ID)
+		return err
+	}
+	return nil
+}
+
+// applyTenantRequest sets the fields of the request in the tenant. A quota of 0 removes it.
+func applyTenantRequest(tenant *domain.Tenant, req ports.TenantRequest) error {
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return fmt.Errorf("%w: the name is required", ErrInvalidTenantRequest)
+		}
+		tenant.Name = name
+	}
+	quotas := []struct {
+		name  string
+		value *int
+		field **int
+	}{
+		{name: "maxIdentities", value: req.MaxIdentities, field: &tenant.MaxIdentities},
+		{name: "maxCredentialsPerMonth", value: req.MaxCredentialsPerMonth, field: &tenant.MaxCredentialsPerMonth},
+	}
+	for _, quota := range quotas {
+		if quota.value == nil {
+			continue
+		}
+		switch {
+		case *quota.value < 0:
+			return fmt.Errorf("%w: %s can't be negative", ErrInvalidTenantRequest, quota.name)
+		case *quota.value == 0:
+			*quota.field = nil
+		default:
+			*quota.field = quota.value
+		}
+	}
+	return nil
+}
+
+// monthStart returns the start of the calendar month of the time in UTC
+func monthStart(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,357 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	stdnet "net"
+	"net/http"
+	"net/url"
+	"slices"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/cache"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/core/pagination"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/pubsub"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/tracing"
+)
+
+var (
+	// ErrWebhookNotFound is returned when the webhook does not exist or belongs to another identity
+	ErrWebhookNotFound = errors.New("webhook not found")
+	// ErrInvalidWebhookRequest is returned when the url or the events of the webhook are not valid
+	ErrInvalidWebhookRequest = errors.New("invalid webhook request")
+	// ErrWebhookPrivateAddress is returned when the webhook url resolves to an address that is not public
+	ErrWebhookPrivateAddress = errors.New("the webhook url resolves to a private address")
+)
+
+const (
+	webhookSecretPrefix = "whsec_"
+	// webhookEventTTL is how long an event is remembered as queued, so the replicas of the notifications service that
+	// receive the same pubsub message queue it only once
+	webhookEventTTL = 10 * time.Minute
+
+	// Headers of the webhook deliveries
+	WebhookEventHeader     = "X-Issuer-Event"     // WebhookEventHeader type of the event
+	WebhookDeliveryHeader  = "X-Issuer-Delivery"  // WebhookDeliveryHeader id of the delivery, the same in all the attempts
+	WebhookSignatureHeader = "X-Issuer-Signature" // WebhookSignatureHeader t=<unix timestamp>,v1=<hex HMAC-SHA256>
+)
+
+// webhookTopics maps the pubsub topics to the webhook event types
+var webhookTopics = map[string]domain.WebhookEventType{
+	event.CredentialCreatedEvent: domain.WebhookEventCredentialCreated,
+	event.CredentialFetchedEvent: domain.WebhookEventCredentialFetched,
+	event.CredentialRevokedEvent: domain.WebhookEventCredentialRevoked,
+	event.StatePublishedEvent:    domain.WebhookEventStatePublished,
+	event.CreateStateEvent:       domain.WebhookEventStateConfirmed,
+	event.StateFailedEvent:       domain.WebhookEventStateFailed,
+	event.CreateConnectionEvent:  domain.WebhookEventConnectionCreated,
+	event.LinkRedeemedEvent:      domain.WebhookEventLinkRedeemed,
+	event.PaymentVerifiedEvent:   domain.WebhookEventPaymentVerified,
+}
+
+// webhookPayload is the body of the webhook deliveries. Data is the event as published in the pubsub.
+type webhookPayload struct {
+	ID         uuid.UUID               `json:"id"`
+	Type       domain.WebhookEventType `json:"type"`
+	Identifier string                  `json:"identifier"`
+	CreatedAt  time.Time               `json:"createdAt"`
+	Data       json.RawMessage         `json:"data"`
+}
+
+type webhook struct {
+	repo    ports.WebhookRepository
+	storage *db.Storage
+	cache   cache.Cache
+	client  *http.Client
+	cfg     config.Webhooks
+}
+
+// NewWebhook returns the service that manages the webhooks of the identities and sends them the events.
+// Unless cfg.AllowPrivateNetworks is set, the deliveries are only sent to public addresses. The address is checked
+// when the connection is made, so a host that resolves to another address after the webhook is created is also
+// rejected, and the proxy of the environment is not used because it would resolve the host instead.
+func NewWebhook(repo ports.WebhookRepository, storage *db.Storage, cachex cache.Cache, cfg config.Webhooks) ports.WebhookService {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !cfg.AllowPrivateNetworks {
+		dialer := &stdnet.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, Control: publicAddressControl}
+		transport.DialContext = dialer.DialContext
+		transport.Proxy = nil
+	}
+	return &webhook{
+		repo:    repo,
+		storage: storage,
+		cache:   cachex,
+		client: &http.Client{
+			Timeout:   cfg.Timeout,
+			Transport: tracing.Transport(transport),
+			// The signature covers the body only, a redirect could send it to a url not registered by the identity
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		},
+		cfg: cfg,
+	}
+}
+
+// Create validates the request and stores a new webhook with a random secret
+func (w *webhook) Create(ctx context.Context, identifier w3c.DID, req ports.WebhookRequest) (*domain.Webhook, error) {
+	if err := w.validateURL(ctx, req.URL); err != nil {
+		return nil, err
+	}
+	events := make([]domain.WebhookEventType, 0, len(req.Events))
+	for _, eventType := range req.Events {
+		if !slices.Contains(domain.WebhookEventTypes(), eventType) {
+			return nil, fmt.Errorf("%w: unknown event %q", ErrInvalidWebhookRequest, eventType)
+		}
+		if !slices.Contains(events, eventType) {
+			events = append(events, eventType)
+		}
+	}
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	wh := domain.NewWebhook(identifier.String(), req.URL, secret, events, req.Description)
+	if err := w.repo.Save(ctx, w.storage.Pgx, wh); err != nil {
+		log.Error(ctx, "saving webhook", "err", err)
+		return nil, err
+	}
+	log.Info(ctx, "webhook created", "id", wh.ID, "identifier", wh.Identifier)
+	return wh, nil
+}
+
+// Get returns the webhook of the identity
+func (w *webhook) Get(ctx context.Context, identifier w3c.DID, id uuid.UUID) (*domain.Webhook, error) {
+	wh, err := w.repo.GetByID(ctx, w.storage.Pgx, identifier, id)
+	if errors.Is(err, repositories.ErrWebhookNotFound) {
+		return nil, ErrWebhookNotFound
+	}
+	return wh, err
+}
+
+// GetAll returns the webhooks of the identity
+func (w *webhook) GetAll(ctx context.Context, identifier w3c.DID) ([]domain.Webhook, error) {
+	return w.repo.GetAll(ctx, w.storage.Pgx, identifier)
+}
+
+// Delete removes the webhook of the identity and its deliveries
+func (w *webhook) Delete(ctx context.Context, identifier w3c.DID, id uuid.UUID) error {
+	err := w.repo.Delete(ctx, w.storage.Pgx, identifier, id)
+	if errors.Is(err, repositories.ErrWebhookNotFound) {
+		return ErrWebhookNotFound
+	}
+	if err == nil {
+		log.Info(ctx, "webhook deleted", "id", id, "identifier", identifier.String())
+	}
+	return err
+}
+
+// GetDeliveries returns a page of the deliveries of the webhook, the newest first, and the total
+func (w *webhook) GetDeliveries(ctx context.Context, identifier w3c.DID, id uuid.UUID, filter pagination.Filter) ([]domain.WebhookDelivery, uint, error) {
+	if _, err := w.Get(ctx, identifier, id); err != nil {
+		return nil, 0, err
+	}
+	return w.repo.GetDeliveries(ctx, w.storage.Pgx, id, filter)
+}
+
+// Subscribe queues a delivery of the pubsub events to the webhooks of the identity of the event. Every subscriber
+// receives the events, so only the first one that receives each of them queues it.
+func (w *webhook) Subscribe(ctx context.Context, subscriber pubsub.Subscriber) {
+	for topic, eventType := range webhookTopics {
+		subscriber.Subscribe(ctx, topic, func(ctx context.Context, msg pubsub.Message) error {
+			if !w.first(ctx, topic, msg) {
+				log.Debug(ctx, "webhook event already queued", "type", eventType)
+				return nil
+			}
+			return w.queue(ctx, eventType, msg)
+		})
+	}
+}
+
+// first tells whether the event is received for the first time in webhookEventTTL. If the cache is not available the
+// event is queued, as a duplicate delivery is better than a lost one.
+func (w *webhook) first(ctx context.Context, topic string, msg pubsub.Message) bool {
+	sum := sha256.Sum256(append([]byte(topic+":"), msg...))
+	count, _, err := w.cache.Increment(ctx, "webhook-event-"+hex.EncodeToString(sum[:]), webhookEventTTL)
+	if err != nil {
+		log.Warn(ctx, "checking queued webhook event", "err", err, "topic", topic)
+		return true
+	}
+	return count == 1
+}
+
+// queue stores a pending delivery of the event for each webhook of the issuer subscribed to it
+func (w *webhook) queue(ctx context.Context, eventType domain.WebhookEventType, msg pubsub.Message) error {
+	var ev struct {
+		IssuerID string `json:"issuerID"`
+	}
+	if err := json.Unmarshal(msg, &ev); err != nil {
+		return fmt.Errorf("webhook %s: unexpected data type: %w", eventType, err)
+	}
+	if ev.IssuerID == "" {
+		log.Debug(ctx, "webhook event without issuer", "type", eventType)
+		return nil
+	}
+	issuerDID, err := w3c.ParseDID(ev.IssuerID)
+	if err != nil {
+		return fmt.Errorf("webhook %s: invalid issuer: %w", eventType, err)
+	}
+
+	webhooks, err := w.repo.GetAll(ctx, w.storage.Pgx, *issuerDID)
+	if err != nil {
+		log.Error(ctx, "getting webhooks", "err", err, "identifier", ev.IssuerID)
+		return err
+	}
+	for _, wh := range webhooks {
+		if !wh.Subscribed(eventType) {
+			continue
+		}
+		id := uuid.New()
+		payload, err := json.Marshal(webhookPayload{
+			ID:         id,
+			Type:       eventType,
+			Identifier: ev.IssuerID,
+			CreatedAt:  time.Now().UTC(),
+			Data:       json.RawMessage(msg),
+		})
+		if err != nil {
+			return err
+		}
+		if err := w.repo.SaveDelivery(ctx, w.storage.Pgx, domain.NewWebhookDelivery(id, wh.ID, eventType, payload)); err != nil {
+			log.Error(ctx, "saving webhook delivery", "err", err, "webhook", wh.ID)
+			return err
+		}
+	}
+	return nil
+}
+
+// ProcessDeliveries sends the pending deliveries that are due. Each delivery is leased just before it is sent, for
+// twice the timeout of the request, so several instances can process them at the same time and a delivery is not sent
+// again while its attempt is in flight.
+func (w *webhook) ProcessDeliveries(ctx context.Context) error {
+	webhooks := make(map[uuid.UUID]*domain.Webhook)
+	for {
+		now := time.Now()
+		deliveries, err := w.repo.ClaimDueDeliveries(ctx, w.storage.Pgx, now, now.Add(2*w.cfg.Timeout), 1)
+		if err != nil {
+			log.Error(ctx, "claiming webhook deliveries", "err", err)
+			return err
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+		delivery := &deliveries[0]
+		wh, ok := webhooks[delivery.WebhookID]
+		if !ok {
+			wh, err = w.repo.GetWebhook(ctx, w.storage.Pgx, delivery.WebhookID)
+			if err != nil {
+				// The webhook was deleted with its deliveries
+				log.Warn(ctx, "getting webhook of delivery", "err", err, "delivery", delivery.ID)
+				continue
+			}
+			webhooks[delivery.WebhookID] = wh
+		}
+		statusCode, err := w.send(ctx, wh, delivery)
+		delivery.Attempted(statusCode, err, w.cfg.MaxAttempts, time.Now())
+		if err := w.repo.SaveDelivery(ctx, w.storage.Pgx, delivery); err != nil {
+			log.Error(ctx, "saving webhook delivery", "err", err, "delivery", delivery.ID)
+			return err
+		}
+		log.Info(ctx, "webhook delivery attempted", "delivery", delivery.ID, "webhook", wh.ID, "status", delivery.Status, "attempts", delivery.Attempts)
+	}
+}
+
+// send posts the signed payload of the delivery to the webhook and returns the status code of the response
+func (w *webhook) send(ctx context.Context, wh *domain.Webhook, delivery *domain.WebhookDelivery) (*int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return nil, err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, string(delivery.EventType))
+	req.Header.Set(WebhookDeliveryHeader, delivery.ID.String())
+	req.Header.Set(WebhookSignatureHeader, fmt.Sprintf("t=%d,v1=%s", timestamp, domain.WebhookSignature(wh.Secret, timestamp, delivery.Payload)))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+		_ = resp.Body.Close()
+	}()
+	return &resp.StatusCode, nil
+}
+
+func (w *webhook) validateURL(ctx context.Context, rawURL string) error {
+	u, err := url.ParseRequestURI(rawURL)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("%w: invalid url", ErrInvalidWebhookRequest)
+	}
+	if u.Scheme != "https" && (u.Scheme != "http" || !w.cfg.AllowHTTP) {
+		return fmt.Errorf("%w: the url must use https", ErrInvalidWebhookRequest)
+	}
+	if u.User != nil {
+		return fmt.Errorf("%w: the url cannot have credentials", ErrInvalidWebhookRequest)
+	}
+	if w.cfg.AllowPrivateNetworks {
+		return nil
+	}
+	addrs, err := stdnet.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		log.Warn(ctx, "resolving webhook host", "err", err, "host", u.Hostname())
+		return fmt.Errorf("%w: the host of the url can not be resolved", ErrInvalidWebhookRequest)
+	}
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return fmt.Errorf("%w: %w", ErrInvalidWebhookRequest, ErrWebhookPrivateAddress)
+		}
+	}
+	return nil
+}
+
+// publicAddressControl is the dialer control of the webhook deliveries, that refuses to connect to addresses that
+// are not public
+func publicAddressControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := stdnet.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := stdnet.ParseIP(host); ip == nil || !isPublicIP(ip) {
+		return fmt.Errorf("%w: %s", ErrWebhookPrivateAddress, host)
+	}
+	return nil
+}
+
+// isPublicIP returns false for the loopback, link local, private, unspecified and multicast addresses
+func isPublicIP(ip stdnet.IP) bool {
+	return !ip.IsLoopback() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() && !ip.IsPrivate() &&
+		!ip.IsUnspecified() && !ip.IsMulticast()
+}
+
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return webhookSecretPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE connections
+    ADD COLUMN tags  TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN notes TEXT;
+
+CREATE INDEX connections_tags_idx ON connections USING GIN (tags);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS connections_tags_idx;
+
+ALTER TABLE connections
+    DROP COLUMN tags,
+    DROP COLUMN notes;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE connection_messages
+(
+    id             uuid        NOT NULL PRIMARY KEY,
+    issuer_id      text        NOT NULL,
+    connection_id  uuid        NOT NULL,
+    type           text        NOT NULL,
+    thread_id      text        NOT NULL,
+    body           jsonb       NOT NULL,
+    status         text        NOT NULL,
+    failure_reason text,
+    created_at     timestamptz NOT NULL,
+    delivered_at   timestamptz,
+    CONSTRAINT fk_connection_messages_connection_id FOREIGN KEY (connection_id) REFERENCES public.connections(id) ON DELETE CASCADE
+);
+
+CREATE INDEX connection_messages_connection_id_idx ON connection_messages(connection_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS connection_messages_connection_id_idx;
+DROP TABLE IF EXISTS connection_messages;
+-- +goose StatementEnd
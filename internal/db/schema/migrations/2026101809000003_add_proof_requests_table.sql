@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE proof_requests
+(
+    id             uuid        NOT NULL PRIMARY KEY,
+    issuer_id      text        NOT NULL,
+    connection_id  uuid,
+    user_id        text,
+    reason         text        NOT NULL,
+    message        jsonb       NOT NULL,
+    qr_code_url    text,
+    status         text        NOT NULL,
+    results        jsonb,
+    failure_reason text,
+    created_at     timestamptz NOT NULL,
+    verified_at    timestamptz,
+    CONSTRAINT fk_proof_requests_connection_id FOREIGN KEY (connection_id) REFERENCES public.connections(id) ON DELETE SET NULL
+);
+
+CREATE INDEX proof_requests_issuer_id_idx ON proof_requests(issuer_id, created_at);
+CREATE INDEX proof_requests_connection_id_idx ON proof_requests(connection_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS proof_requests_connection_id_idx;
+DROP INDEX IF EXISTS proof_requests_issuer_id_idx;
+DROP TABLE IF EXISTS proof_requests;
+-- +goose StatementEnd
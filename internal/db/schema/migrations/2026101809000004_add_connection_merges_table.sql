@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE connection_merges
+(
+    id                   uuid        NOT NULL PRIMARY KEY,
+    issuer_id            text        NOT NULL,
+    connection_id        uuid        NOT NULL,
+    merged_connection_id uuid,
+    merged_user_id       text,
+    method               text        NOT NULL,
+    status               text        NOT NULL,
+    reason               text,
+    reissue_credentials  boolean     NOT NULL DEFAULT false,
+    reissued_credentials text[],
+    request              jsonb,
+    qr_code_url          text,
+    failure_reason       text,
+    created_at           timestamptz NOT NULL,
+    completed_at         timestamptz,
+    CONSTRAINT fk_connection_merges_connection_id FOREIGN KEY (connection_id) REFERENCES public.connections(id) ON DELETE CASCADE
+);
+
+CREATE INDEX connection_merges_connection_id_idx ON connection_merges(connection_id, created_at);
+CREATE UNIQUE INDEX connection_merges_merged_user_id_key ON connection_merges(issuer_id, merged_user_id) WHERE status = 'completed';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS connection_merges_merged_user_id_key;
+DROP INDEX IF EXISTS connection_merges_connection_id_idx;
+DROP TABLE IF EXISTS connection_merges;
+-- +goose StatementEnd
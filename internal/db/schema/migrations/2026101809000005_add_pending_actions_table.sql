@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE pending_actions
+(
+    id                 uuid        NOT NULL PRIMARY KEY,
+    issuer_id          text        NOT NULL,
+    type               text        NOT NULL,
+    payload            jsonb       NOT NULL,
+    status             text        NOT NULL,
+    required_approvals integer     NOT NULL,
+    request            jsonb,
+    qr_code_url        text,
+    result             jsonb,
+    failure_reason     text,
+    created_at         timestamptz NOT NULL,
+    expires_at         timestamptz NOT NULL,
+    completed_at       timestamptz,
+    CONSTRAINT fk_pending_actions_issuer_id FOREIGN KEY (issuer_id) REFERENCES public.identities(identifier) ON DELETE CASCADE
+);
+
+CREATE INDEX pending_actions_issuer_id_idx ON pending_actions(issuer_id, status, created_at);
+
+CREATE TABLE pending_action_approvals
+(
+    pending_action_id uuid        NOT NULL,
+    approver          text        NOT NULL,
+    decision          text        NOT NULL,
+    reason            text,
+    created_at        timestamptz NOT NULL,
+    PRIMARY KEY (pending_action_id, approver),
+    CONSTRAINT fk_pending_action_approvals_pending_action_id FOREIGN KEY (pending_action_id) REFERENCES pending_actions(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pending_action_approvals;
+DROP INDEX IF EXISTS pending_actions_issuer_id_idx;
+DROP TABLE IF EXISTS pending_actions;
+-- +goose StatementEnd
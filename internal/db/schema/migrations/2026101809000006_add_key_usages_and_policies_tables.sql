@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE key_usages
+(
+    id         uuid        NOT NULL PRIMARY KEY,
+    key_id     text        NOT NULL,
+    key_type   text        NOT NULL,
+    issuer_id  text,
+    purpose    text        NOT NULL,
+    digest     text        NOT NULL,
+    status     text        NOT NULL,
+    reason     text,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX key_usages_key_id_idx ON key_usages(key_id, created_at);
+CREATE INDEX key_usages_issuer_id_idx ON key_usages(issuer_id, created_at);
+
+CREATE FUNCTION key_usages_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'key_usages is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER key_usages_append_only
+    BEFORE UPDATE OR DELETE ON key_usages
+    FOR EACH ROW EXECUTE FUNCTION key_usages_append_only();
+
+CREATE TABLE key_policies
+(
+    key_id            text        NOT NULL PRIMARY KEY,
+    issuer_id         text        NOT NULL,
+    allowed_purposes  text[]      NOT NULL,
+    rate_limit        integer     NOT NULL,
+    rate_limit_window bigint      NOT NULL,
+    created_at        timestamptz NOT NULL,
+    updated_at        timestamptz NOT NULL,
+    CONSTRAINT fk_key_policies_issuer_id FOREIGN KEY (issuer_id) REFERENCES public.identities(identifier) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS key_policies;
+DROP TRIGGER IF EXISTS key_usages_append_only ON key_usages;
+DROP FUNCTION IF EXISTS key_usages_append_only;
+DROP INDEX IF EXISTS key_usages_issuer_id_idx;
+DROP INDEX IF EXISTS key_usages_key_id_idx;
+DROP TABLE IF EXISTS key_usages;
+-- +goose StatementEnd
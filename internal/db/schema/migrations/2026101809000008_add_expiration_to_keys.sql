@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE keys ADD COLUMN expires_at timestamptz NULL;
+ALTER TABLE keys ADD COLUMN expiry_warned_at timestamptz NULL;
+ALTER TABLE keys ADD COLUMN expired_at timestamptz NULL;
+CREATE INDEX keys_expires_at_index ON keys (expires_at) WHERE expired_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS keys_expires_at_index;
+ALTER TABLE keys DROP COLUMN IF EXISTS expired_at;
+ALTER TABLE keys DROP COLUMN IF EXISTS expiry_warned_at;
+ALTER TABLE keys DROP COLUMN IF EXISTS expires_at;
+-- +goose StatementEnd
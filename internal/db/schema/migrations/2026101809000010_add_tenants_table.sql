@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tenants
+(
+    id                        uuid        NOT NULL PRIMARY KEY,
+    name                      text        NOT NULL UNIQUE,
+    max_identities            integer,
+    max_credentials_per_month integer,
+    created_at                timestamptz NOT NULL
+);
+
+ALTER TABLE identities ADD COLUMN tenant_id uuid REFERENCES tenants (id);
+CREATE INDEX identities_tenant_id_idx ON identities (tenant_id);
+
+ALTER TABLE api_keys ADD COLUMN tenant_id uuid REFERENCES tenants (id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE api_keys DROP COLUMN IF EXISTS tenant_id;
+DROP INDEX IF EXISTS identities_tenant_id_idx;
+ALTER TABLE identities DROP COLUMN IF EXISTS tenant_id;
+DROP TABLE IF EXISTS tenants;
+-- +goose StatementEnd
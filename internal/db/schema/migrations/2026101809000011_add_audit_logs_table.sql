@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_logs
+(
+    id            uuid        NOT NULL PRIMARY KEY,
+    sequence      bigint      NOT NULL UNIQUE,
+    actor         text        NOT NULL,
+    action        text        NOT NULL,
+    identifier    text,
+    resource      text        NOT NULL,
+    request_id    text        NOT NULL,
+    status_code   integer     NOT NULL,
+    outcome       text        NOT NULL,
+    created_at    timestamptz NOT NULL,
+    previous_hash bytea,
+    hash          bytea       NOT NULL
+);
+
+CREATE INDEX audit_logs_identifier_idx ON audit_logs(identifier, sequence);
+CREATE INDEX audit_logs_created_at_idx ON audit_logs(created_at);
+
+CREATE FUNCTION audit_logs_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_logs_no_update_delete BEFORE UPDATE OR DELETE ON audit_logs
+    FOR EACH ROW EXECUTE FUNCTION audit_logs_append_only();
+CREATE TRIGGER audit_logs_no_truncate BEFORE TRUNCATE ON audit_logs
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_logs_append_only();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS audit_logs_no_truncate ON audit_logs;
+DROP TRIGGER IF EXISTS audit_logs_no_update_delete ON audit_logs;
+DROP FUNCTION IF EXISTS audit_logs_append_only();
+DROP INDEX IF EXISTS audit_logs_created_at_idx;
+DROP INDEX IF EXISTS audit_logs_identifier_idx;
+DROP TABLE IF EXISTS audit_logs;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE webhooks
+(
+    id          uuid        NOT NULL PRIMARY KEY,
+    identifier  text        NOT NULL,
+    url         text        NOT NULL,
+    secret      text        NOT NULL,
+    events      text[]      NOT NULL,
+    description text,
+    created_at  timestamptz NOT NULL,
+    CONSTRAINT fk_webhooks_identifier FOREIGN KEY (identifier) REFERENCES public.identities(identifier) ON DELETE CASCADE
+);
+
+CREATE INDEX webhooks_identifier_idx ON webhooks(identifier);
+
+CREATE TABLE webhook_deliveries
+(
+    id               uuid        NOT NULL PRIMARY KEY,
+    webhook_id       uuid        NOT NULL,
+    event_type       text        NOT NULL,
+    payload          bytea       NOT NULL,
+    status           text        NOT NULL,
+    attempts         integer     NOT NULL DEFAULT 0,
+    next_attempt_at  timestamptz NOT NULL,
+    last_status_code integer,
+    last_error       text,
+    created_at       timestamptz NOT NULL,
+    delivered_at     timestamptz,
+    CONSTRAINT fk_webhook_deliveries_webhook_id FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE
+);
+
+CREATE INDEX webhook_deliveries_webhook_id_idx ON webhook_deliveries(webhook_id, created_at);
+CREATE INDEX webhook_deliveries_pending_idx ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS webhook_deliveries_pending_idx;
+DROP INDEX IF EXISTS webhook_deliveries_webhook_id_idx;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP INDEX IF EXISTS webhooks_identifier_idx;
+DROP TABLE IF EXISTS webhooks;
+-- +goose StatementEnd
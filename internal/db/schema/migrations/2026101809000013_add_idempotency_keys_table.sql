@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE idempotency_keys
+(
+    identifier   text        NOT NULL,
+    operation    text        NOT NULL,
+    key          text        NOT NULL,
+    request_hash text        NOT NULL,
+    status_code  integer,
+    response     bytea,
+    created_at   timestamptz NOT NULL,
+    expires_at   timestamptz NOT NULL,
+    PRIMARY KEY (identifier, operation, key),
+    CONSTRAINT fk_idempotency_keys_identifier FOREIGN KEY (identifier) REFERENCES public.identities(identifier) ON DELETE CASCADE
+);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys(expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idempotency_keys_expires_at_idx;
+DROP TABLE IF EXISTS idempotency_keys;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE proof_request_responses
+(
+    id               uuid        NOT NULL PRIMARY KEY,
+    proof_request_id uuid        NOT NULL,
+    user_id          text        NOT NULL,
+    connection_id    uuid,
+    results          jsonb       NOT NULL,
+    created_at       timestamptz NOT NULL,
+    CONSTRAINT fk_proof_request_responses_proof_request_id FOREIGN KEY (proof_request_id) REFERENCES public.proof_requests(id) ON DELETE CASCADE,
+    CONSTRAINT fk_proof_request_responses_connection_id FOREIGN KEY (connection_id) REFERENCES public.connections(id) ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX proof_request_responses_user_id_idx ON proof_request_responses(proof_request_id, user_id);
+
+INSERT INTO proof_request_responses (id, proof_request_id, user_id, connection_id, results, created_at)
+SELECT gen_random_uuid(), id, user_id, connection_id, COALESCE(results, '[]'::jsonb), COALESCE(verified_at, created_at)
+FROM proof_requests
+WHERE status = 'verified' AND user_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS proof_request_responses_user_id_idx;
+DROP TABLE IF EXISTS proof_request_responses;
+-- +goose StatementEnd
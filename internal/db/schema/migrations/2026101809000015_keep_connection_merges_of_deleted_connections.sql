@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE connection_merges DROP CONSTRAINT IF EXISTS fk_connection_merges_connection_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM connection_merges WHERE connection_id NOT IN (SELECT id FROM connections);
+ALTER TABLE connection_merges ADD CONSTRAINT fk_connection_merges_connection_id FOREIGN KEY (connection_id) REFERENCES public.connections(id) ON DELETE CASCADE;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pending_actions ADD COLUMN requested_by text;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pending_actions DROP COLUMN IF EXISTS requested_by;
+-- +goose StatementEnd
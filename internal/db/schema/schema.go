@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"embed"
 	"fmt"
+	"io/fs"
 
+	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/pressly/goose/v3"
 
 	"github.com/polygonid/sh-id-platform/internal/log"
@@ -39,3 +41,48 @@ func Migrate(databaseURL string) error {
 
 	return nil
 }
+
+// CheckMigrations returns an error when some of the migrations embedded in the node are not applied in the database
+func CheckMigrations(ctx context.Context, pool *pgxpool.Pool) error {
+	files, err := fs.Glob(embedMigrations, "migrations/*.sql")
+	if err != nil {
+		return fmt.Errorf("error listing migrations: %w", err)
+	}
+
+	rows, err := pool.Query(ctx, `SELECT DISTINCT ON (version_id) version_id, is_applied FROM goose_db_version ORDER BY version_id, id DESC`)
+	if err != nil {
+		return fmt.Errorf("error reading applied migrations: %w", err)
+	}
+	defer rows.Close()
+	applied := make(map[int64]bool)
+	for rows.Next() {
+		var version int64
+		var isApplied bool
+		if err := rows.Scan(&version, &isApplied); err != nil {
+			return fmt.Errorf("error reading applied migrations: %w", err)
+		}
+		applied[version] = isApplied
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error reading applied migrations: %w", err)
+	}
+
+	pending := 0
+	var first int64
+	for _, file := range files {
+		version, err := goose.NumericComponent(file)
+		if err != nil {
+			return fmt.Errorf("invalid migration %s: %w", file, err)
+		}
+		if !applied[version] {
+			if pending == 0 || version < first {
+				first = version
+			}
+			pending++
+		}
+	}
+	if pending > 0 {
+		return fmt.Errorf("%d migrations are not applied, the first one is %d", pending, first)
+	}
+	return nil
+}
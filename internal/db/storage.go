@@ -2,8 +2,14 @@ package db
 
 import (
 	"context"
+	"time"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/polygonid/sh-id-platform/internal/tracing"
 )
 
 // Storage defines the postgres storage
@@ -13,7 +19,13 @@ type Storage struct {
 
 // NewStorage creates and returns a new Pgx storage connection
 func NewStorage(connectionString string) (*Storage, error) {
-	pgxConn, err := pgxpool.Connect(context.Background(), connectionString)
+	cfg, err := pgxpool.ParseConfig(connectionString)
+	if err != nil {
+		return nil, err
+	}
+	cfg.ConnConfig.Logger = queryTracer{}
+	cfg.ConnConfig.LogLevel = pgx.LogLevelInfo
+	pgxConn, err := pgxpool.ConnectConfig(context.Background(), cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -32,3 +44,27 @@ func (s *Storage) Close() error {
 	s.Pgx.Close()
 	return nil
 }
+
+// queryTracer creates a span for each query of a traced request. pgx v4 has no tracing hooks, but it logs the
+// queries with their duration when they finish, so the spans are created afterwards with the start time.
+type queryTracer struct{}
+
+func (queryTracer) Log(ctx context.Context, _ pgx.LogLevel, msg string, data map[string]interface{}) {
+	switch msg {
+	case "Query", "Exec", "CopyFrom", "SendBatch":
+	default:
+		return
+	}
+	if !trace.SpanFromContext(ctx).IsRecording() {
+		return
+	}
+	elapsed, _ := data["time"].(time.Duration)
+	end := time.Now()
+	_, span := tracing.Start(ctx, "db."+msg, trace.WithTimestamp(end.Add(-elapsed)), trace.WithSpanKind(trace.SpanKindClient))
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	if sql, ok := data["sql"].(string); ok {
+		span.SetAttributes(attribute.String("db.statement", sql))
+	}
+	err, _ := data["err"].(error)
+	tracing.End(span, err, trace.WithTimestamp(end))
+}
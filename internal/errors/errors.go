@@ -1,6 +1,11 @@
 package errors
 
-import "net/http"
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+)
 
 // AuthError is a special error type used to signal an authorization error
 type AuthError struct {
@@ -12,6 +17,48 @@ func (a AuthError) Error() string {
 	return a.Err.Error()
 }
 
+// ForbiddenError is a special error type used to signal that the authenticated caller is not allowed to do the request
+type ForbiddenError struct {
+	Err error
+}
+
+// Error satisfies error interface for ForbiddenError
+func (f ForbiddenError) Error() string {
+	return f.Err.Error()
+}
+
+// TooManyRequestsError is a special error type used to signal that the caller exceeded a rate limit
+type TooManyRequestsError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+// Error satisfies error interface for TooManyRequestsError
+func (t TooManyRequestsError) Error() string {
+	return t.Err.Error()
+}
+
+// IdempotencyError is a special error type used to signal that a request cannot be served with its idempotency key,
+// like a key in use by a request in progress or a key reused with a different request
+type IdempotencyError struct {
+	Status int
+	Err    error
+}
+
+// Error satisfies error interface for IdempotencyError
+func (i IdempotencyError) Error() string {
+	return i.Err.Error()
+}
+
+// RetryAfterSeconds returns the value of the Retry-After header, the time until the caller can retry in whole seconds
+func RetryAfterSeconds(retryAfter time.Duration) int {
+	seconds := int((retryAfter + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
+
 // RequestErrorHandlerFunc is a Request Error Handler that can be injected in oapi-codegen to handler errors in requests
 func RequestErrorHandlerFunc(w http.ResponseWriter, _ *http.Request, err error) {
 	http.Error(w, err.Error(), http.StatusBadRequest)
@@ -21,11 +68,22 @@ func RequestErrorHandlerFunc(w http.ResponseWriter, _ *http.Request, err error)
 // We use it to create custom responses to some errors that may occur, like an authentication error.
 func ResponseErrorHandlerFunc(w http.ResponseWriter, _ *http.Request, err error) {
 	w.Header().Add("Content-Type", "application/json")
-	switch err.(type) {
+	switch e := err.(type) {
 	case AuthError:
 		w.WriteHeader(http.StatusUnauthorized)
 		w.Header().Add("WWW-Authenticate", `Basic realm="restricted", charset="UTF-8"`)
 		_, _ = w.Write([]byte("\"Unauthorized\""))
+	case ForbiddenError:
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("\"Forbidden\""))
+	case TooManyRequestsError:
+		w.Header().Set("Retry-After", strconv.Itoa(RetryAfterSeconds(e.RetryAfter)))
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"message":"too many requests"}`))
+	case IdempotencyError:
+		w.WriteHeader(e.Status)
+		body, _ := json.Marshal(map[string]string{"message": e.Error()})
+		_, _ = w.Write(body)
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 		_, _ = w.Write([]byte(err.Error()))
@@ -19,9 +19,12 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/iden3/contracts-abi/state/go/abi"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/tracing"
 )
 
 const (
@@ -110,6 +113,7 @@ func (c *Client) GetLatestStateByID(ctx context.Context, addr common.Address, id
 		latestState abi.IStateStateInfo
 		err         error
 	)
+	ctx, span := tracing.Start(ctx, "EthClient.GetLatestStateByID")
 	if err = c.Call(func(c *ethclient.Client) error {
 		stateContact, err := abi.NewState(addr, c)
 		if err != nil {
@@ -118,8 +122,10 @@ func (c *Client) GetLatestStateByID(ctx context.Context, addr common.Address, id
 		latestState, err = stateContact.GetStateInfoById(&bind.CallOpts{Context: ctx}, id)
 		return err
 	}); err != nil {
+		tracing.End(span, err)
 		return latestState, err
 	}
+	tracing.End(span, nil)
 	return latestState, nil
 }
 
@@ -308,12 +314,18 @@ func (c *Client) GetTransactionReceiptByID(ctx context.Context, txID string) (*t
 
 // WaitTransactionReceiptByID wait for transaction receipt
 func (c *Client) WaitTransactionReceiptByID(ctx context.Context, txID string) (*types.Receipt, error) {
-	return c.waitReceipt(ctx, common.HexToHash(txID), c.Config.ReceiptTimeout)
+	ctx, span := tracing.Start(ctx, "EthClient.WaitTransactionReceipt", trace.WithAttributes(attribute.String("eth.tx", txID)))
+	receipt, err := c.waitReceipt(ctx, common.HexToHash(txID), c.Config.ReceiptTimeout)
+	tracing.End(span, err)
+	return receipt, err
 }
 
 // WaitForBlock wait for eth block
 func (c *Client) WaitForBlock(ctx context.Context, confirmationBlock *big.Int) error {
-	return c.waitBlock(ctx, c.Config.ConfirmationTimeout, confirmationBlock)
+	ctx, span := tracing.Start(ctx, "EthClient.WaitForBlock", trace.WithAttributes(attribute.String("eth.block", confirmationBlock.String())))
+	err := c.waitBlock(ctx, c.Config.ConfirmationTimeout, confirmationBlock)
+	tracing.End(span, err)
+	return err
 }
 
 // GetTransactionByID return the transaction by ID
@@ -321,15 +333,15 @@ func (c *Client) GetTransactionByID(ctx context.Context, txID string) (*types.Tr
 	return c.client.TransactionByHash(ctx, common.HexToHash(txID))
 }
 
-// CreateTxOpts creates a new transaction signer
-func (c *Client) CreateTxOpts(ctx context.Context, kmsKey kms.KeyID) (*bind.TransactOpts, error) {
+// CreateTxOpts creates a new transaction signer. Transactions are signed by the kms key for the given purpose.
+func (c *Client) CreateTxOpts(ctx context.Context, kmsKey kms.KeyID, purpose kms.SignPurpose) (*bind.TransactOpts, error) {
 	//nolint:all
 	addr, err := c.getAddress(kmsKey)
 	if err != nil {
 		return nil, err
 	}
 
-	sigFn := c.signerFnFactory(ctx, kmsKey)
+	sigFn := c.signerFnFactory(ctx, kmsKey, purpose)
 
 	opts := &bind.TransactOpts{
 		From:   addr,
@@ -464,9 +476,12 @@ func (c *Client) CreateRawTx(ctx context.Context, txParams TransactionParams) (*
 
 // SendRawTx send raw transaction.
 func (c *Client) SendRawTx(ctx context.Context, tx *types.Transaction) error {
+	ctx, span := tracing.Start(ctx, "EthClient.SendRawTx", trace.WithAttributes(attribute.String("eth.tx", tx.Hash().Hex())))
 	_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
 	defer cancel()
-	return c.client.SendTransaction(_ctx, tx)
+	err := c.client.SendTransaction(_ctx, tx)
+	tracing.End(span, err)
+	return err
 }
 
 // getGasPrice returns suggested gas price within configured bounds
@@ -514,6 +529,11 @@ func (c *Client) getGasPrice(ctx context.Context) (*big.Int, error) {
 	return gasPrice, err
 }
 
+// Address returns the ethereum address of the key k
+func (c *Client) Address(k kms.KeyID) (common.Address, error) {
+	return c.getAddress(k)
+}
+
 // getAddress - get address by keyID
 func (c *Client) getAddress(k kms.KeyID) (common.Address, error) {
 	if c.kms == nil {
@@ -542,7 +562,7 @@ func (c *Client) getAddress(k kms.KeyID) (common.Address, error) {
 	return fromAddress, nil
 }
 
-func (c *Client) signerFnFactory(ctx context.Context, signingKeyID kms.KeyID) func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+func (c *Client) signerFnFactory(ctx context.Context, signingKeyID kms.KeyID, purpose kms.SignPurpose) func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
 	return func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
 		if c.kms == nil {
 			return nil, errors.Join(errors.New("the signer is read-only"))
@@ -556,7 +576,7 @@ func (c *Client) signerFnFactory(ctx context.Context, signingKeyID kms.KeyID) fu
 		signer := types.LatestSignerForChainID(ch)
 		h := signer.Hash(tx)
 
-		sig, err := c.kms.Sign(ctx, signingKeyID, h[:])
+		sig, err := c.kms.Sign(ctx, signingKeyID, purpose, h[:])
 		if err != nil {
 			return nil, err
 		}
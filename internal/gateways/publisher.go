@@ -16,6 +16,8 @@ import (
 	"github.com/iden3/go-merkletree-sql/v2"
 	rstypes "github.com/iden3/go-rapidsnark/types"
 	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
@@ -24,9 +26,11 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/metrics"
 	"github.com/polygonid/sh-id-platform/internal/network"
 	"github.com/polygonid/sh-id-platform/internal/pubsub"
 	"github.com/polygonid/sh-id-platform/internal/syncttlmap"
+	"github.com/polygonid/sh-id-platform/internal/tracing"
 )
 
 type jobIDType string
@@ -85,7 +89,10 @@ func NewPublisher(storage *db.Storage, identityService ports.IdentityService, cl
 	}
 }
 
-func (p *publisher) PublishState(ctx context.Context, identifier *w3c.DID) (*domain.PublishedState, error) {
+func (p *publisher) PublishState(ctx context.Context, identifier *w3c.DID) (_ *domain.PublishedState, err error) {
+	ctx, span := tracing.Start(ctx, "Publisher.PublishState", trace.WithAttributes(attribute.String("identity", identifier.String())))
+	defer func() { tracing.End(span, err) }()
+
 	idStr := identifier.String()
 	processingEntity := p.pendingTransactions.Load(idStr)
 	if processingEntity != nil {
@@ -101,7 +108,10 @@ func (p *publisher) PublishState(ctx context.Context, identifier *w3c.DID) (*dom
 	return newState, err
 }
 
-func (p *publisher) RetryPublishState(ctx context.Context, identifier *w3c.DID) (*domain.PublishedState, error) {
+func (p *publisher) RetryPublishState(ctx context.Context, identifier *w3c.DID) (_ *domain.PublishedState, err error) {
+	ctx, span := tracing.Start(ctx, "Publisher.RetryPublishState", trace.WithAttributes(attribute.String("identity", identifier.String())))
+	defer func() { tracing.End(span, err) }()
+
 	idStr := identifier.String()
 	processingEntity := p.pendingTransactions.Load(idStr)
 	if processingEntity != nil {
@@ -130,13 +140,16 @@ func (p *publisher) publishState(ctx context.Context, identifier *w3c.DID) (*dom
 	}
 
 	// 4. Calculate new states and publish them synchronously
+	start := time.Now()
 	updatedState, err := p.identityService.UpdateState(ctx, *identifier)
 	if err != nil {
 		log.Error(ctx, "Error during processing claims", "err", err, "did", identifier.String())
+		metrics.ObserveStatePublish(start, err)
 		return nil, err
 	}
 
 	txID, err := p.publishProof(ctx, identifier, *updatedState)
+	metrics.ObserveStatePublish(start, err)
 	if err != nil {
 		// TODO: Handle RHS status already published
 		log.Error(ctx, "Error during publishing proof:", "err", err, "did", identifier.String())
@@ -146,6 +159,7 @@ func (p *publisher) publishState(ctx context.Context, identifier *w3c.DID) (*dom
 			log.Error(ctx, "Error saving the state as failed:", "err", err, "did", identifier.String())
 			return nil, errUpdating
 		}
+		p.publishStateEvent(ctx, event.StateFailedEvent, updatedState)
 		return nil, err
 	}
 
@@ -170,7 +184,9 @@ func (p *publisher) retryPublishFailedState(ctx context.Context, identifier *w3c
 		return nil, ErrNoFailedStatesToProcess
 	}
 
+	start := time.Now()
 	txID, err := p.publishProof(ctx, identifier, *failedState)
+	metrics.ObserveStatePublish(start, err)
 	if err != nil {
 		log.Error(ctx, "Error during publishing proof:", "err", err, "did", identifier.String())
 		return nil, err
@@ -255,7 +271,7 @@ func (p *publisher) publishProof(ctx context.Context, identifier *w3c.DID, newSt
 		}
 
 		sigDigest := kms.BJJDigest(hashOldAndNewStates)
-		sigBytes, err := p.kms.Sign(ctx, claimKeyID, sigDigest)
+		sigBytes, err := p.kms.Sign(ctx, claimKeyID, kms.SignPurposeStateTransition, sigDigest)
 		if err != nil {
 			return nil, err
 		}
@@ -310,15 +326,18 @@ func (p *publisher) publishProof(ctx context.Context, identifier *w3c.DID, newSt
 	if err != nil {
 		return nil, err
 	}
+	newState.ModifiedAt = time.Now() // as set by the database trigger, to measure the confirmation time
+	p.publishStateEvent(ctx, event.StatePublishedEvent, &newState)
 
-	// add go routine that will listen for transaction status update
+	// add go routine that will listen for transaction status update. It outlives the request that published the
+	// state, so it keeps its trace and log attributes but not its cancellation
 
 	go func(ctx context.Context) {
 		if err := p.updateTransactionStatus(ctx, identity, newState, *txID); err != nil {
 			log.Error(ctx, "cannot update transaction status", "err", err)
 		}
 		p.pendingTransactions.Delete(identifier.String())
-	}(ctx)
+	}(context.WithoutCancel(ctx))
 
 	return txID, nil
 }
@@ -439,6 +458,13 @@ func (p *publisher) updateIdentityStateTxStatus(ctx context.Context, identity *d
 	blockTime := int(header.Time)
 	state.BlockTimestamp = &blockTime
 
+	network, err := identity.GetResolverPrefix()
+	if err != nil {
+		network = "unknown"
+	}
+	metrics.AddStateGas(network, receipt.GasUsed, receipt.EffectiveGasPrice)
+	metrics.ObserveStateConfirmation(network, state.ModifiedAt, receipt.Status == types.ReceiptStatusSuccessful)
+
 	if receipt.Status == types.ReceiptStatusSuccessful {
 		state.Status = domain.StatusConfirmed
 		err = p.claimService.UpdateClaimsMTPAndState(ctx, state)
@@ -463,13 +489,14 @@ func (p *publisher) updateIdentityStateTxStatus(ctx context.Context, identity *d
 			}
 		}
 
-		if err = p.notificationPublisher.Publish(ctx, event.CreateStateEvent, &event.CreateState{State: *state.State}); err != nil {
-			log.Error(ctx, "publish EventCreateState", "err", err.Error(), "state", *state.State)
-		}
+		p.publishStateEvent(ctx, event.CreateStateEvent, state)
 
 	} else {
 		state.Status = domain.StatusFailed
 		err = p.identityService.UpdateIdentityState(ctx, state)
+		if err == nil {
+			p.publishStateEvent(ctx, event.StateFailedEvent, state)
+		}
 	}
 
 	if err != nil {
@@ -480,6 +507,17 @@ func (p *publisher) updateIdentityStateTxStatus(ctx context.Context, identity *d
 	return nil
 }
 
+// publishStateEvent publishes a state event. Errors are only logged because the state is already updated.
+func (p *publisher) publishStateEvent(ctx context.Context, topic string, state *domain.IdentityState) {
+	if state.State == nil {
+		return
+	}
+	ev := &event.CreateState{State: *state.State, IssuerID: state.Identifier, TxID: state.TxID}
+	if err := p.notificationPublisher.Publish(ctx, topic, ev); err != nil {
+		log.Error(ctx, "publish state event", "err", err.Error(), "event", topic, "state", *state.State)
+	}
+}
+
 // groupByUserId - groups claims by user id
 func groupByUserId(claims []*domain.Claim) map[string][]string {
 	grouped := make(map[string][]string)
@@ -504,6 +542,7 @@ func (p *publisher) CheckTransactionStatus(ctx context.Context, identity *domain
 		log.Error(ctx, "Error during get transacted states", "err", err)
 		return
 	}
+	metrics.SetPendingStateTransactions(len(states))
 	// we shouldn't process states which go routines are still in progress
 
 	var toCheck []domain.IdentityState
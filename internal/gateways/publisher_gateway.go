@@ -93,7 +93,7 @@ func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *w3c
 			return nil, err
 		}
 
-		opts, err := client.CreateTxOpts(ctxWT, sigKeyID)
+		opts, err := client.CreateTxOpts(ctxWT, sigKeyID, kms.SignPurposeStateTransition)
 		if err != nil {
 			log.Error(ctx, "failed to create tx opts", "err", err)
 			return nil, err
@@ -127,7 +127,7 @@ func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *w3c
 			return nil, err
 		}
 
-		opts, err := client.CreateTxOpts(ctxWT, pb.publishingKeyID)
+		opts, err := client.CreateTxOpts(ctxWT, pb.publishingKeyID, kms.SignPurposeStateTransition)
 		if err != nil {
 			log.Error(ctx, "failed to create tx opts", "err", err)
 			return nil, err
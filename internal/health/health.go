@@ -2,19 +2,27 @@ package health
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
 	"sync"
 	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
 )
 
 const (
 	DefaultPingPeriod = 5 * time.Second // DefaultPingPeriod is a recommendation to ping any service
 )
 
+// errNotChecked is the error of the services that have not been checked yet
+const errNotChecked = "not checked yet"
+
 // Status struct
 type Status struct {
 	sync.RWMutex
-	monitors     Monitors
-	lastStatuses map[string]bool
+	monitors   Monitors
+	critical   map[string]bool
+	lastChecks map[string]Check
 }
 
 // Pinger is a function that return error if cannot ping. False otherwise
@@ -23,23 +31,55 @@ type Pinger func(ctx context.Context) error
 // Monitors represents a map of Pingers identified by it's human name
 type Monitors map[string]Pinger
 
-// New returns a Health instance
-func New(m Monitors) *Status {
+// Check is the result of the last ping of a service. Only the critical services affect the readiness.
+type Check struct {
+	Healthy   bool      `json:"healthy"`
+	Critical  bool      `json:"critical"`
+	LatencyMs float64   `json:"latencyMs"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+// Report is the readiness of a service and the result of each of its checks. A ready service is degraded when some
+// of its non critical checks fail.
+type Report struct {
+	Ready    bool             `json:"ready"`
+	Degraded bool             `json:"degraded"`
+	Checks   map[string]Check `json:"checks"`
+}
+
+// New returns a Health instance. The critical services, like the database, must be healthy for the service to be
+// ready. The degraded ones, like the RPC of a network, only affect some operations and are just reported.
+func New(critical Monitors, degraded Monitors) *Status {
+	monitors := make(Monitors, len(critical)+len(degraded))
+	criticals := make(map[string]bool, len(critical))
+	checks := make(map[string]Check, len(critical)+len(degraded))
+	for service, ping := range degraded {
+		monitors[service] = ping
+		checks[service] = Check{Error: errNotChecked}
+	}
+	for service, ping := range critical {
+		monitors[service] = ping
+		criticals[service] = true
+		checks[service] = Check{Critical: true, Error: errNotChecked}
+	}
 	return &Status{
-		monitors:     m,
-		lastStatuses: make(map[string]bool, len(m)),
+		monitors:   monitors,
+		critical:   criticals,
+		lastChecks: checks,
 	}
 }
 
-// Run starts a monitor that will check each service every t duration.
+// Run starts a monitor that will check each service every t duration. The services are checked at the same time
+// and each ping times out after t.
 func (s *Status) Run(ctx context.Context, t time.Duration) {
 	go func() {
 		timer := time.NewTicker(t)
-		s.checkStatus(ctx)
+		s.checkStatus(ctx, t)
 		for {
 			select {
 			case <-timer.C:
-				s.checkStatus(ctx)
+				s.checkStatus(ctx, t)
 			case <-ctx.Done():
 				return
 			}
@@ -52,13 +92,87 @@ func (s *Status) Run(ctx context.Context, t time.Duration) {
 func (s *Status) Status() map[string]bool {
 	s.RLock()
 	defer s.RUnlock()
-	return s.lastStatuses
+	statuses := make(map[string]bool, len(s.lastChecks))
+	for service, check := range s.lastChecks {
+		statuses[service] = check.Healthy
+	}
+	return statuses
 }
 
-func (s *Status) checkStatus(ctx context.Context) {
-	s.Lock()
-	defer s.Unlock()
+// Report returns the last check of each service. The service is ready when all the critical ones are healthy.
+func (s *Status) Report() Report {
+	s.RLock()
+	defer s.RUnlock()
+	report := Report{Ready: true, Checks: make(map[string]Check, len(s.lastChecks))}
+	for service, check := range s.lastChecks {
+		report.Checks[service] = check
+		if check.Critical {
+			report.Ready = report.Ready && check.Healthy
+		} else {
+			report.Degraded = report.Degraded || !check.Healthy
+		}
+	}
+	return report
+}
+
+// LiveHandler returns the handler of the liveness endpoint. It only tells that the process is serving requests,
+// the dependencies are checked by the readiness endpoint.
+func LiveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+}
+
+// ReadyHandler returns the handler of the readiness endpoint. It answers 503 when any critical check is failing.
+func (s *Status) ReadyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		report := s.Report()
+		status := http.StatusOK
+		if !report.Ready {
+			status = http.StatusServiceUnavailable
+		}
+		writeJSON(w, status, report)
+	})
+}
+
+func (s *Status) checkStatus(ctx context.Context, timeout time.Duration) {
+	var wg sync.WaitGroup
 	for service, ping := range s.monitors {
-		s.lastStatuses[service] = ping(ctx) == nil
+		wg.Add(1)
+		go func(service string, ping Pinger) {
+			defer wg.Done()
+			check := runCheck(ctx, ping, timeout)
+			check.Critical = s.critical[service]
+			s.Lock()
+			previous := s.lastChecks[service]
+			s.lastChecks[service] = check
+			s.Unlock()
+			if !check.Healthy && check.Error != previous.Error {
+				log.Warn(ctx, "health check failed", "service", service, "critical", check.Critical, "err", check.Error)
+			}
+		}(service, ping)
 	}
+	wg.Wait()
+}
+
+func runCheck(ctx context.Context, ping Pinger, timeout time.Duration) Check {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	start := time.Now()
+	err := ping(ctx)
+	check := Check{
+		Healthy:   err == nil,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+		CheckedAt: start.UTC(),
+	}
+	if err != nil {
+		check.Error = err.Error()
+	}
+	return check
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
 }
@@ -0,0 +1,92 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatus(t *testing.T) {
+	status := New(Monitors{
+		"postgres": func(context.Context) error { return nil },
+		"kms:BJJ:vault": func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}, Monitors{
+		"network:polygon:amoy": func(context.Context) error {
+			return errors.New("latest block 51234 is 7m0s old")
+		},
+	})
+
+	t.Run("should not be ready before the first check", func(t *testing.T) {
+		report := status.Report()
+		assert.False(t, report.Ready)
+		assert.Equal(t, errNotChecked, report.Checks["postgres"].Error)
+	})
+
+	status.checkStatus(context.Background(), 50*time.Millisecond)
+
+	t.Run("should report each check", func(t *testing.T) {
+		assert.Equal(t, map[string]bool{"postgres": true, "network:polygon:amoy": false, "kms:BJJ:vault": false}, status.Status())
+		report := status.Report()
+		assert.False(t, report.Ready)
+		assert.True(t, report.Degraded)
+		assert.True(t, report.Checks["postgres"].Healthy)
+		assert.True(t, report.Checks["postgres"].Critical)
+		assert.Empty(t, report.Checks["postgres"].Error)
+		assert.False(t, report.Checks["network:polygon:amoy"].Critical)
+		assert.Equal(t, "latest block 51234 is 7m0s old", report.Checks["network:polygon:amoy"].Error)
+		assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks["kms:BJJ:vault"].Error)
+		assert.GreaterOrEqual(t, report.Checks["kms:BJJ:vault"].LatencyMs, float64(50))
+	})
+
+	t.Run("should answer 503 when not ready", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		status.ReadyHandler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		var report Report
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &report))
+		assert.False(t, report.Ready)
+		assert.Len(t, report.Checks, 3)
+	})
+}
+
+func TestStatus_Degraded(t *testing.T) {
+	status := New(Monitors{
+		"postgres": func(context.Context) error { return nil },
+	}, Monitors{
+		"balance:polygon:amoy": func(context.Context) error { return errors.New("balance is 0 wei") },
+	})
+	status.checkStatus(context.Background(), time.Second)
+
+	report := status.Report()
+	assert.True(t, report.Ready)
+	assert.True(t, report.Degraded)
+
+	rr := httptest.NewRecorder()
+	status.ReadyHandler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestHandlers(t *testing.T) {
+	status := New(Monitors{"postgres": func(context.Context) error { return nil }}, nil)
+	status.checkStatus(context.Background(), time.Second)
+
+	rr := httptest.NewRecorder()
+	status.ReadyHandler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"ready":true`)
+
+	rr = httptest.NewRecorder()
+	LiveHandler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/health/live", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, rr.Body.String())
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/tracing"
 )
 
 // DefaultHTTPClientWithRetry http client with retry behavior.
@@ -25,8 +26,9 @@ type Client struct {
 	base http.Client
 }
 
-// NewClient returns new instance of custom client
+// NewClient returns new instance of custom client. The requests are traced.
 func NewClient(c http.Client) *Client {
+	c.Transport = tracing.Transport(c.Transport)
 	return &Client{
 		base: c,
 	}
@@ -36,7 +38,7 @@ func NewClient(c http.Client) *Client {
 func (c *Client) Post(ctx context.Context, url string, req []byte) ([]byte, error) {
 	reqBody := bytes.NewBuffer(req)
 
-	request, err := http.NewRequest(http.MethodPost, url, reqBody)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +71,7 @@ func addRequestIDToHeader(ctx context.Context, r *http.Request) {
 
 // executeRequest contains common logic of request execution
 func executeRequest(ctx context.Context, c *Client, r *http.Request) ([]byte, error) {
-	log.With("method", r.Method, "uri", r.RequestURI)
+	ctx = log.With(ctx, "method", r.Method, "url", r.URL.Redacted())
 	resp, err := c.base.Do(r)
 	if err != nil {
 		log.Error(ctx, "http request", "err", err)
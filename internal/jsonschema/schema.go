@@ -12,9 +12,12 @@ import (
 	"github.com/iden3/go-schema-processor/v2/processor"
 	"github.com/mitchellh/mapstructure"
 	"github.com/piprate/json-gold/ld"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/loader"
+	"github.com/polygonid/sh-id-platform/internal/tracing"
 )
 
 const (
@@ -56,7 +59,10 @@ type JSONSchema struct {
 }
 
 // Load loads the json file
-func Load(ctx context.Context, jsonSchemaURL string, loader loader.DocumentLoader) (*JSONSchema, error) {
+func Load(ctx context.Context, jsonSchemaURL string, loader loader.DocumentLoader) (_ *JSONSchema, err error) {
+	ctx, span := tracing.Start(ctx, "Loader.LoadSchema", trace.WithAttributes(attribute.String("url", jsonSchemaURL)))
+	defer func() { tracing.End(span, err) }()
+
 	pr := processor.InitProcessorOptions(
 		&processor.Processor{},
 		processor.WithValidator(jsonSuite.Validator{}),
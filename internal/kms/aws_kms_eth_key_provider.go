@@ -2,6 +2,11 @@ package kms
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -42,26 +47,10 @@ type AwKmsEthKeyProviderConfig struct {
 func NewAwsKMSEthKeyProvider(ctx context.Context, keyType KeyType, issuerETHTransferKeyPath string, awsKmsEthKeyProviderConfig AwKmsEthKeyProviderConfig) (KeyProvider, error) {
 	keyTypeRE := regexp.QuoteMeta(string(keyType))
 	reIdenKeyPathHex := regexp.MustCompile("^(?i).*/" + keyTypeRE + ":([a-f0-9]{64})$")
-	cfg, err := config.LoadDefaultConfig(
-		ctx,
-		config.WithRegion(awsKmsEthKeyProviderConfig.Region),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(awsKmsEthKeyProviderConfig.AccessKey,
-			awsKmsEthKeyProviderConfig.SecretKey, "")),
-	)
+	svc, err := newAwsKmsClient(ctx, awsKmsEthKeyProviderConfig)
 	if err != nil {
-		return nil, fmt.Errorf("unable to load SDK config, %v", err)
-	}
-
-	var options []func(*kms.Options)
-	if strings.ToLower(awsKmsEthKeyProviderConfig.Region) == "local" {
-		options = make([]func(*kms.Options), 1)
-		options[0] = func(o *kms.Options) {
-			o.BaseEndpoint = aws.String(awsKmsEthKeyProviderConfig.URL)
-			o.Region = "us-east-1"
-		}
+		return nil, err
 	}
-
-	svc := kms.NewFromConfig(cfg, options...)
 	return &awsKmsEthKeyProvider{
 		keyType:                  keyType,
 		reIdenKeyPathHex:         reIdenKeyPathHex,
@@ -216,15 +205,14 @@ func (awsKeyProv *awsKmsEthKeyProvider) ListByIdentity(ctx context.Context, iden
 			continue
 		}
 
-		for _, tag := range tagOutput.Tags {
-			if aws.ToString(tag.TagKey) == "did" && aws.ToString(tag.TagValue) == identity.String() {
-				id := "ETH/" + *key.KeyId
-				keysToReturn = append(keysToReturn, KeyID{
-					Type: KeyTypeEthereum,
-					ID:   aws.ToString(&id),
-				})
-			}
+		tags := awsKmsTags(tagOutput.Tags)
+		if tags["did"] != identity.String() || (tags["keyType"] != "" && tags["keyType"] != string(KeyTypeEthereum)) {
+			continue
 		}
+		keysToReturn = append(keysToReturn, KeyID{
+			Type: KeyTypeEthereum,
+			ID:   awsKmdKeyIDPrefix + aws.ToString(key.KeyId),
+		})
 	}
 
 	return keysToReturn, nil
@@ -261,6 +249,99 @@ func (awsKeyProv *awsKmsEthKeyProvider) Exists(ctx context.Context, keyID KeyID)
 	return true, nil
 }
 
+func (awsKeyProv *awsKmsEthKeyProvider) importKeyID(identity *w3c.DID, keyID KeyID, _ []byte) string {
+	if identity == nil {
+		return keyID.ID
+	}
+	return ""
+}
+
+// importKey creates a key with external origin and imports the private key as its key material. Keys bound to
+// the identity are tagged like LinkToIdentity does and unbound keys get their id as alias.
+func (awsKeyProv *awsKmsEthKeyProvider) importKey(ctx context.Context, identity *w3c.DID, keyID KeyID, privateKey []byte) (KeyID, error) {
+	keyMaterial, err := secp256k1PKCS8(privateKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+
+	key, err := awsKeyProv.kmsClient.CreateKey(ctx, &kms.CreateKeyInput{
+		KeySpec:     types.KeySpecEccSecgP256k1,
+		KeyUsage:    types.KeyUsageTypeSignVerify,
+		Origin:      types.OriginTypeExternal,
+		Description: aws.String("Key imported by issuer node"),
+	})
+	if err != nil {
+		return KeyID{}, fmt.Errorf("failed to create key: %w", err)
+	}
+
+	params, err := awsKeyProv.kmsClient.GetParametersForImport(ctx, &kms.GetParametersForImportInput{
+		KeyId:             key.KeyMetadata.KeyId,
+		WrappingAlgorithm: types.AlgorithmSpecRsaesOaepSha256,
+		WrappingKeySpec:   types.WrappingKeySpecRsa2048,
+	})
+	if err != nil {
+		return KeyID{}, fmt.Errorf("failed to get parameters for import: %w", err)
+	}
+	wrappingKey, err := x509.ParsePKIXPublicKey(params.PublicKey)
+	if err != nil {
+		return KeyID{}, fmt.Errorf("failed to parse wrapping key: %w", err)
+	}
+	rsaKey, ok := wrappingKey.(*rsa.PublicKey)
+	if !ok {
+		return KeyID{}, errors.New("unexpected wrapping key type")
+	}
+	encryptedKeyMaterial, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, rsaKey, keyMaterial, nil)
+	if err != nil {
+		return KeyID{}, fmt.Errorf("failed to wrap key material: %w", err)
+	}
+
+	_, err = awsKeyProv.kmsClient.ImportKeyMaterial(ctx, &kms.ImportKeyMaterialInput{
+		KeyId:                key.KeyMetadata.KeyId,
+		EncryptedKeyMaterial: encryptedKeyMaterial,
+		ImportToken:          params.ImportToken,
+		ExpirationModel:      types.ExpirationModelTypeKeyMaterialDoesNotExpire,
+	})
+	if err != nil {
+		return KeyID{}, fmt.Errorf("failed to import key material: %w", err)
+	}
+
+	if identity == nil {
+		_, err = awsKeyProv.kmsClient.CreateAlias(ctx, &kms.CreateAliasInput{
+			AliasName:   aws.String(aliasPrefix + keyID.ID),
+			TargetKeyId: key.KeyMetadata.Arn,
+		})
+		if err != nil {
+			return KeyID{}, fmt.Errorf("failed to create alias: %w", err)
+		}
+		return keyID, nil
+	}
+
+	return awsKeyProv.LinkToIdentity(ctx, KeyID{Type: awsKeyProv.keyType, ID: awsKmdKeyIDPrefix + *key.KeyMetadata.KeyId}, *identity)
+}
+
+// newAwsKmsClient returns an AWS KMS client, the region "local" uses the configured url (localstack)
+func newAwsKmsClient(ctx context.Context, awsKmsConfig AwKmsEthKeyProviderConfig) (*kms.Client, error) {
+	cfg, err := config.LoadDefaultConfig(
+		ctx,
+		config.WithRegion(awsKmsConfig.Region),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(awsKmsConfig.AccessKey,
+			awsKmsConfig.SecretKey, "")),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load SDK config, %v", err)
+	}
+
+	var options []func(*kms.Options)
+	if strings.ToLower(awsKmsConfig.Region) == "local" {
+		options = make([]func(*kms.Options), 1)
+		options[0] = func(o *kms.Options) {
+			o.BaseEndpoint = aws.String(awsKmsConfig.URL)
+			o.Region = "us-east-1"
+		}
+	}
+	return kms.NewFromConfig(cfg, options...), nil
+}
+
 // getKeyInfo returns key metadata by key id
 func (awsKeyProv *awsKmsEthKeyProvider) getKeyInfo(ctx context.Context, keyID string) (*types.KeyMetadata, error) {
 	aliasInput := &kms.DescribeKeyInput{
@@ -274,6 +355,15 @@ func (awsKeyProv *awsKmsEthKeyProvider) getKeyInfo(ctx context.Context, keyID st
 	return aliasOutput.KeyMetadata, nil
 }
 
+// awsKmsTags returns the tags of a key by tag key
+func awsKmsTags(tags []types.Tag) map[string]string {
+	result := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		result[aws.ToString(tag.TagKey)] = aws.ToString(tag.TagValue)
+	}
+	return result
+}
+
 func getAwsKmsKeyID(keyID KeyID) (string, error) {
 	keyIDParts := strings.Split(keyID.ID, awsKmdKeyIDPrefix)
 	if len(keyIDParts) != awsKmsKeyIDParts {
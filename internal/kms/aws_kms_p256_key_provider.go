@@ -0,0 +1,190 @@
+package kms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const awsKmsP256KeyIDPrefix = "P256/"
+
+type awsKmsP256KeyProvider struct {
+	keyType   KeyType
+	kmsClient *kms.Client
+}
+
+// NewAwsKMSP256KeyProvider - creates new key provider for P-256 keys stored in AWS KMS
+func NewAwsKMSP256KeyProvider(ctx context.Context, keyType KeyType, awsKmsConfig AwKmsEthKeyProviderConfig) (KeyProvider, error) {
+	svc, err := newAwsKmsClient(ctx, awsKmsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &awsKmsP256KeyProvider{
+		keyType:   keyType,
+		kmsClient: svc,
+	}, nil
+}
+
+func (awsKeyProv *awsKmsP256KeyProvider) New(identity *w3c.DID) (KeyID, error) {
+	ctx := context.Background()
+	key, err := awsKeyProv.kmsClient.CreateKey(ctx, &kms.CreateKeyInput{
+		KeySpec:     types.KeySpecEccNistP256,
+		KeyUsage:    types.KeyUsageTypeSignVerify,
+		Origin:      types.OriginTypeAwsKms,
+		Description: aws.String("Key from issuer node"),
+	})
+	if err != nil {
+		log.Error(ctx, "failed to create key", "err", err)
+		return KeyID{}, fmt.Errorf("failed to create key: %w", err)
+	}
+
+	keyID := KeyID{Type: awsKeyProv.keyType, ID: awsKmsP256KeyIDPrefix + aws.ToString(key.KeyMetadata.KeyId)}
+	if identity != nil {
+		return awsKeyProv.LinkToIdentity(ctx, keyID, *identity)
+	}
+	return keyID, nil
+}
+
+// PublicKey returns the compressed public key
+func (awsKeyProv *awsKmsP256KeyProvider) PublicKey(keyID KeyID) ([]byte, error) {
+	keyIDStr, err := awsKeyProv.awsKeyID(keyID)
+	if err != nil {
+		return nil, err
+	}
+	result, err := awsKeyProv.kmsClient.GetPublicKey(context.Background(), &kms.GetPublicKeyInput{
+		KeyId: aws.String(keyIDStr),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key: %w", err)
+	}
+	pub, err := DecodeP256PubKey(result.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return CompressP256PubKey(pub), nil
+}
+
+// Sign signs the digest and returns the r||s signature
+func (awsKeyProv *awsKmsP256KeyProvider) Sign(ctx context.Context, keyID KeyID, data []byte) ([]byte, error) {
+	keyIDStr, err := awsKeyProv.awsKeyID(keyID)
+	if err != nil {
+		return nil, err
+	}
+	result, err := awsKeyProv.kmsClient.Sign(ctx, &kms.SignInput{
+		KeyId:            aws.String(keyIDStr),
+		Message:          data,
+		MessageType:      types.MessageTypeDigest,
+		SigningAlgorithm: types.SigningAlgorithmSpecEcdsaSha256,
+	})
+	if err != nil {
+		log.Error(ctx, "failed to sign payload", "err", err)
+		return nil, fmt.Errorf("failed to sign payload: %w", err)
+	}
+	return p256SignatureFromDER(result.Signature)
+}
+
+// LinkToIdentity tags the key with the identity, the key id does not change
+func (awsKeyProv *awsKmsP256KeyProvider) LinkToIdentity(ctx context.Context, keyID KeyID, identity w3c.DID) (KeyID, error) {
+	keyIDStr, err := awsKeyProv.awsKeyID(keyID)
+	if err != nil {
+		return KeyID{}, err
+	}
+
+	_, err = awsKeyProv.kmsClient.TagResource(ctx, &kms.TagResourceInput{
+		KeyId: aws.String(keyIDStr),
+		Tags: []types.Tag{
+			{
+				TagKey:   aws.String("keyType"),
+				TagValue: aws.String(string(awsKeyProv.keyType)),
+			},
+			{
+				TagKey:   aws.String("did"),
+				TagValue: aws.String(identity.String()),
+			},
+		},
+	})
+	if err != nil {
+		log.Error(ctx, "failed to tag resource", "err", err)
+		return KeyID{}, fmt.Errorf("failed to tag resource: %w", err)
+	}
+	return keyID, nil
+}
+
+// ListByIdentity returns the P-256 keys tagged with the identity
+func (awsKeyProv *awsKmsP256KeyProvider) ListByIdentity(ctx context.Context, identity w3c.DID) ([]KeyID, error) {
+	const limit = 500
+	listKeysOutput, err := awsKeyProv.kmsClient.ListKeys(ctx, &kms.ListKeysInput{
+		Limit: aws.Int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list keys: %w", err)
+	}
+
+	keysToReturn := make([]KeyID, 0)
+	for _, key := range listKeysOutput.Keys {
+		tagOutput, err := awsKeyProv.kmsClient.ListResourceTags(ctx, &kms.ListResourceTagsInput{
+			KeyId: key.KeyId,
+		})
+		if err != nil {
+			log.Error(ctx, "failed to list tags", "keyID", aws.ToString(key.KeyId), "err", err)
+			continue
+		}
+		tags := awsKmsTags(tagOutput.Tags)
+		if tags["did"] != identity.String() || tags["keyType"] != string(awsKeyProv.keyType) {
+			continue
+		}
+		keysToReturn = append(keysToReturn, KeyID{
+			Type: awsKeyProv.keyType,
+			ID:   awsKmsP256KeyIDPrefix + aws.ToString(key.KeyId),
+		})
+	}
+	return keysToReturn, nil
+}
+
+// Delete schedules the deletion of the key
+func (awsKeyProv *awsKmsP256KeyProvider) Delete(ctx context.Context, keyID KeyID) error {
+	const pendingWindowInDays = 7
+	keyIDStr, err := awsKeyProv.awsKeyID(keyID)
+	if err != nil {
+		return err
+	}
+	_, err = awsKeyProv.kmsClient.ScheduleKeyDeletion(ctx, &kms.ScheduleKeyDeletionInput{
+		KeyId:               aws.String(keyIDStr),
+		PendingWindowInDays: aws.Int32(pendingWindowInDays),
+	})
+	return err
+}
+
+// Exists checks if the key exists and it is not scheduled for deletion
+func (awsKeyProv *awsKmsP256KeyProvider) Exists(ctx context.Context, keyID KeyID) (bool, error) {
+	keyIDStr, err := awsKeyProv.awsKeyID(keyID)
+	if err != nil {
+		return false, err
+	}
+	output, err := awsKeyProv.kmsClient.DescribeKey(ctx, &kms.DescribeKeyInput{
+		KeyId: aws.String(keyIDStr),
+	})
+	if err != nil {
+		return false, nil
+	}
+	return output.KeyMetadata != nil && output.KeyMetadata.DeletionDate == nil, nil
+}
+
+func (awsKeyProv *awsKmsP256KeyProvider) awsKeyID(keyID KeyID) (string, error) {
+	if keyID.Type != awsKeyProv.keyType {
+		return "", ErrIncorrectKeyType
+	}
+	id, found := strings.CutPrefix(keyID.ID, awsKmsP256KeyIDPrefix)
+	if !found || id == "" {
+		return "", errors.New("invalid keyID: " + keyID.ID)
+	}
+	return id, nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/ecdsa"
+	"crypto/x509/pkix"
 	"encoding/asn1"
 	"encoding/hex"
 	"errors"
@@ -162,3 +163,32 @@ func adjustSignatureLength(buffer []byte) []byte {
 //	}
 //	return signature, nil
 //}
+
+var (
+	oidPublicKeyECDSA = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidNamedSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+// secp256k1PKCS8 encodes the Ethereum private key as the PKCS#8 DER that AWS KMS imports as key material.
+// x509.MarshalPKCS8PrivateKey does not support the secp256k1 curve.
+func secp256k1PKCS8(privateKey []byte) ([]byte, error) {
+	if len(privateKey) != defaultLength {
+		return nil, errors.New("incorrect private key")
+	}
+	ecPrivateKey, err := asn1.Marshal(struct {
+		Version       int
+		PrivateKey    []byte
+		NamedCurveOID asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	}{Version: 1, PrivateKey: privateKey, NamedCurveOID: oidNamedSecp256k1})
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(struct {
+		Version    int
+		Algo       pkix.AlgorithmIdentifier
+		PrivateKey []byte
+	}{
+		Algo:       pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyECDSA, Parameters: asn1.RawValue{FullBytes: oidSecp256k1}},
+		PrivateKey: ecPrivateKey,
+	})
+}
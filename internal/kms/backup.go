@@ -0,0 +1,227 @@
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	stderr "errors"
+	"fmt"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	keyBackupVersion = 1
+	backupKeyLength  = 32
+)
+
+var (
+	// ErrNoKeysToBackup raises when the identity has no keys that can be exported
+	ErrNoKeysToBackup = stderr.New("the identity has no keys to backup")
+	// ErrInvalidKeyBackup raises when the backup can not be decrypted with the given shares
+	ErrInvalidKeyBackup = stderr.New("the backup can not be decrypted with the given shares")
+)
+
+// KeyBackup is the encrypted backup of the keys of an identity. The keys are sealed with AES-GCM using a random
+// key that is split in Shamir shares, any Threshold of them restore the backup. The backup does not hold the
+// shares, so it can be stored next to the database backups.
+type KeyBackup struct {
+	Version    int       `json:"version"`
+	Identity   string    `json:"identity"`
+	Keys       int       `json:"keys"`
+	Shares     int       `json:"shares"`
+	Threshold  int       `json:"threshold"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Nonce      []byte    `json:"nonce"`
+	Ciphertext []byte    `json:"ciphertext"`
+}
+
+// backupKey is a key in the plaintext of the backup
+type backupKey struct {
+	KeyType    KeyType `json:"key_type"`
+	KeyID      string  `json:"key_id"`
+	PrivateKey string  `json:"private_key"`
+}
+
+// RestoredKey is a key restored from a backup. KeyID is the id of the key in the kms it was restored to, it can
+// be different from the id it had when the backup was made.
+type RestoredKey struct {
+	BackupKeyID KeyID
+	KeyID       KeyID
+	Existed     bool
+}
+
+// Encode returns the backup as base64 encoded json
+func (b *KeyBackup) Encode() (string, error) {
+	content, err := json.Marshal(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(content), nil
+}
+
+// DecodeKeyBackup parses a backup returned by KeyBackup.Encode
+func DecodeKeyBackup(encoded string) (*KeyBackup, error) {
+	content, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode backup: %w", err)
+	}
+	var backup KeyBackup
+	if err := json.Unmarshal(content, &backup); err != nil {
+		return nil, fmt.Errorf("cannot parse backup: %w", err)
+	}
+	if backup.Version != keyBackupVersion {
+		return nil, fmt.Errorf("unsupported backup version %d", backup.Version)
+	}
+	return &backup, nil
+}
+
+// BackupIdentityKeys returns the encrypted backup of all the keys of the identity and the shares of its
+// encryption key. The providers have to be able to export the keys, so keys in aws-kms or in a PKCS#11 token
+// can not be backed up.
+func (k *KMS) BackupIdentityKeys(ctx context.Context, identity w3c.DID, shares, threshold int) (*KeyBackup, [][]byte, error) {
+	if threshold < 2 || shares < threshold || shares > shamirMaxShares {
+		return nil, nil, fmt.Errorf("%w: the threshold has to be between 2 and the number of shares", ErrInvalidShares)
+	}
+
+	keyIDs, err := k.KeysByIdentity(ctx, identity)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := make([]backupKey, 0, len(keyIDs))
+	for _, keyID := range keyIDs {
+		exporter, ok := k.registry[keyID.Type].(keyExporter)
+		if !ok {
+			log.Warn(ctx, "the key provider can not export keys, the key is not in the backup", "keyID", keyID.ID)
+			continue
+		}
+		privateKey, err := exporter.exportKey(ctx, keyID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot export key %s: %w", keyID.ID, err)
+		}
+		keys = append(keys, backupKey{KeyType: keyID.Type, KeyID: keyID.ID, PrivateKey: hex.EncodeToString(privateKey)})
+	}
+	if len(keys) == 0 {
+		return nil, nil, ErrNoKeysToBackup
+	}
+
+	plaintext, err := json.Marshal(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataKey := make([]byte, backupKeyLength)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, nil, err
+	}
+	aead, err := newBackupCipher(dataKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+
+	backup := &KeyBackup{
+		Version:   keyBackupVersion,
+		Identity:  identity.String(),
+		Keys:      len(keys),
+		Shares:    shares,
+		Threshold: threshold,
+		CreatedAt: time.Now().UTC(),
+		Nonce:     nonce,
+	}
+	backup.Ciphertext = aead.Seal(nil, nonce, plaintext, backup.additionalData())
+
+	keyShares, err := splitSecret(dataKey, shares, threshold)
+	if err != nil {
+		return nil, nil, err
+	}
+	return backup, keyShares, nil
+}
+
+// RestoreIdentityKeys decrypts the backup with the shares and imports its keys. Keys already in the kms are not
+// imported again. Every key is verified signing with it and checking the signature with the backup public key.
+func (k *KMS) RestoreIdentityKeys(ctx context.Context, backup *KeyBackup, shares [][]byte) ([]RestoredKey, error) {
+	identity, err := w3c.ParseDID(backup.Identity)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup identity: %w", err)
+	}
+
+	dataKey, err := combineShares(shares)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newBackupCipher(dataKey)
+	if err != nil {
+		return nil, ErrInvalidKeyBackup
+	}
+	plaintext, err := aead.Open(nil, backup.Nonce, backup.Ciphertext, backup.additionalData())
+	if err != nil {
+		return nil, ErrInvalidKeyBackup
+	}
+
+	var keys []backupKey
+	if err := json.Unmarshal(plaintext, &keys); err != nil {
+		return nil, fmt.Errorf("cannot parse backup keys: %w", err)
+	}
+
+	restored := make([]RestoredKey, 0, len(keys))
+	for _, key := range keys {
+		backupKeyID := KeyID{Type: key.KeyType, ID: key.KeyID}
+		kp, ok := k.registry[key.KeyType]
+		if !ok {
+			return restored, fmt.Errorf("cannot restore key %s: %w", key.KeyID, ErrUnknownKeyType)
+		}
+		importer, ok := kp.(keyImporter)
+		if !ok {
+			return restored, fmt.Errorf("cannot restore key %s: the key provider can not import %s keys", key.KeyID, key.KeyType)
+		}
+
+		privateKey, err := hex.DecodeString(key.PrivateKey)
+		if err != nil {
+			return restored, fmt.Errorf("cannot decode key %s: %w", key.KeyID, err)
+		}
+		publicKey, err := publicKeyFromPrivate(key.KeyType, privateKey)
+		if err != nil {
+			return restored, fmt.Errorf("cannot decode key %s: %w", key.KeyID, err)
+		}
+
+		keyID, existed, err := findKey(ctx, kp, identity, backupKeyID, publicKey)
+		if err != nil {
+			return restored, fmt.Errorf("cannot restore key %s: %w", key.KeyID, err)
+		}
+		if !existed {
+			if keyID, err = importer.importKey(ctx, identity, backupKeyID, privateKey); err != nil {
+				return restored, fmt.Errorf("cannot import key %s: %w", key.KeyID, err)
+			}
+		}
+		if err := verifyKey(ctx, kp, keyID, publicKey); err != nil {
+			return restored, fmt.Errorf("cannot verify key %s: %w", keyID.ID, err)
+		}
+		restored = append(restored, RestoredKey{BackupKeyID: backupKeyID, KeyID: keyID, Existed: existed})
+	}
+	return restored, nil
+}
+
+// additionalData binds the ciphertext to the backup metadata
+func (b *KeyBackup) additionalData() []byte {
+	return []byte(fmt.Sprintf("%d|%s|%d|%d|%d|%d", b.Version, b.Identity, b.Keys, b.Shares, b.Threshold, b.CreatedAt.Unix()))
+}
+
+func newBackupCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,72 @@
+package kms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKMS_BackupAndRestoreIdentityKeys(t *testing.T) {
+	ctx := context.Background()
+	did, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qQ68JkRcf3ybQNvgRV9BP6qLgBrXmUezqBi4wsEuV")
+	require.NoError(t, err)
+
+	keyStore, err := OpenForMigration(ctx, MigrationProviderLocalStorage, Config{LocalStoragePath: t.TempDir()})
+	require.NoError(t, err)
+	bjjKeyID, err := keyStore.CreateKey(KeyTypeBabyJubJub, did)
+	require.NoError(t, err)
+	ethKeyID, err := keyStore.CreateKey(KeyTypeEthereum, nil)
+	require.NoError(t, err)
+	ethKeyID, err = keyStore.LinkToIdentity(ctx, ethKeyID, *did)
+	require.NoError(t, err)
+
+	_, _, err = keyStore.BackupIdentityKeys(ctx, *did, 3, 4)
+	assert.ErrorIs(t, err, ErrInvalidShares)
+
+	backup, shares, err := keyStore.BackupIdentityKeys(ctx, *did, 5, 3)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+	assert.Equal(t, 2, backup.Keys)
+	assert.Equal(t, did.String(), backup.Identity)
+
+	encoded, err := backup.Encode()
+	require.NoError(t, err)
+	backup, err = DecodeKeyBackup(encoded)
+	require.NoError(t, err)
+
+	recovered, err := OpenForMigration(ctx, MigrationProviderLocalStorage, Config{LocalStoragePath: t.TempDir()})
+	require.NoError(t, err)
+
+	t.Run("should not restore with a share of another backup", func(t *testing.T) {
+		_, otherShares, err := keyStore.BackupIdentityKeys(ctx, *did, 3, 2)
+		require.NoError(t, err)
+		_, err = recovered.RestoreIdentityKeys(ctx, backup, [][]byte{shares[0], otherShares[1]})
+		assert.ErrorIs(t, err, ErrInvalidKeyBackup)
+	})
+
+	t.Run("should restore the keys with the threshold of shares", func(t *testing.T) {
+		restored, err := recovered.RestoreIdentityKeys(ctx, backup, [][]byte{shares[4], shares[1], shares[2]})
+		require.NoError(t, err)
+		require.Len(t, restored, 2)
+		for _, key := range restored {
+			assert.False(t, key.Existed)
+			assert.Equal(t, key.BackupKeyID, key.KeyID)
+		}
+
+		keys, err := recovered.KeysByIdentity(ctx, *did)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []KeyID{bjjKeyID, ethKeyID}, keys)
+	})
+
+	t.Run("should not import the keys twice", func(t *testing.T) {
+		restored, err := recovered.RestoreIdentityKeys(ctx, backup, shares[:3])
+		require.NoError(t, err)
+		require.Len(t, restored, 2)
+		for _, key := range restored {
+			assert.True(t, key.Existed)
+		}
+	})
+}
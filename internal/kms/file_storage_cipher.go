@@ -0,0 +1,314 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	stderr "errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/argon2"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	encryptedFileVersion = 1
+	kdfArgon2id          = "argon2id"
+	argon2Time           = 3
+	argon2Memory         = 64 * 1024
+	argon2Threads        = 4
+	argon2KeyLength      = 32
+	argon2SaltLength     = 16
+)
+
+var (
+	// ErrLocalStorageLocked raises when the local storage file is encrypted and no passphrase is provided
+	ErrLocalStorageLocked = stderr.New("local storage file is encrypted, a passphrase is required")
+	// ErrInvalidLocalStoragePassphrase raises when the local storage file can not be decrypted with the given passphrase
+	ErrInvalidLocalStoragePassphrase = stderr.New("invalid local storage passphrase")
+)
+
+// encryptedFileContent is the content of an encrypted local storage file. The plaintext is the same json array
+// a non encrypted file has, sealed with AES-GCM using a key derived from the passphrase with Argon2id.
+type encryptedFileContent struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Salt       []byte `json:"salt"`
+	Time       uint32 `json:"time"`
+	Memory     uint32 `json:"memory"`
+	Threads    uint8  `json:"threads"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// passphraseSource returns the passphrase of the local storage file
+type passphraseSource func() (string, error)
+
+// staticPassphrase returns a source of the given passphrase
+func staticPassphrase(passphrase string) passphraseSource {
+	return func() (string, error) {
+		return passphrase, nil
+	}
+}
+
+// keyFilePassphrase returns a source that reads the passphrase from the given key file every time, so a passphrase
+// changed in the file is picked up
+func keyFilePassphrase(keyFile string) passphraseSource {
+	return func() (string, error) {
+		return ReadPassphraseFile(keyFile)
+	}
+}
+
+// fileKey is a key derived from the passphrase with the parameters stored in the file header
+type fileKey struct {
+	aead    cipher.AEAD
+	salt    []byte
+	time    uint32
+	memory  uint32
+	threads uint8
+}
+
+func newFileKey(passphrase string, salt []byte, time uint32, memory uint32, threads uint8) (*fileKey, error) {
+	key := argon2.IDKey([]byte(passphrase), salt, time, memory, threads, argon2KeyLength)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &fileKey{aead: aead, salt: salt, time: time, memory: memory, threads: threads}, nil
+}
+
+// newRandomFileKey derives a key from the passphrase with a new random salt and the default parameters
+func newRandomFileKey(passphrase string) (*fileKey, error) {
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return newFileKey(passphrase, salt, argon2Time, argon2Memory, argon2Threads)
+}
+
+// derivedFrom tells whether the key was derived with the parameters of the given file header
+func (k *fileKey) derivedFrom(content *encryptedFileContent) bool {
+	return bytes.Equal(k.salt, content.Salt) && k.time == content.Time && k.memory == content.Memory && k.threads == content.Threads
+}
+
+// fileCipher encrypts and decrypts the local storage file. The derived key is kept so it is only derived again when
+// the file header changes, that is when another process encrypted the file first or the passphrase was changed.
+type fileCipher struct {
+	passphrase passphraseSource
+	mu         sync.Mutex
+	key        *fileKey
+}
+
+func newFileCipher(passphrase passphraseSource, key *fileKey) *fileCipher {
+	return &fileCipher{passphrase: passphrase, key: key}
+}
+
+func (c *fileCipher) seal(plaintext []byte) ([]byte, error) {
+	c.mu.Lock()
+	key := c.key
+	c.mu.Unlock()
+
+	nonce := make([]byte, key.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return json.Marshal(encryptedFileContent{
+		Version:    encryptedFileVersion,
+		KDF:        kdfArgon2id,
+		Salt:       key.salt,
+		Time:       key.time,
+		Memory:     key.memory,
+		Threads:    key.threads,
+		Nonce:      nonce,
+		Ciphertext: key.aead.Seal(nil, nonce, plaintext, nil),
+	})
+}
+
+// open decrypts the given file content, deriving the key again from the passphrase if the file header changed
+func (c *fileCipher) open(content *encryptedFileContent) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := c.key
+	if key == nil || !key.derivedFrom(content) {
+		passphrase, err := c.passphrase()
+		if err != nil {
+			return nil, err
+		}
+		if key, err = newFileKey(passphrase, content.Salt, content.Time, content.Memory, content.Threads); err != nil {
+			return nil, err
+		}
+	}
+
+	plaintext, err := key.aead.Open(nil, content.Nonce, content.Ciphertext, nil)
+	if err != nil {
+		return nil, ErrInvalidLocalStoragePassphrase
+	}
+	c.key = key
+	return plaintext, nil
+}
+
+// parseEncryptedFileContent returns the encrypted content of the file, or nil if the file is not encrypted
+func parseEncryptedFileContent(fileContent []byte) (*encryptedFileContent, error) {
+	trimmed := bytes.TrimSpace(fileContent)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return nil, nil
+	}
+	var content encryptedFileContent
+	if err := json.Unmarshal(trimmed, &content); err != nil {
+		return nil, err
+	}
+	if content.Version != encryptedFileVersion || content.KDF != kdfArgon2id {
+		return nil, fmt.Errorf("unsupported local storage file encryption: version %d, kdf %s", content.Version, content.KDF)
+	}
+	return &content, nil
+}
+
+// unlockFile derives the key of an encrypted local storage file and checks the passphrase is valid.
+// If the file is not encrypted yet, it is encrypted with the passphrase, so an existing plaintext file is migrated
+// the first time the issuer node starts with a passphrase.
+func unlockFile(ctx context.Context, file string, passphrase passphraseSource) (*fileCipher, error) {
+	fileContent, err := os.ReadFile(file)
+	if err != nil {
+		log.Error(ctx, "cannot read file", "err", err, "file", file)
+		return nil, err
+	}
+
+	encrypted, err := parseEncryptedFileContent(fileContent)
+	if err != nil {
+		return nil, err
+	}
+
+	if encrypted == nil {
+		value, err := passphrase()
+		if err != nil {
+			return nil, err
+		}
+		key, err := newRandomFileKey(value)
+		if err != nil {
+			return nil, err
+		}
+		fc := newFileCipher(passphrase, key)
+		if err := writeFile(file, fileContent, fc); err != nil {
+			log.Error(ctx, "cannot encrypt local storage file", "err", err, "file", file)
+			return nil, err
+		}
+		log.Info(ctx, "local storage file encrypted", "file", file)
+		return fc, nil
+	}
+
+	fc := newFileCipher(passphrase, nil)
+	if _, err := fc.open(encrypted); err != nil {
+		return nil, err
+	}
+	return fc, nil
+}
+
+// readFile returns the plaintext content of the local storage file. fc is nil for non encrypted files.
+func readFile(file string, fc *fileCipher) ([]byte, error) {
+	fileContent, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := parseEncryptedFileContent(fileContent)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case encrypted == nil:
+		return fileContent, nil
+	case fc == nil:
+		return nil, ErrLocalStorageLocked
+	default:
+		return fc.open(encrypted)
+	}
+}
+
+// writeFile writes the local storage file, encrypting the content when fc is not nil. The content is written to a
+// temporary file first so an interrupted write never leaves a corrupted file.
+func writeFile(file string, content []byte, fc *fileCipher) error {
+	if fc != nil {
+		var err error
+		if content, err = fc.seal(content); err != nil {
+			return err
+		}
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(file), filepath.Base(file)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
+
+// ChangeLocalStoragePassphrase re-encrypts the local storage file with a new passphrase.
+// An empty oldPassphrase means the file is not encrypted yet and an empty newPassphrase stores the keys in plaintext again.
+func ChangeLocalStoragePassphrase(ctx context.Context, file string, oldPassphrase string, newPassphrase string) error {
+	var oldCipher *fileCipher
+	if oldPassphrase != "" {
+		var err error
+		if oldCipher, err = unlockFile(ctx, file, staticPassphrase(oldPassphrase)); err != nil {
+			return err
+		}
+	}
+
+	content, err := readFile(file, oldCipher)
+	if err != nil {
+		return err
+	}
+
+	var newCipher *fileCipher
+	if newPassphrase != "" {
+		key, err := newRandomFileKey(newPassphrase)
+		if err != nil {
+			return err
+		}
+		newCipher = newFileCipher(staticPassphrase(newPassphrase), key)
+	}
+
+	if err := writeFile(file, content, newCipher); err != nil {
+		log.Error(ctx, "cannot write file", "err", err)
+		return err
+	}
+	return nil
+}
+
+// ReadPassphraseFile returns the passphrase stored in the given key file, without the trailing new line
+func ReadPassphraseFile(file string) (string, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	passphrase := strings.TrimRight(string(content), "\r\n")
+	if passphrase == "" {
+		return "", fmt.Errorf("key file %s is empty", file)
+	}
+	return passphrase, nil
+}
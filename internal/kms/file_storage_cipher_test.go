@@ -0,0 +1,155 @@
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedFileStorageManager(t *testing.T) {
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), LocalStorageFileName)
+	plaintext, err := json.Marshal([]localStorageProviderFileContent{
+		{KeyPath: "pbkey", KeyType: ethereum, PrivateKey: "0xABC123"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(file, plaintext, 0o600))
+
+	keyID := KeyID{Type: KeyTypeEthereum, ID: "pbkey"}
+
+	t.Run("should encrypt an existing plaintext file", func(t *testing.T) {
+		ls, err := NewEncryptedFileStorageManager(ctx, file, "passphrase")
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "0xABC123")
+		encrypted, err := parseEncryptedFileContent(content)
+		require.NoError(t, err)
+		require.NotNil(t, encrypted)
+
+		privateKey, err := ls.searchPrivateKey(ctx, keyID)
+		require.NoError(t, err)
+		assert.Equal(t, "0xABC123", privateKey)
+	})
+
+	t.Run("should save keys encrypted", func(t *testing.T) {
+		ls, err := NewEncryptedFileStorageManager(ctx, file, "passphrase")
+		require.NoError(t, err)
+		require.NoError(t, ls.SaveKeyMaterial(ctx, map[string]string{jsonKeyType: string(KeyTypeBabyJubJub), jsonKeyData: "0xDEF456"}, "bjjkey"))
+
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "0xDEF456")
+
+		material, err := ls.getKeyMaterial(ctx, KeyID{Type: KeyTypeBabyJubJub, ID: "bjjkey"})
+		require.NoError(t, err)
+		assert.Equal(t, "0xDEF456", material[jsonKeyData])
+	})
+
+	t.Run("should fail with a wrong passphrase", func(t *testing.T) {
+		_, err := NewEncryptedFileStorageManager(ctx, file, "wrong")
+		assert.ErrorIs(t, err, ErrInvalidLocalStoragePassphrase)
+	})
+
+	t.Run("should fail to read an encrypted file without passphrase", func(t *testing.T) {
+		_, err := NewFileStorageManager(file).searchPrivateKey(ctx, keyID)
+		assert.ErrorIs(t, err, ErrLocalStorageLocked)
+	})
+
+	t.Run("should change the passphrase", func(t *testing.T) {
+		require.NoError(t, ChangeLocalStoragePassphrase(ctx, file, "passphrase", "new passphrase"))
+
+		_, err := NewEncryptedFileStorageManager(ctx, file, "passphrase")
+		assert.ErrorIs(t, err, ErrInvalidLocalStoragePassphrase)
+
+		ls, err := NewEncryptedFileStorageManager(ctx, file, "new passphrase")
+		require.NoError(t, err)
+		privateKey, err := ls.searchPrivateKey(ctx, keyID)
+		require.NoError(t, err)
+		assert.Equal(t, "0xABC123", privateKey)
+	})
+
+	t.Run("should decrypt the file", func(t *testing.T) {
+		require.NoError(t, ChangeLocalStoragePassphrase(ctx, file, "new passphrase", ""))
+
+		var content []localStorageProviderFileContent
+		raw, err := os.ReadFile(file)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(raw, &content))
+		assert.Len(t, content, 2)
+
+		privateKey, err := NewFileStorageManager(file).searchPrivateKey(ctx, keyID)
+		require.NoError(t, err)
+		assert.Equal(t, "0xABC123", privateKey)
+	})
+}
+
+func TestEncryptedFileStorageManagerKeyChanges(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	file := filepath.Join(dir, LocalStorageFileName)
+	plaintext, err := json.Marshal([]localStorageProviderFileContent{
+		{KeyPath: "pbkey", KeyType: ethereum, PrivateKey: "0xABC123"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(file, plaintext, 0o600))
+	keyFile := filepath.Join(dir, "passphrase")
+	require.NoError(t, os.WriteFile(keyFile, []byte("passphrase\n"), 0o600))
+
+	keyID := KeyID{Type: KeyTypeEthereum, ID: "pbkey"}
+
+	first, err := newEncryptedFileStorageManager(ctx, file, keyFilePassphrase(keyFile))
+	require.NoError(t, err)
+
+	info, err := os.Stat(file)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	t.Run("should read the file encrypted by another process", func(t *testing.T) {
+		// another process that unlocked the plaintext file at the same time encrypts it with another salt
+		require.NoError(t, os.WriteFile(file, plaintext, 0o600))
+		_, err := NewEncryptedFileStorageManager(ctx, file, "passphrase")
+		require.NoError(t, err)
+
+		privateKey, err := first.searchPrivateKey(ctx, keyID)
+		require.NoError(t, err)
+		assert.Equal(t, "0xABC123", privateKey)
+	})
+
+	t.Run("should read the file after the passphrase changes in the key file", func(t *testing.T) {
+		require.NoError(t, ChangeLocalStoragePassphrase(ctx, file, "passphrase", "new passphrase"))
+		require.NoError(t, os.WriteFile(keyFile, []byte("new passphrase\n"), 0o600))
+
+		privateKey, err := first.searchPrivateKey(ctx, keyID)
+		require.NoError(t, err)
+		assert.Equal(t, "0xABC123", privateKey)
+	})
+
+	t.Run("should fail when the passphrase is not the file one", func(t *testing.T) {
+		require.NoError(t, ChangeLocalStoragePassphrase(ctx, file, "new passphrase", "another passphrase"))
+
+		_, err := first.searchPrivateKey(ctx, keyID)
+		assert.ErrorIs(t, err, ErrInvalidLocalStoragePassphrase)
+	})
+}
+
+func TestReadPassphraseFile(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "passphrase")
+	require.NoError(t, os.WriteFile(keyFile, []byte("secret passphrase\n"), 0o600))
+
+	passphrase, err := ReadPassphraseFile(keyFile)
+	require.NoError(t, err)
+	assert.Equal(t, "secret passphrase", passphrase)
+
+	emptyFile := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(emptyFile, []byte("\n"), 0o600))
+	_, err = ReadPassphraseFile(emptyFile)
+	assert.Error(t, err)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"os"
 	"strings"
 
 	"github.com/iden3/go-iden3-core/v2/w3c"
@@ -17,6 +16,7 @@ const (
 	babyjubjub    = "babyjubjub"
 	ethereum      = "ethereum"
 	solanaEd25519 = "ed25519"
+	nistP256      = "p256"
 )
 
 type localStorageProviderFileContent struct {
@@ -26,16 +26,32 @@ type localStorageProviderFileContent struct {
 }
 
 type fileStorageManager struct {
-	file string
+	file   string
+	cipher *fileCipher
 }
 
 // NewFileStorageManager - creates new local storage file manager
 func NewFileStorageManager(file string) *fileStorageManager {
-	return &fileStorageManager{file}
+	return &fileStorageManager{file: file}
+}
+
+// NewEncryptedFileStorageManager - creates new local storage file manager that keeps the file encrypted with a key
+// derived from the passphrase. The file is unlocked here, so a wrong passphrase fails at startup, and a plaintext
+// file is encrypted.
+func NewEncryptedFileStorageManager(ctx context.Context, file string, passphrase string) (*fileStorageManager, error) {
+	return newEncryptedFileStorageManager(ctx, file, staticPassphrase(passphrase))
+}
+
+func newEncryptedFileStorageManager(ctx context.Context, file string, passphrase passphraseSource) (*fileStorageManager, error) {
+	fc, err := unlockFile(ctx, file, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return &fileStorageManager{file: file, cipher: fc}, nil
 }
 
 func (ls *fileStorageManager) SaveKeyMaterial(ctx context.Context, keyMaterial map[string]string, id string) error {
-	localStorageFileContent, err := readContentFile(ctx, ls.file)
+	localStorageFileContent, err := ls.readContentFile(ctx)
 	if err != nil {
 		return err
 	}
@@ -50,8 +66,7 @@ func (ls *fileStorageManager) SaveKeyMaterial(ctx context.Context, keyMaterial m
 		log.Error(ctx, "cannot marshal file content", "err", err)
 		return err
 	}
-	// nolint: all
-	if err := os.WriteFile(ls.file, newFileContent, 0644); err != nil {
+	if err := writeFile(ls.file, newFileContent, ls.cipher); err != nil {
 		log.Error(ctx, "cannot write file", "err", err)
 		return err
 	}
@@ -60,7 +75,7 @@ func (ls *fileStorageManager) SaveKeyMaterial(ctx context.Context, keyMaterial m
 
 func (ls *fileStorageManager) searchByIdentity(ctx context.Context, identity w3c.DID, keyType KeyType) ([]KeyID, error) {
 	keyTypeToRead := convertFromKeyType(keyType)
-	localStorageFileContent, err := readContentFile(ctx, ls.file)
+	localStorageFileContent, err := ls.readContentFile(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +98,7 @@ func (ls *fileStorageManager) searchByIdentity(ctx context.Context, identity w3c
 }
 
 func (ls *fileStorageManager) searchPrivateKey(ctx context.Context, keyID KeyID) (string, error) {
-	localStorageFileContent, err := readContentFile(ctx, ls.file)
+	localStorageFileContent, err := ls.readContentFile(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -95,10 +110,10 @@ func (ls *fileStorageManager) searchPrivateKey(ctx context.Context, keyID KeyID)
 	return "", errors.New("key not found")
 }
 
-func readContentFile(ctx context.Context, file string) ([]localStorageProviderFileContent, error) {
-	fileContent, err := os.ReadFile(file)
+func (ls *fileStorageManager) readContentFile(ctx context.Context) ([]localStorageProviderFileContent, error) {
+	fileContent, err := readFile(ls.file, ls.cipher)
 	if err != nil {
-		log.Error(ctx, "cannot read file", "err", err, "file", file)
+		log.Error(ctx, "cannot read file", "err", err, "file", ls.file)
 		return nil, err
 	}
 
@@ -112,7 +127,7 @@ func readContentFile(ctx context.Context, file string) ([]localStorageProviderFi
 }
 
 func (ls *fileStorageManager) deleteKeyMaterial(ctx context.Context, keyID KeyID) error {
-	localStorageFileContent, err := readContentFile(ctx, ls.file)
+	localStorageFileContent, err := ls.readContentFile(ctx)
 	if err != nil {
 		return err
 	}
@@ -128,8 +143,7 @@ func (ls *fileStorageManager) deleteKeyMaterial(ctx context.Context, keyID KeyID
 		log.Error(ctx, "cannot marshal file content", "err", err)
 		return err
 	}
-	// nolint: all
-	if err := os.WriteFile(ls.file, newFileContent, 0644); err != nil {
+	if err := writeFile(ls.file, newFileContent, ls.cipher); err != nil {
 		log.Error(ctx, "cannot write file", "err", err)
 		return err
 	}
@@ -137,7 +151,7 @@ func (ls *fileStorageManager) deleteKeyMaterial(ctx context.Context, keyID KeyID
 }
 
 func (ls *fileStorageManager) getKeyMaterial(ctx context.Context, keyID KeyID) (map[string]string, error) {
-	localStorageFileContent, err := readContentFile(ctx, ls.file)
+	localStorageFileContent, err := ls.readContentFile(ctx)
 	if err != nil {
 		return nil, err
 	}
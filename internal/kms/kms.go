@@ -7,12 +7,17 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/vault/api"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/metrics"
+	"github.com/polygonid/sh-id-platform/internal/tracing"
 )
 
 // StorageManager - interface for managing local storage
@@ -30,7 +35,7 @@ type KMSType interface {
 	RegisterKeyProvider(kt KeyType, kp KeyProvider) error
 	CreateKey(kt KeyType, identity *w3c.DID) (KeyID, error)
 	PublicKey(keyID KeyID) ([]byte, error)
-	Sign(ctx context.Context, keyID KeyID, data []byte) ([]byte, error)
+	Sign(ctx context.Context, keyID KeyID, purpose SignPurpose, data []byte) ([]byte, error)
 	KeysByIdentity(ctx context.Context, identity w3c.DID) ([]KeyID, error)
 	LinkToIdentity(ctx context.Context, keyID KeyID, identity w3c.DID) (KeyID, error)
 	Delete(ctx context.Context, keyID KeyID) error
@@ -61,6 +66,20 @@ const (
 	SOLAWSSecretManagerStorage ConfigProvider = "aws-sm"
 	// SOLVaultKeyProvider is a key provider for ed25519 keys in vault
 	SOLVaultKeyProvider ConfigProvider = "vault"
+	// ETHPKCS11KeyProvider is a key provider for Ethereum keys in a PKCS#11 token
+	ETHPKCS11KeyProvider ConfigProvider = "pkcs11"
+	// SOLPKCS11KeyProvider is a key provider for ed25519 keys in a PKCS#11 token
+	SOLPKCS11KeyProvider ConfigProvider = "pkcs11"
+	// P256LocalStorageKeyProvider is a key provider for P-256 keys in local storage
+	P256LocalStorageKeyProvider ConfigProvider = "localstorage"
+	// P256AWSSecretManagerStorage is a key provider for P-256 keys in AWS Secret Manager
+	P256AWSSecretManagerStorage ConfigProvider = "aws-sm"
+	// P256VaultKeyProvider is a key provider for P-256 keys in the vault kv v2 secrets engine
+	P256VaultKeyProvider ConfigProvider = "vault"
+	// P256AwsKmsKeyProvider is a key provider for P-256 keys in AWS KMS
+	P256AwsKmsKeyProvider ConfigProvider = "aws-kms"
+	// RemoteSignerKeyProvider is a key provider for keys of any type held by an external signing service
+	RemoteSignerKeyProvider ConfigProvider = "remote"
 )
 
 // Config is a configuration for KMS
@@ -68,11 +87,16 @@ type Config struct {
 	BJJKeyProvider           ConfigProvider
 	ETHKeyProvider           ConfigProvider
 	SOLKeyProvider           ConfigProvider
+	P256KeyProvider          ConfigProvider
 	AWSAccessKey             string
 	AWSSecretKey             string
 	AWSRegion                string
 	AWSURL                   string
 	LocalStoragePath         string
+	LocalStoragePassphrase   string
+	LocalStorageKeyFile      string
+	PKCS11                   PKCS11Config
+	RemoteSigner             RemoteSignerConfig
 	Vault                    *api.Client
 	PluginIden3MountPath     string
 	IssuerETHTransferKeyPath string
@@ -99,9 +123,18 @@ type KeyProvider interface {
 	Exists(ctx context.Context, keyID KeyID) (bool, error)
 }
 
+// Pinger is implemented by the key providers that depend on an external service
+type Pinger interface {
+	// Ping returns an error when the service is not reachable or does not accept the credentials of the provider
+	Ping(ctx context.Context) error
+}
+
 // KMS stores keys and secrets
 type KMS struct {
 	registry map[KeyType]KeyProvider
+	auditor  SignAuditor
+	// providers are the configured names of the key providers, used in the metrics
+	providers map[KeyType]ConfigProvider
 }
 
 // KeyType describes the type of Key
@@ -112,6 +145,7 @@ const (
 	KeyTypeBabyJubJub KeyType = "BJJ"
 	KeyTypeEthereum   KeyType = "ETH"
 	KeyTypeEd25519    KeyType = "Ed25519"
+	KeyTypeP256       KeyType = "P256"
 )
 
 // ErrUnknownKeyType returns when we do not support this type of keys
@@ -174,14 +208,74 @@ func (k *KMS) PublicKey(keyID KeyID) ([]byte, error) {
 	return kp.PublicKey(keyID)
 }
 
-// Sign signs digest with private key
-func (k *KMS) Sign(ctx context.Context, keyID KeyID, data []byte) ([]byte, error) {
+// Pingers returns the ping of each key provider that depends on an external service, by key type and provider
+// name, like kms:BJJ:vault
+func (k *KMS) Pingers() map[string]func(ctx context.Context) error {
+	pingers := make(map[string]func(ctx context.Context) error)
+	for kt, kp := range k.registry {
+		if p, ok := kp.(Pinger); ok {
+			pingers[fmt.Sprintf("kms:%s:%s", kt, k.providers[kt])] = p.Ping
+		}
+	}
+	return pingers
+}
+
+// SetSignAuditor sets the auditor that checks the key policies and records every sign request.
+// It should be called on app initialization.
+func (k *KMS) SetSignAuditor(auditor SignAuditor) {
+	k.auditor = auditor
+}
+
+// Sign signs digest with private key. If a sign auditor is set, the key policy is checked before signing
+// and the request is recorded with its purpose.
+func (k *KMS) Sign(ctx context.Context, keyID KeyID, purpose SignPurpose, data []byte) ([]byte, error) {
 	kp, ok := k.registry[keyID.Type]
 	if !ok {
 		return nil, errors.WithStack(ErrUnknownKeyType)
 	}
 
-	return kp.Sign(ctx, keyID, data)
+	if k.auditor == nil {
+		return k.sign(ctx, kp, keyID, data)
+	}
+
+	if err := k.auditor.Allow(ctx, keyID, purpose); err != nil {
+		if stderr.Is(err, ErrSignPurposeNotAllowed) || stderr.Is(err, ErrSignRateLimitExceeded) {
+			if recErr := k.auditor.Record(ctx, newSignRecord(keyID, purpose, data, SignStatusDenied, err)); recErr != nil {
+				log.Error(ctx, "recording denied sign request", "err", recErr, "keyID", keyID.ID)
+			}
+		}
+		return nil, err
+	}
+
+	signature, err := k.sign(ctx, kp, keyID, data)
+	if err != nil {
+		if recErr := k.auditor.Record(ctx, newSignRecord(keyID, purpose, data, SignStatusFailed, err)); recErr != nil {
+			log.Error(ctx, "recording failed sign request", "err", recErr, "keyID", keyID.ID)
+		}
+		return nil, err
+	}
+
+	if err := k.auditor.Record(ctx, newSignRecord(keyID, purpose, data, SignStatusSigned, nil)); err != nil {
+		return nil, fmt.Errorf("cannot record sign request: %w", err)
+	}
+	return signature, nil
+}
+
+// sign signs the data with the key provider and records the latency
+func (k *KMS) sign(ctx context.Context, kp KeyProvider, keyID KeyID, data []byte) ([]byte, error) {
+	provider, ok := k.providers[keyID.Type]
+	if !ok {
+		provider = "unknown"
+	}
+	ctx, span := tracing.Start(ctx, "KMS.Sign", trace.WithAttributes(
+		attribute.String("kms.provider", string(provider)),
+		attribute.String("kms.key_type", string(keyID.Type)),
+	))
+	start := time.Now()
+	signature, err := kp.Sign(ctx, keyID, data)
+	metrics.ObserveKMSSign(string(provider), string(keyID.Type), start, err)
+	tracing.End(span, err)
+	return signature, err
 }
 
 // KeysByIdentity lists keys by identity
@@ -302,6 +396,11 @@ func OpenWithConfig(ctx context.Context, config Config) (*KMS, error) {
 		return nil, err
 	}
 
+	p256KeyProvider, err := createP256KeyProvider(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
 	keyStore := NewKMS()
 	err = keyStore.RegisterKeyProvider(KeyTypeBabyJubJub, bjjKeyProvider)
 	if err != nil {
@@ -316,6 +415,16 @@ func OpenWithConfig(ctx context.Context, config Config) (*KMS, error) {
 	if err != nil {
 		return nil, fmt.Errorf("cannot register Solana Ed25519 key provider: %+v", err)
 	}
+	err = keyStore.RegisterKeyProvider(KeyTypeP256, p256KeyProvider)
+	if err != nil {
+		return nil, fmt.Errorf("cannot register P-256 key provider: %+v", err)
+	}
+	keyStore.providers = map[KeyType]ConfigProvider{
+		KeyTypeBabyJubJub: config.BJJKeyProvider,
+		KeyTypeEthereum:   config.ETHKeyProvider,
+		KeyTypeEd25519:    config.SOLKeyProvider,
+		KeyTypeP256:       config.P256KeyProvider,
+	}
 	return keyStore, nil
 }
 
@@ -336,11 +445,11 @@ func createBJJKeyProvider(ctx context.Context, config Config) (KeyProvider, erro
 	}
 
 	if config.BJJKeyProvider == BJJLocalStorageKeyProvider {
-		filePath, err := createFileIfNotExists(ctx, config.LocalStoragePath, LocalStorageFileName)
+		storageManager, err := newLocalStorageManager(ctx, config)
 		if err != nil {
-			return nil, fmt.Errorf("cannot create file: %v", err)
+			return nil, err
 		}
-		bjjKeyProvider = NewLocalBJJKeyProvider(KeyTypeBabyJubJub, NewFileStorageManager(filePath))
+		bjjKeyProvider = NewLocalBJJKeyProvider(KeyTypeBabyJubJub, storageManager)
 		log.Info(ctx, "BabyJubJub key provider created", "provider:", BJJLocalStorageKeyProvider)
 	}
 
@@ -358,6 +467,14 @@ func createBJJKeyProvider(ctx context.Context, config Config) (KeyProvider, erro
 		log.Info(ctx, "BabyJubJub key provider created", "provider:", BJJAWSSecretManagerStorage)
 	}
 
+	if config.BJJKeyProvider == RemoteSignerKeyProvider {
+		bjjKeyProvider, err = NewRemoteSignerKeyProvider(KeyTypeBabyJubJub, config.RemoteSigner)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create BabyJubJub remote signer key provider: %+v", err)
+		}
+		log.Info(ctx, "BabyJubJub key provider created", "provider:", RemoteSignerKeyProvider)
+	}
+
 	return bjjKeyProvider, nil
 }
 
@@ -378,11 +495,11 @@ func createETHKeyProvider(ctx context.Context, config Config) (KeyProvider, erro
 	}
 
 	if config.ETHKeyProvider == ETHLocalStorageKeyProvider {
-		filePath, err := createFileIfNotExists(ctx, config.LocalStoragePath, LocalStorageFileName)
+		storageManager, err := newLocalStorageManager(ctx, config)
 		if err != nil {
-			return nil, fmt.Errorf("cannot create file: %v", err)
+			return nil, err
 		}
-		ethKeyProvider = NewLocalEthKeyProvider(KeyTypeEthereum, NewFileStorageManager(filePath))
+		ethKeyProvider = NewLocalEthKeyProvider(KeyTypeEthereum, storageManager)
 		log.Info(ctx, "Ethereum key provider created", "provider:", ETHLocalStorageKeyProvider)
 	}
 
@@ -419,6 +536,22 @@ func createETHKeyProvider(ctx context.Context, config Config) (KeyProvider, erro
 		log.Info(ctx, "Ethereum key provider created", "provider:", ETHAwsKmsKeyProvider)
 	}
 
+	if config.ETHKeyProvider == ETHPKCS11KeyProvider {
+		ethKeyProvider, err = NewPKCS11KeyProvider(KeyTypeEthereum, config.PKCS11)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create Ethereum pkcs11 key provider: %+v", err)
+		}
+		log.Info(ctx, "Ethereum key provider created", "provider:", ETHPKCS11KeyProvider)
+	}
+
+	if config.ETHKeyProvider == RemoteSignerKeyProvider {
+		ethKeyProvider, err = NewRemoteSignerKeyProvider(KeyTypeEthereum, config.RemoteSigner)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create Ethereum remote signer key provider: %+v", err)
+		}
+		log.Info(ctx, "Ethereum key provider created", "provider:", RemoteSignerKeyProvider)
+	}
+
 	return ethKeyProvider, nil
 }
 
@@ -431,11 +564,11 @@ func createSOLKeyProvider(ctx context.Context, config Config) (KeyProvider, erro
 	}
 
 	if config.SOLKeyProvider == SOLLocalStorageKeyProvider {
-		filePath, err := createFileIfNotExists(ctx, config.LocalStoragePath, LocalStorageFileName)
+		storageManager, err := newLocalStorageManager(ctx, config)
 		if err != nil {
-			return nil, fmt.Errorf("cannot create file: %v", err)
+			return nil, err
 		}
-		solKeyProvider = NewLocalEd25519KeyProvider(KeyTypeEd25519, NewFileStorageManager(filePath))
+		solKeyProvider = NewLocalEd25519KeyProvider(KeyTypeEd25519, storageManager)
 		log.Info(ctx, "Ed25519 key provider created", "provider:", SOLLocalStorageKeyProvider)
 	}
 
@@ -461,16 +594,127 @@ func createSOLKeyProvider(ctx context.Context, config Config) (KeyProvider, erro
 		log.Info(ctx, "Ed25519 key provider created", "provider:", SOLVaultKeyProvider)
 	}
 
+	if config.SOLKeyProvider == SOLPKCS11KeyProvider {
+		solKeyProvider, err = NewPKCS11KeyProvider(KeyTypeEd25519, config.PKCS11)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create SOL pkcs11 key provider: %+v", err)
+		}
+		log.Info(ctx, "Ed25519 key provider created", "provider:", SOLPKCS11KeyProvider)
+	}
+
+	if config.SOLKeyProvider == RemoteSignerKeyProvider {
+		solKeyProvider, err = NewRemoteSignerKeyProvider(KeyTypeEd25519, config.RemoteSigner)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create SOL remote signer key provider: %+v", err)
+		}
+		log.Info(ctx, "Ed25519 key provider created", "provider:", RemoteSignerKeyProvider)
+	}
+
 	return solKeyProvider, nil
 }
 
+func createP256KeyProvider(ctx context.Context, config Config) (KeyProvider, error) {
+	var p256KeyProvider KeyProvider
+	var err error
+
+	if config.P256KeyProvider == "" {
+		return nil, errors.New("P-256 key provider is not provided")
+	}
+
+	if config.P256KeyProvider == P256LocalStorageKeyProvider {
+		storageManager, err := newLocalStorageManager(ctx, config)
+		if err != nil {
+			return nil, err
+		}
+		p256KeyProvider = NewLocalP256KeyProvider(KeyTypeP256, storageManager)
+		log.Info(ctx, "P-256 key provider created", "provider:", P256LocalStorageKeyProvider)
+	}
+
+	if config.P256KeyProvider == P256AWSSecretManagerStorage {
+		provider, err := NewAwsSecretStorageProvider(ctx, AwsSecretStorageProviderConfig{
+			AccessKey: config.AWSAccessKey,
+			SecretKey: config.AWSSecretKey,
+			Region:    config.AWSRegion,
+			URL:       config.AWSURL,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot create P-256 aws key provider: %+v", err)
+		}
+		p256KeyProvider = NewLocalP256KeyProvider(KeyTypeP256, provider)
+		log.Info(ctx, "P-256 key provider created", "provider:", P256AWSSecretManagerStorage)
+	}
+
+	if config.P256KeyProvider == P256VaultKeyProvider {
+		if config.Vault == nil {
+			return nil, errors.New("vault client is not provided")
+		}
+		p256KeyProvider = NewVaultP256KeyProvider(config.Vault, KeyTypeP256)
+		log.Info(ctx, "P-256 key provider created", "provider:", P256VaultKeyProvider)
+	}
+
+	if config.P256KeyProvider == P256AwsKmsKeyProvider {
+		if config.AWSAccessKey == "" || config.AWSSecretKey == "" || config.AWSRegion == "" {
+			return nil, errors.New("AWS KMS access key, secret key and region have to be provided")
+		}
+		p256KeyProvider, err = NewAwsKMSP256KeyProvider(ctx, KeyTypeP256, AwKmsEthKeyProviderConfig{
+			AccessKey: config.AWSAccessKey,
+			SecretKey: config.AWSSecretKey,
+			Region:    config.AWSRegion,
+			URL:       config.AWSURL,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot create P-256 aws kms key provider: %+v", err)
+		}
+		log.Info(ctx, "P-256 key provider created", "provider:", P256AwsKmsKeyProvider)
+	}
+
+	if config.P256KeyProvider == RemoteSignerKeyProvider {
+		p256KeyProvider, err = NewRemoteSignerKeyProvider(KeyTypeP256, config.RemoteSigner)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create P-256 remote signer key provider: %+v", err)
+		}
+		log.Info(ctx, "P-256 key provider created", "provider:", RemoteSignerKeyProvider)
+	}
+
+	if p256KeyProvider == nil {
+		return nil, fmt.Errorf("unknown P-256 key provider %q", config.P256KeyProvider)
+	}
+	return p256KeyProvider, nil
+}
+
+// newLocalStorageManager returns the local storage file manager, encrypted if a passphrase is configured
+func newLocalStorageManager(ctx context.Context, config Config) (*fileStorageManager, error) {
+	filePath, err := createFileIfNotExists(ctx, config.LocalStoragePath, LocalStorageFileName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create file: %v", err)
+	}
+
+	if config.LocalStoragePassphrase == "" {
+		if _, err := readFile(filePath, nil); err != nil {
+			return nil, fmt.Errorf("cannot read local storage file: %w", err)
+		}
+		return NewFileStorageManager(filePath), nil
+	}
+
+	// with a key file, the passphrase is read again when the file is encrypted with another one
+	passphrase := staticPassphrase(config.LocalStoragePassphrase)
+	if config.LocalStorageKeyFile != "" {
+		passphrase = keyFilePassphrase(config.LocalStorageKeyFile)
+	}
+	storageManager, err := newEncryptedFileStorageManager(ctx, filePath, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("cannot unlock local storage file: %w", err)
+	}
+	return storageManager, nil
+}
+
 func createFileIfNotExists(ctx context.Context, folderPath, fileName string) (string, error) {
 	if err := os.MkdirAll(folderPath, os.ModePerm); err != nil {
 		return "", fmt.Errorf("error creating folder: %v", err)
 	}
 	filePath := filepath.Join(folderPath, fileName)
 	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		file, err := os.Create(filePath)
+		file, err := os.OpenFile(filePath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
 		if err != nil {
 			return "", fmt.Errorf("error creating file: %v", err)
 		}
@@ -169,3 +169,27 @@ func (ls *localBJJKeyProvider) Exists(ctx context.Context, keyID KeyID) (bool, e
 	}
 	return true, nil
 }
+
+func (ls *localBJJKeyProvider) exportKey(ctx context.Context, keyID KeyID) ([]byte, error) {
+	return ls.privateKey(ctx, keyID)
+}
+
+func (ls *localBJJKeyProvider) importKeyID(identity *w3c.DID, keyID KeyID, publicKey []byte) string {
+	if identity == nil {
+		return keyID.ID
+	}
+	return keyPathForLocalProvider(identity, ls.keyType, hex.EncodeToString(publicKey))
+}
+
+func (ls *localBJJKeyProvider) importKey(ctx context.Context, identity *w3c.DID, keyID KeyID, privateKey []byte) (KeyID, error) {
+	publicKey, err := publicKeyFromPrivate(ls.keyType, privateKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+	newKeyID := KeyID{Type: ls.keyType, ID: ls.importKeyID(identity, keyID, publicKey)}
+	keyMaterial := map[string]string{
+		jsonKeyType: string(ls.keyType),
+		jsonKeyData: hex.EncodeToString(privateKey),
+	}
+	return newKeyID, ls.storageManager.SaveKeyMaterial(ctx, keyMaterial, newKeyID.ID)
+}
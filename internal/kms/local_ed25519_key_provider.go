@@ -157,3 +157,31 @@ func (ls *localEd25519KeyProvider) privateKey(ctx context.Context, keyID KeyID)
 	val := ed25519.NewKeyFromSeed(decodedSeed)
 	return val, nil
 }
+
+func (ls *localEd25519KeyProvider) exportKey(ctx context.Context, keyID KeyID) ([]byte, error) {
+	privKey, err := ls.privateKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return privKey.Seed(), nil
+}
+
+func (ls *localEd25519KeyProvider) importKeyID(identity *w3c.DID, keyID KeyID, publicKey []byte) string {
+	if identity == nil {
+		return keyID.ID
+	}
+	return getKeyID(identity, ls.keyType, solana.PublicKeyFromBytes(publicKey).String())
+}
+
+func (ls *localEd25519KeyProvider) importKey(ctx context.Context, identity *w3c.DID, keyID KeyID, privateKey []byte) (KeyID, error) {
+	publicKey, err := publicKeyFromPrivate(ls.keyType, privateKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+	newKeyID := KeyID{Type: ls.keyType, ID: ls.importKeyID(identity, keyID, publicKey)}
+	keyMaterial := map[string]string{
+		jsonKeyType: string(KeyTypeEd25519),
+		jsonKeyData: hex.EncodeToString(privateKey),
+	}
+	return newKeyID, ls.storageManager.SaveKeyMaterial(ctx, keyMaterial, newKeyID.ID)
+}
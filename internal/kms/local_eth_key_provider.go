@@ -180,3 +180,27 @@ func (ls *localEthKeyProvider) privateKey(ctx context.Context, keyID KeyID) ([]b
 
 	return val, nil
 }
+
+func (ls *localEthKeyProvider) exportKey(ctx context.Context, keyID KeyID) ([]byte, error) {
+	return ls.privateKey(ctx, keyID)
+}
+
+func (ls *localEthKeyProvider) importKeyID(identity *w3c.DID, keyID KeyID, publicKey []byte) string {
+	if identity == nil {
+		return keyID.ID
+	}
+	return getKeyID(identity, ls.keyType, hex.EncodeToString(publicKey))
+}
+
+func (ls *localEthKeyProvider) importKey(ctx context.Context, identity *w3c.DID, keyID KeyID, privateKey []byte) (KeyID, error) {
+	publicKey, err := publicKeyFromPrivate(ls.keyType, privateKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+	newKeyID := KeyID{Type: ls.keyType, ID: ls.importKeyID(identity, keyID, publicKey)}
+	keyMaterial := map[string]string{
+		jsonKeyType: string(KeyTypeEthereum),
+		jsonKeyData: hex.EncodeToString(privateKey),
+	}
+	return newKeyID, ls.storageManager.SaveKeyMaterial(ctx, keyMaterial, newKeyID.ID)
+}
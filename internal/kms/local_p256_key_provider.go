@@ -0,0 +1,185 @@
+package kms
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"regexp"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+type localP256KeyProvider struct {
+	keyType          KeyType
+	reIdenKeyPathHex *regexp.Regexp // RE of key path bounded to identity
+	storageManager   StorageManager
+	temporaryKeys    map[string]map[string]string
+}
+
+// NewLocalP256KeyProvider - creates new key provider for P-256 keys stored in local storage
+func NewLocalP256KeyProvider(keyType KeyType, storageManager StorageManager) KeyProvider {
+	keyTypeRE := regexp.QuoteMeta(string(keyType))
+	reIdenKeyPathHex := regexp.MustCompile("^(?i).*/" + keyTypeRE + ":([a-f0-9]{66})$")
+	return &localP256KeyProvider{
+		keyType:          keyType,
+		storageManager:   storageManager,
+		reIdenKeyPathHex: reIdenKeyPathHex,
+		temporaryKeys:    make(map[string]map[string]string),
+	}
+}
+
+func (ls *localP256KeyProvider) New(identity *w3c.DID) (KeyID, error) {
+	keyID := KeyID{Type: ls.keyType}
+	privKey, pubKey, err := newP256PrivateKey()
+	if err != nil {
+		return keyID, err
+	}
+
+	keyMaterial := map[string]string{
+		jsonKeyType: string(KeyTypeP256),
+		jsonKeyData: hex.EncodeToString(privKey),
+	}
+
+	keyID.ID = getKeyID(identity, ls.keyType, hex.EncodeToString(pubKey))
+	ls.temporaryKeys[keyID.ID] = keyMaterial
+	return keyID, nil
+}
+
+// PublicKey returns the compressed public key
+func (ls *localP256KeyProvider) PublicKey(keyID KeyID) ([]byte, error) {
+	ctx := context.Background()
+	if keyID.Type != ls.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+
+	ss := ls.reIdenKeyPathHex.FindStringSubmatch(keyID.ID)
+	if len(ss) != partsNumber {
+		pkBytes, err := ls.privateKey(ctx, keyID)
+		if err != nil {
+			return nil, errors.New("unable to get private key for build public key")
+		}
+		pk, err := decodeP256PrivateKey(pkBytes)
+		if err != nil {
+			return nil, err
+		}
+		return CompressP256PubKey(&pk.PublicKey), nil
+	}
+
+	return hex.DecodeString(ss[1])
+}
+
+// Sign signs the digest and returns the r||s signature
+func (ls *localP256KeyProvider) Sign(ctx context.Context, keyID KeyID, data []byte) ([]byte, error) {
+	privKeyData, err := ls.privateKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := decodeP256PrivateKey(privKeyData)
+	if err != nil {
+		return nil, err
+	}
+	return signP256(privKey, data)
+}
+
+func (ls *localP256KeyProvider) LinkToIdentity(ctx context.Context, keyID KeyID, identity w3c.DID) (KeyID, error) {
+	if keyID.Type != ls.keyType {
+		return keyID, ErrIncorrectKeyType
+	}
+
+	keyMaterial, ok := ls.temporaryKeys[keyID.ID]
+	delete(ls.temporaryKeys, keyID.ID)
+	if !ok {
+		return keyID, errors.New("key not found")
+	}
+
+	newKey := getKeyID(&identity, ls.keyType, keyID.ID)
+	if err := ls.storageManager.SaveKeyMaterial(ctx, keyMaterial, newKey); err != nil {
+		return KeyID{}, err
+	}
+
+	keyID.ID = newKey
+	return keyID, nil
+}
+
+// ListByIdentity lists keys by identity
+func (ls *localP256KeyProvider) ListByIdentity(ctx context.Context, identity w3c.DID) ([]KeyID, error) {
+	return ls.storageManager.searchByIdentity(ctx, identity, ls.keyType)
+}
+
+func (ls *localP256KeyProvider) Delete(ctx context.Context, keyID KeyID) error {
+	return ls.storageManager.deleteKeyMaterial(ctx, keyID)
+}
+
+func (ls *localP256KeyProvider) Exists(ctx context.Context, keyID KeyID) (bool, error) {
+	_, err := ls.storageManager.getKeyMaterial(ctx, keyID)
+	if err != nil {
+		if errors.Is(err, ErrKeyNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (ls *localP256KeyProvider) privateKey(ctx context.Context, keyID KeyID) ([]byte, error) {
+	if keyID.Type != ls.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+
+	if keyID.ID == "" {
+		return nil, errors.New("key ID is empty")
+	}
+
+	privateKey := ""
+	if keyMaterial, ok := ls.temporaryKeys[keyID.ID]; ok {
+		privateKey = keyMaterial[jsonKeyData]
+	}
+
+	if privateKey == "" {
+		var err error
+		privateKey, err = ls.storageManager.searchPrivateKey(ctx, keyID)
+		if err != nil {
+			log.Error(ctx, "cannot get private key", "err", err, "keyID", keyID)
+			return nil, err
+		}
+	}
+
+	val, err := hex.DecodeString(privateKey)
+	if err != nil {
+		log.Error(ctx, "cannot decode private key", "err", err, "keyID", keyID)
+		return nil, err
+	}
+
+	if len(val) != p256KeyLength {
+		log.Error(ctx, "incorrect private key", "keyID", keyID)
+		return nil, errors.New("incorrect private key")
+	}
+	return val, nil
+}
+
+func (ls *localP256KeyProvider) exportKey(ctx context.Context, keyID KeyID) ([]byte, error) {
+	return ls.privateKey(ctx, keyID)
+}
+
+func (ls *localP256KeyProvider) importKeyID(identity *w3c.DID, keyID KeyID, publicKey []byte) string {
+	if identity == nil {
+		return keyID.ID
+	}
+	return getKeyID(identity, ls.keyType, hex.EncodeToString(publicKey))
+}
+
+func (ls *localP256KeyProvider) importKey(ctx context.Context, identity *w3c.DID, keyID KeyID, privateKey []byte) (KeyID, error) {
+	publicKey, err := publicKeyFromPrivate(ls.keyType, privateKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+	newKeyID := KeyID{Type: ls.keyType, ID: ls.importKeyID(identity, keyID, publicKey)}
+	keyMaterial := map[string]string{
+		jsonKeyType: string(KeyTypeP256),
+		jsonKeyData: hex.EncodeToString(privateKey),
+	}
+	return newKeyID, ls.storageManager.SaveKeyMaterial(ctx, keyMaterial, newKeyID.ID)
+}
@@ -0,0 +1,102 @@
+package kms
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_New_LocalP256Provider(t *testing.T) {
+	tmpFile, err := createTestFile(t)
+	require.NoError(t, err)
+	//nolint:errcheck
+	defer os.Remove(tmpFile.Name())
+	ls := NewFileStorageManager(tmpFile.Name())
+
+	t.Run("should generate a new keyID using local storage manager", func(t *testing.T) {
+		localP256KeyProvider := NewLocalP256KeyProvider(KeyTypeP256, ls)
+		keyID, err := localP256KeyProvider.New(nil)
+		require.NoError(t, err)
+		keyIDParts := strings.Split(keyID.ID, ":")
+		require.Len(t, keyIDParts, 2)
+		assert.Equal(t, string(KeyTypeP256), keyIDParts[0])
+		assert.Len(t, keyIDParts[1], 2*p256CompressedLength)
+	})
+}
+
+func Test_SignAndLink_LocalP256KeyProvider(t *testing.T) {
+	ctx := context.Background()
+	tmpFile, err := createTestFile(t)
+	require.NoError(t, err)
+	//nolint:errcheck
+	defer os.Remove(tmpFile.Name())
+	ls := NewFileStorageManager(tmpFile.Name())
+	localP256KeyProvider := NewLocalP256KeyProvider(KeyTypeP256, ls)
+	did := randomDID(t)
+
+	keyID, err := localP256KeyProvider.New(nil)
+	require.NoError(t, err)
+	publicKey, err := localP256KeyProvider.PublicKey(keyID)
+	require.NoError(t, err)
+
+	linkedKeyID, err := localP256KeyProvider.LinkToIdentity(ctx, keyID, did)
+	require.NoError(t, err)
+	assert.Equal(t, did.String()+"/"+keyID.ID, linkedKeyID.ID)
+
+	t.Run("should list the key of the identity", func(t *testing.T) {
+		keys, err := localP256KeyProvider.ListByIdentity(ctx, did)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, linkedKeyID, keys[0])
+	})
+
+	t.Run("should return the same public key after linking", func(t *testing.T) {
+		linkedPublicKey, err := localP256KeyProvider.PublicKey(linkedKeyID)
+		require.NoError(t, err)
+		assert.Equal(t, publicKey, linkedPublicKey)
+	})
+
+	t.Run("should sign with the key", func(t *testing.T) {
+		digest := sha256.Sum256([]byte("payload"))
+		signature, err := localP256KeyProvider.Sign(ctx, linkedKeyID, digest[:])
+		require.NoError(t, err)
+		require.Len(t, signature, P256SignatureLength)
+
+		pub, err := DecodeP256PubKey(publicKey)
+		require.NoError(t, err)
+		assert.True(t, VerifyP256Signature(pub, digest[:], signature))
+		other := sha256.Sum256([]byte("other payload"))
+		assert.False(t, VerifyP256Signature(pub, other[:], signature))
+	})
+
+	t.Run("should delete the key", func(t *testing.T) {
+		require.NoError(t, localP256KeyProvider.Delete(ctx, linkedKeyID))
+		exists, err := localP256KeyProvider.Exists(ctx, linkedKeyID)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestP256SignatureFromDER(t *testing.T) {
+	privKeyData, publicKey, err := newP256PrivateKey()
+	require.NoError(t, err)
+	privKey, err := decodeP256PrivateKey(privKeyData)
+	require.NoError(t, err)
+
+	digest := sha256.Sum256([]byte("payload"))
+	der, err := ecdsa.SignASN1(rand.Reader, privKey, digest[:])
+	require.NoError(t, err)
+	signature, err := p256SignatureFromDER(der)
+	require.NoError(t, err)
+
+	pub, err := DecodeP256PubKey(publicKey)
+	require.NoError(t, err)
+	assert.True(t, VerifyP256Signature(pub, digest[:], signature))
+}
@@ -0,0 +1,432 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-iden3-crypto/utils"
+	"github.com/pkg/errors"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// MigrationProvider is a key backend the keys can be migrated from or to
+type MigrationProvider string
+
+// List of the key backends supported by the key migration
+const (
+	MigrationProviderLocalStorage     MigrationProvider = "localstorage"       // MigrationProviderLocalStorage local storage file
+	MigrationProviderVault            MigrationProvider = "vault"              // MigrationProviderVault vault kv v2 secrets
+	MigrationProviderVaultPluginIden3 MigrationProvider = "vault-plugin-iden3" // MigrationProviderVaultPluginIden3 vault iden3 plugin
+	MigrationProviderAWSSM            MigrationProvider = "aws-sm"             // MigrationProviderAWSSM AWS secrets manager
+	MigrationProviderAWSKMS           MigrationProvider = "aws-kms"            // MigrationProviderAWSKMS AWS KMS, Ethereum keys only
+)
+
+// MigrationProviders returns all the key backends supported by the key migration
+func MigrationProviders() []MigrationProvider {
+	return []MigrationProvider{
+		MigrationProviderLocalStorage, MigrationProviderVault, MigrationProviderVaultPluginIden3,
+		MigrationProviderAWSSM, MigrationProviderAWSKMS,
+	}
+}
+
+// MigrationAction is what the migration does with a key
+type MigrationAction string
+
+// List of migration actions
+const (
+	MigrationActionCreate MigrationAction = "create" // MigrationActionCreate the key is imported in the destination
+	MigrationActionExists MigrationAction = "exists" // MigrationActionExists the destination already has the key
+	MigrationActionSkip   MigrationAction = "skip"   // MigrationActionSkip the key can not be migrated, see the reason
+)
+
+// KeyMigration describes the migration of a key. Destination is empty when the destination provider assigns the
+// key id on import (aws-kms) and the key is not migrated yet.
+type KeyMigration struct {
+	Identity    *w3c.DID
+	Source      KeyID
+	Destination KeyID
+	Action      MigrationAction
+	Reason      string
+	Verified    bool
+	publicKey   []byte
+}
+
+// IDChanged returns true if the key has a different id in the destination. Stored references to the key, like
+// payment option signing keys or key policies, have to be updated.
+func (m KeyMigration) IDChanged() bool {
+	return m.Destination.ID != "" && m.Destination.ID != m.Source.ID
+}
+
+// keyExporter is implemented by the key providers that can return the private key of their keys.
+// The private key is returned in its raw form: the 32 bytes scalar for BJJ and ETH and the seed for Ed25519.
+type keyExporter interface {
+	exportKey(ctx context.Context, keyID KeyID) ([]byte, error)
+}
+
+// keyImporter is implemented by the key providers that can store an existing private key
+type keyImporter interface {
+	// importKeyID returns the id the key will have once imported. Keys bound to the identity get the path the
+	// provider gives to new keys of the identity and unbound keys keep their id. It is empty if the provider
+	// assigns the id on import.
+	importKeyID(identity *w3c.DID, keyID KeyID, publicKey []byte) string
+	// importKey stores the private key and returns its id
+	importKey(ctx context.Context, identity *w3c.DID, keyID KeyID, privateKey []byte) (KeyID, error)
+}
+
+// OpenForMigration returns a KMS with the providers of every key type the backend supports. Unlike
+// OpenWithConfig, vault is the kv v2 secrets engine and the iden3 plugin is vault-plugin-iden3.
+func OpenForMigration(ctx context.Context, provider MigrationProvider, config Config) (*KMS, error) {
+	providers := make(map[KeyType]KeyProvider)
+	switch provider {
+	case MigrationProviderLocalStorage, MigrationProviderAWSSM:
+		var storageManager StorageManager
+		var err error
+		if provider == MigrationProviderLocalStorage {
+			storageManager, err = newLocalStorageManager(ctx, config)
+		} else {
+			storageManager, err = NewAwsSecretStorageProvider(ctx, AwsSecretStorageProviderConfig{
+				AccessKey: config.AWSAccessKey,
+				SecretKey: config.AWSSecretKey,
+				Region:    config.AWSRegion,
+				URL:       config.AWSURL,
+			})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot create %s storage: %w", provider, err)
+		}
+		providers[KeyTypeBabyJubJub] = NewLocalBJJKeyProvider(KeyTypeBabyJubJub, storageManager)
+		providers[KeyTypeEthereum] = NewLocalEthKeyProvider(KeyTypeEthereum, storageManager)
+		providers[KeyTypeEd25519] = NewLocalEd25519KeyProvider(KeyTypeEd25519, storageManager)
+		providers[KeyTypeP256] = NewLocalP256KeyProvider(KeyTypeP256, storageManager)
+	case MigrationProviderVault:
+		if config.Vault == nil {
+			return nil, errors.New("vault client is not provided")
+		}
+		providers[KeyTypeBabyJubJub] = NewVaultBJJKeyProvider(config.Vault, KeyTypeBabyJubJub)
+		providers[KeyTypeEthereum] = NewVaultEthProvider(config.Vault, KeyTypeEthereum)
+		providers[KeyTypeP256] = NewVaultP256KeyProvider(config.Vault, KeyTypeP256)
+	case MigrationProviderVaultPluginIden3:
+		if config.Vault == nil {
+			return nil, errors.New("vault client is not provided")
+		}
+		for _, kt := range []KeyType{KeyTypeBabyJubJub, KeyTypeEthereum, KeyTypeEd25519} {
+			kp, err := NewVaultPluginIden3KeyProvider(config.Vault, config.PluginIden3MountPath, kt)
+			if err != nil {
+				return nil, fmt.Errorf("cannot create %s key provider: %w", kt, err)
+			}
+			providers[kt] = kp
+		}
+	case MigrationProviderAWSKMS:
+		kp, err := NewAwsKMSEthKeyProvider(ctx, KeyTypeEthereum, config.IssuerETHTransferKeyPath, AwKmsEthKeyProviderConfig{
+			AccessKey: config.AWSAccessKey,
+			SecretKey: config.AWSSecretKey,
+			Region:    config.AWSRegion,
+			URL:       config.AWSURL,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot create Ethereum aws kms key provider: %w", err)
+		}
+		providers[KeyTypeEthereum] = kp
+	default:
+		return nil, fmt.Errorf("unknown key provider %q", provider)
+	}
+
+	keyStore := NewKMS()
+	for kt, kp := range providers {
+		if err := keyStore.RegisterKeyProvider(kt, kp); err != nil {
+			return nil, err
+		}
+	}
+	return keyStore, nil
+}
+
+// KeyMigrator copies keys between two key management systems
+type KeyMigrator struct {
+	from *KMS
+	to   *KMS
+}
+
+// NewKeyMigrator returns a migrator of the keys of from into to
+func NewKeyMigrator(from, to *KMS) *KeyMigrator {
+	return &KeyMigrator{from: from, to: to}
+}
+
+// Plan returns the migration of the keys of the identities and of the unbound keys, like the publishing key,
+// without changing the destination. It is the dry run of Migrate. Only keys of the given types are planned,
+// all of them if keyTypes is empty.
+func (m *KeyMigrator) Plan(ctx context.Context, identities []w3c.DID, unbound []KeyID, keyTypes []KeyType) ([]KeyMigration, error) {
+	plan := make([]KeyMigration, 0)
+	for i := range identities {
+		keys, err := m.from.KeysByIdentity(ctx, identities[i])
+		if err != nil {
+			return nil, fmt.Errorf("cannot list keys of %s: %w", identities[i].String(), err)
+		}
+		for _, keyID := range keys {
+			if len(keyTypes) > 0 && !slices.Contains(keyTypes, keyID.Type) {
+				continue
+			}
+			plan = append(plan, m.plan(ctx, &identities[i], keyID))
+		}
+	}
+	for _, keyID := range unbound {
+		if len(keyTypes) > 0 && !slices.Contains(keyTypes, keyID.Type) {
+			continue
+		}
+		plan = append(plan, m.plan(ctx, nil, keyID))
+	}
+	return plan, nil
+}
+
+// Migrate imports the planned keys in the destination and verifies every migrated key, signing with the
+// destination and checking the signature with the source public key. Keys that already exist are verified too.
+func (m *KeyMigrator) Migrate(ctx context.Context, plan []KeyMigration) ([]KeyMigration, error) {
+	result := make([]KeyMigration, 0, len(plan))
+	var migrationErr error
+	for _, item := range plan {
+		if item.Action == MigrationActionCreate {
+			destination, err := m.importKey(ctx, item)
+			if err != nil {
+				log.Error(ctx, "cannot import key", "err", err, "keyID", item.Source.ID)
+				item.Action = MigrationActionSkip
+				item.Reason = err.Error()
+				migrationErr = errors.New("some keys could not be migrated")
+				result = append(result, item)
+				continue
+			}
+			item.Destination = destination
+		}
+		if item.Action != MigrationActionSkip {
+			if err := m.verify(ctx, item); err != nil {
+				log.Error(ctx, "cannot verify migrated key", "err", err, "keyID", item.Destination.ID)
+				item.Reason = err.Error()
+				migrationErr = errors.New("some keys could not be verified")
+			} else {
+				item.Verified = true
+			}
+		}
+		result = append(result, item)
+	}
+	return result, migrationErr
+}
+
+func (m *KeyMigrator) plan(ctx context.Context, identity *w3c.DID, keyID KeyID) KeyMigration {
+	item := KeyMigration{Identity: identity, Source: keyID, Action: MigrationActionSkip}
+
+	privateKey, err := m.exportKey(ctx, keyID)
+	if err != nil {
+		item.Reason = err.Error()
+		return item
+	}
+	if item.publicKey, err = publicKeyFromPrivate(keyID.Type, privateKey); err != nil {
+		item.Reason = err.Error()
+		return item
+	}
+
+	kp, ok := m.to.registry[keyID.Type]
+	if !ok {
+		item.Reason = fmt.Sprintf("the destination does not support %s keys", keyID.Type)
+		return item
+	}
+	importer, ok := kp.(keyImporter)
+	if !ok {
+		item.Reason = fmt.Sprintf("the destination can not import %s keys", keyID.Type)
+		return item
+	}
+
+	existing, found, err := findKey(ctx, kp, identity, keyID, item.publicKey)
+	if err != nil {
+		item.Reason = err.Error()
+		return item
+	}
+	if found {
+		item.Action = MigrationActionExists
+		item.Destination = existing
+		return item
+	}
+
+	item.Action = MigrationActionCreate
+	item.Destination = KeyID{Type: keyID.Type, ID: importer.importKeyID(identity, keyID, item.publicKey)}
+	return item
+}
+
+// findKey looks for the key in the destination. Keys bound to the identity are matched by public key and unbound
+// keys by id. An unbound id holding a different key is an error, it would be overwritten.
+func findKey(ctx context.Context, kp KeyProvider, identity *w3c.DID, keyID KeyID, publicKey []byte) (KeyID, bool, error) {
+	if identity == nil {
+		pub, err := kp.PublicKey(keyID)
+		if err != nil {
+			return KeyID{}, false, nil
+		}
+		pub, err = normalizePublicKey(ctx, keyID.Type, pub)
+		if err != nil {
+			return KeyID{}, false, err
+		}
+		if !bytes.Equal(pub, publicKey) {
+			return KeyID{}, false, errors.New("the destination has a different key with the same id")
+		}
+		return keyID, true, nil
+	}
+
+	keys, err := kp.ListByIdentity(ctx, *identity)
+	if err != nil {
+		return KeyID{}, false, fmt.Errorf("cannot list destination keys: %w", err)
+	}
+	for _, destID := range keys {
+		if destID.Type != keyID.Type {
+			continue
+		}
+		pub, err := kp.PublicKey(destID)
+		if err != nil {
+			continue
+		}
+		if pub, err = normalizePublicKey(ctx, keyID.Type, pub); err == nil && bytes.Equal(pub, publicKey) {
+			return destID, true, nil
+		}
+	}
+	return KeyID{}, false, nil
+}
+
+func (m *KeyMigrator) exportKey(ctx context.Context, keyID KeyID) ([]byte, error) {
+	kp, ok := m.from.registry[keyID.Type]
+	if !ok {
+		return nil, errors.WithStack(ErrUnknownKeyType)
+	}
+	exporter, ok := kp.(keyExporter)
+	if !ok {
+		return nil, fmt.Errorf("the source can not export %s keys", keyID.Type)
+	}
+	return exporter.exportKey(ctx, keyID)
+}
+
+func (m *KeyMigrator) importKey(ctx context.Context, item KeyMigration) (KeyID, error) {
+	privateKey, err := m.exportKey(ctx, item.Source)
+	if err != nil {
+		return KeyID{}, err
+	}
+	importer, ok := m.to.registry[item.Source.Type].(keyImporter)
+	if !ok {
+		return KeyID{}, fmt.Errorf("the destination can not import %s keys", item.Source.Type)
+	}
+	return importer.importKey(ctx, item.Identity, item.Source, privateKey)
+}
+
+// verify signs a test digest with the destination key and checks it with the public key of the source
+func (m *KeyMigrator) verify(ctx context.Context, item KeyMigration) error {
+	kp, ok := m.to.registry[item.Destination.Type]
+	if !ok {
+		return errors.WithStack(ErrUnknownKeyType)
+	}
+	return verifyKey(ctx, kp, item.Destination, item.publicKey)
+}
+
+// verifyKey signs a test digest with the key and checks the signature with the expected public key
+func verifyKey(ctx context.Context, kp KeyProvider, keyID KeyID, publicKey []byte) error {
+	digest := sha256.Sum256([]byte("issuer node key verification " + keyID.ID))
+	if keyID.Type == KeyTypeBabyJubJub {
+		// the little-endian digest has to be in the field
+		digest[len(digest)-1] = 0
+	}
+
+	signature, err := kp.Sign(ctx, keyID, digest[:])
+	if err != nil {
+		return fmt.Errorf("cannot sign with the key: %w", err)
+	}
+
+	var valid bool
+	switch keyID.Type {
+	case KeyTypeBabyJubJub:
+		pub, err := DecodeBJJPubKey(publicKey)
+		if err != nil {
+			return err
+		}
+		sig, err := DecodeBJJSignature(signature)
+		if err != nil {
+			return err
+		}
+		valid = pub.VerifyPoseidon(new(big.Int).SetBytes(utils.SwapEndianness(digest[:])), sig)
+	case KeyTypeEthereum:
+		if len(signature) < crypto.SignatureLength-1 {
+			return errors.New("unexpected signature length")
+		}
+		valid = crypto.VerifySignature(publicKey, digest[:], signature[:crypto.SignatureLength-1])
+	case KeyTypeEd25519:
+		valid = ed25519.Verify(publicKey, digest[:], signature)
+	case KeyTypeP256:
+		pub, err := DecodeP256PubKey(publicKey)
+		if err != nil {
+			return err
+		}
+		valid = VerifyP256Signature(pub, digest[:], signature)
+	default:
+		return errors.WithStack(ErrUnknownKeyType)
+	}
+	if !valid {
+		return errors.New("the signature of the key does not match the expected public key")
+	}
+	return nil
+}
+
+// publicKeyFromPrivate returns the public key in the form used to compare keys across providers: compressed for
+// BJJ, ETH and P256 and raw for Ed25519
+func publicKeyFromPrivate(keyType KeyType, privateKey []byte) ([]byte, error) {
+	switch keyType {
+	case KeyTypeBabyJubJub:
+		privKey, err := decodeBJJPrivateKey(privateKey)
+		if err != nil {
+			return nil, err
+		}
+		pub := privKey.Public().Compress()
+		return pub[:], nil
+	case KeyTypeEthereum:
+		privKey, err := decodeETHPrivateKey(privateKey)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.CompressPubkey(&privKey.PublicKey), nil
+	case KeyTypeEd25519:
+		if len(privateKey) != ed25519.SeedSize {
+			return nil, errors.New("unexpected length of ed25519 seed")
+		}
+		return ed25519.NewKeyFromSeed(privateKey).Public().(ed25519.PublicKey), nil
+	case KeyTypeP256:
+		privKey, err := decodeP256PrivateKey(privateKey)
+		if err != nil {
+			return nil, err
+		}
+		return CompressP256PubKey(&privKey.PublicKey), nil
+	default:
+		return nil, errors.WithStack(ErrUnknownKeyType)
+	}
+}
+
+// normalizePublicKey converts the public key returned by a provider to the form of publicKeyFromPrivate.
+// Ethereum public keys can be compressed, uncompressed or DER encoded (aws-kms).
+func normalizePublicKey(ctx context.Context, keyType KeyType, publicKey []byte) ([]byte, error) {
+	if keyType != KeyTypeEthereum {
+		return publicKey, nil
+	}
+	switch len(publicKey) {
+	case 33: // nolint:mnd
+		return publicKey, nil
+	case 65: // nolint:mnd
+		pub, err := crypto.UnmarshalPubkey(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.CompressPubkey(pub), nil
+	default:
+		pub, err := DecodeAWSETHPubKey(ctx, publicKey)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.CompressPubkey(pub), nil
+	}
+}
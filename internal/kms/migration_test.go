@@ -0,0 +1,111 @@
+package kms
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyMigrator_Migrate(t *testing.T) {
+	ctx := context.Background()
+	did, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qQ68JkRcf3ybQNvgRV9BP6qLgBrXmUezqBi4wsEuV")
+	require.NoError(t, err)
+
+	from, err := OpenForMigration(ctx, MigrationProviderLocalStorage, Config{LocalStoragePath: t.TempDir()})
+	require.NoError(t, err)
+	to, err := OpenForMigration(ctx, MigrationProviderLocalStorage, Config{LocalStoragePath: t.TempDir()})
+	require.NoError(t, err)
+
+	bjjKeyID, err := from.CreateKey(KeyTypeBabyJubJub, did)
+	require.NoError(t, err)
+	ethKeyID, err := from.CreateKey(KeyTypeEthereum, nil)
+	require.NoError(t, err)
+	ethKeyID, err = from.LinkToIdentity(ctx, ethKeyID, *did)
+	require.NoError(t, err)
+	ed25519KeyID, err := from.CreateKey(KeyTypeEd25519, nil)
+	require.NoError(t, err)
+	ed25519KeyID, err = from.LinkToIdentity(ctx, ed25519KeyID, *did)
+	require.NoError(t, err)
+
+	publishKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	publishKeyID := KeyID{Type: KeyTypeEthereum, ID: "pbkey"}
+	require.NoError(t, from.registry[KeyTypeEthereum].(*localEthKeyProvider).storageManager.SaveKeyMaterial(ctx, map[string]string{
+		jsonKeyType: string(KeyTypeEthereum),
+		jsonKeyData: hex.EncodeToString(crypto.FromECDSA(publishKey)),
+	}, publishKeyID.ID))
+
+	migrator := NewKeyMigrator(from, to)
+	unbound := []KeyID{publishKeyID, {Type: KeyTypeEthereum, ID: "missing"}}
+
+	t.Run("should plan the migration without changing the destination", func(t *testing.T) {
+		plan, err := migrator.Plan(ctx, []w3c.DID{*did}, unbound, nil)
+		require.NoError(t, err)
+		require.Len(t, plan, 5)
+		actions := make(map[string]MigrationAction)
+		for _, item := range plan {
+			actions[item.Source.ID] = item.Action
+		}
+		assert.Equal(t, MigrationActionCreate, actions[bjjKeyID.ID])
+		assert.Equal(t, MigrationActionCreate, actions[ethKeyID.ID])
+		assert.Equal(t, MigrationActionCreate, actions[ed25519KeyID.ID])
+		assert.Equal(t, MigrationActionCreate, actions[publishKeyID.ID])
+		assert.Equal(t, MigrationActionSkip, actions["missing"])
+
+		keys, err := to.KeysByIdentity(ctx, *did)
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("should filter by key type", func(t *testing.T) {
+		plan, err := migrator.Plan(ctx, []w3c.DID{*did}, unbound, []KeyType{KeyTypeBabyJubJub})
+		require.NoError(t, err)
+		require.Len(t, plan, 1)
+		assert.Equal(t, bjjKeyID, plan[0].Source)
+	})
+
+	t.Run("should migrate and verify the keys", func(t *testing.T) {
+		plan, err := migrator.Plan(ctx, []w3c.DID{*did}, unbound[:1], nil)
+		require.NoError(t, err)
+		result, err := migrator.Migrate(ctx, plan)
+		require.NoError(t, err)
+		require.Len(t, result, 4)
+		for _, item := range result {
+			assert.True(t, item.Verified, item.Source.ID)
+			assert.Equal(t, item.Source, item.Destination)
+			assert.False(t, item.IDChanged())
+		}
+
+		keys, err := to.KeysByIdentity(ctx, *did)
+		require.NoError(t, err)
+		assert.Len(t, keys, 3)
+	})
+
+	t.Run("should find the migrated keys in the destination", func(t *testing.T) {
+		plan, err := migrator.Plan(ctx, []w3c.DID{*did}, unbound[:1], nil)
+		require.NoError(t, err)
+		require.Len(t, plan, 4)
+		for _, item := range plan {
+			assert.Equal(t, MigrationActionExists, item.Action, item.Source.ID)
+		}
+	})
+}
+
+func TestSecp256k1PKCS8(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	der, err := secp256k1PKCS8(crypto.FromECDSA(privateKey))
+	require.NoError(t, err)
+
+	// same encoding as aws_kms_material_key_importer.sh, the curve is not supported by x509
+	_, err = x509.ParsePKCS8PrivateKey(der)
+	assert.ErrorContains(t, err, "unknown elliptic curve")
+	assert.Contains(t, hex.EncodeToString(der), "302e0201010420"+hex.EncodeToString(crypto.FromECDSA(privateKey))+"a00706052b8104000a")
+}
@@ -0,0 +1,129 @@
+package kms
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/asn1"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	p256KeyLength          = 32
+	p256CompressedLength   = 33
+	p256UncompressedLength = 65
+	// P256SignatureLength is the length of the r||s signatures of P-256 keys, the JWS ES256 encoding
+	P256SignatureLength = 64
+)
+
+// DecodeP256PubKey is a helper method to convert the byte representation of a P-256 public key, compressed,
+// uncompressed or DER encoded (aws-kms), to *ecdsa.PublicKey
+func DecodeP256PubKey(key []byte) (*ecdsa.PublicKey, error) {
+	switch len(key) {
+	case p256CompressedLength:
+		x, y := elliptic.UnmarshalCompressed(elliptic.P256(), key)
+		if x == nil {
+			return nil, errors.New("invalid P-256 public key")
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+	case p256UncompressedLength:
+		if _, err := ecdh.P256().NewPublicKey(key); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(key[1:p256CompressedLength]),
+			Y:     new(big.Int).SetBytes(key[p256CompressedLength:]),
+		}, nil
+	default:
+		pub, err := x509.ParsePKIXPublicKey(key)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		ecdsaPub, ok := pub.(*ecdsa.PublicKey)
+		if !ok || ecdsaPub.Curve != elliptic.P256() {
+			return nil, errors.New("invalid P-256 public key")
+		}
+		return ecdsaPub, nil
+	}
+}
+
+// CompressP256PubKey returns the compressed SEC1 form of the public key
+func CompressP256PubKey(pub *ecdsa.PublicKey) []byte {
+	return elliptic.MarshalCompressed(elliptic.P256(), pub.X, pub.Y)
+}
+
+// decodeP256PrivateKey is a helper method to convert the byte representation of a P-256 private key
+// to *ecdsa.PrivateKey
+func decodeP256PrivateKey(key []byte) (*ecdsa.PrivateKey, error) {
+	if len(key) != p256KeyLength {
+		return nil, errors.New("incorrect private key")
+	}
+	ecdhKey, err := ecdh.P256().NewPrivateKey(key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	pub := ecdhKey.PublicKey().Bytes()
+	return &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(pub[1:p256CompressedLength]),
+			Y:     new(big.Int).SetBytes(pub[p256CompressedLength:]),
+		},
+		D: new(big.Int).SetBytes(key),
+	}, nil
+}
+
+// newP256PrivateKey returns the bytes of a random P-256 private key and its compressed public key
+func newP256PrivateKey() ([]byte, []byte, error) {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	privKey, err := decodeP256PrivateKey(key.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+	return key.Bytes(), CompressP256PubKey(&privKey.PublicKey), nil
+}
+
+// signP256 signs the digest and returns the r||s signature
+func signP256(privKey *ecdsa.PrivateKey, digest []byte) ([]byte, error) {
+	r, s, err := ecdsa.Sign(rand.Reader, privKey, digest)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return p256Signature(r, s), nil
+}
+
+// p256SignatureFromDER converts an ASN.1 DER encoded ECDSA signature, returned by aws-kms, to r||s
+func p256SignatureFromDER(der []byte) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(der, &sig); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return p256Signature(sig.R, sig.S), nil
+}
+
+// VerifyP256Signature checks a r||s signature of the digest
+func VerifyP256Signature(pub *ecdsa.PublicKey, digest, signature []byte) bool {
+	if len(signature) != P256SignatureLength {
+		return false
+	}
+	r := new(big.Int).SetBytes(signature[:p256KeyLength])
+	s := new(big.Int).SetBytes(signature[p256KeyLength:])
+	return ecdsa.Verify(pub, digest, r, s)
+}
+
+func p256Signature(r, s *big.Int) []byte {
+	signature := make([]byte, P256SignatureLength)
+	r.FillBytes(signature[:p256KeyLength])
+	s.FillBytes(signature[p256KeyLength:])
+	return signature
+}
@@ -0,0 +1,435 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/asn1"
+	"encoding/hex"
+	stderr "errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gagliardetto/solana-go"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/miekg/pkcs11"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// PKCS#11 v3.0 Edwards curve constants, not defined by the pkcs11 package
+const (
+	ckkECEdwards           = 0x00000040
+	ckmECEdwardsKeyPairGen = 0x00001055
+	ckmEdDSA               = 0x00001057
+)
+
+const pkcs11FindObjectsMax = 100
+
+var (
+	// DER encoded OIDs of the curves, used as CKA_EC_PARAMS
+	oidSecp256k1 = []byte{0x06, 0x05, 0x2b, 0x81, 0x04, 0x00, 0x0a}
+	oidEd25519   = []byte{0x06, 0x03, 0x2b, 0x65, 0x70}
+
+	pkcs11TokensMu sync.Mutex
+	pkcs11Tokens   = make(map[string]*pkcs11Token)
+)
+
+// PKCS11Config - configuration for the PKCS#11 key provider
+type PKCS11Config struct {
+	ModulePath string
+	TokenLabel string
+	Pin        string
+}
+
+// pkcs11Token is a logged in session with a token. PKCS#11 sessions can not be used concurrently,
+// so every operation holds the mutex.
+type pkcs11Token struct {
+	mu      sync.Mutex
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+}
+
+type pkcs11KeyProvider struct {
+	keyType KeyType
+	token   *pkcs11Token
+}
+
+// NewPKCS11KeyProvider - creates new key provider for Ethereum (secp256k1) or Ed25519 keys stored in a PKCS#11 token,
+// like an HSM or SoftHSM. Keys never leave the token, the key ID is stored as the label of the key objects.
+func NewPKCS11KeyProvider(keyType KeyType, cfg PKCS11Config) (KeyProvider, error) {
+	if keyType != KeyTypeEthereum && keyType != KeyTypeEd25519 {
+		return nil, ErrIncorrectKeyType
+	}
+	token, err := openPKCS11Token(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &pkcs11KeyProvider{keyType: keyType, token: token}, nil
+}
+
+// openPKCS11Token returns a session with the token. The session is shared by the providers using the same token,
+// as a PKCS#11 module can only be initialized once per process.
+func openPKCS11Token(cfg PKCS11Config) (*pkcs11Token, error) {
+	pkcs11TokensMu.Lock()
+	defer pkcs11TokensMu.Unlock()
+
+	key := cfg.ModulePath + "/" + cfg.TokenLabel
+	if token, ok := pkcs11Tokens[key]; ok {
+		return token, nil
+	}
+
+	p := pkcs11.New(cfg.ModulePath)
+	if p == nil {
+		return nil, fmt.Errorf("cannot load PKCS#11 module %s", cfg.ModulePath)
+	}
+	if err := p.Initialize(); err != nil && !isPKCS11Error(err, pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED) {
+		return nil, fmt.Errorf("cannot initialize PKCS#11 module: %w", err)
+	}
+
+	slots, err := p.GetSlotList(true)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list PKCS#11 slots: %w", err)
+	}
+	for _, slot := range slots {
+		info, err := p.GetTokenInfo(slot)
+		if err != nil || strings.TrimSpace(info.Label) != cfg.TokenLabel {
+			continue
+		}
+		session, err := p.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+		if err != nil {
+			return nil, fmt.Errorf("cannot open PKCS#11 session: %w", err)
+		}
+		if err := p.Login(session, pkcs11.CKU_USER, cfg.Pin); err != nil && !isPKCS11Error(err, pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+			return nil, fmt.Errorf("cannot login to PKCS#11 token: %w", err)
+		}
+		token := &pkcs11Token{ctx: p, session: session}
+		pkcs11Tokens[key] = token
+		return token, nil
+	}
+
+	return nil, fmt.Errorf("PKCS#11 token %s not found", cfg.TokenLabel)
+}
+
+func isPKCS11Error(err error, code uint) bool {
+	var pErr pkcs11.Error
+	return stderr.As(err, &pErr) && uint(pErr) == code
+}
+
+func (p *pkcs11KeyProvider) New(identity *w3c.DID) (KeyID, error) {
+	keyID := KeyID{Type: p.keyType}
+
+	p.token.mu.Lock()
+	defer p.token.mu.Unlock()
+
+	// the key is created with a temporary label and labeled with its id once the public key is known
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return keyID, err
+	}
+	tmpLabel := "tmp:" + hex.EncodeToString(nonce)
+	mechanism, keyType, params := p.keyGeneration()
+	pub, priv, err := p.token.ctx.GenerateKeyPair(p.token.session,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
+			pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, keyType),
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, params),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, tmpLabel),
+		},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+			pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, keyType),
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+			pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, tmpLabel),
+		})
+	if err != nil {
+		return keyID, fmt.Errorf("cannot generate key pair: %w", err)
+	}
+
+	pubKey, err := p.publicKeyFromObject(pub)
+	if err != nil {
+		return keyID, err
+	}
+
+	// same key ids as the local storage providers: hex compressed key for Ethereum and base58 for Ed25519
+	keyID.ID = getKeyID(identity, p.keyType, hex.EncodeToString(pubKey))
+	if p.keyType == KeyTypeEd25519 {
+		keyID.ID = getKeyID(identity, p.keyType, solana.PublicKeyFromBytes(pubKey).String())
+	}
+	if err := p.setLabel([]pkcs11.ObjectHandle{pub, priv}, keyID.ID); err != nil {
+		return KeyID{}, err
+	}
+	return keyID, nil
+}
+
+// PublicKey returns the compressed public key for Ethereum keys and the raw public key for Ed25519 keys
+func (p *pkcs11KeyProvider) PublicKey(keyID KeyID) ([]byte, error) {
+	if keyID.Type != p.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+
+	p.token.mu.Lock()
+	defer p.token.mu.Unlock()
+
+	pub, err := p.findObject(pkcs11.CKO_PUBLIC_KEY, keyID.ID)
+	if err != nil {
+		return nil, err
+	}
+	return p.publicKeyFromObject(pub)
+}
+
+// Sign returns a [R || S || V] signature for Ethereum keys and an Ed25519 signature for Ed25519 keys
+func (p *pkcs11KeyProvider) Sign(ctx context.Context, keyID KeyID, data []byte) ([]byte, error) {
+	if keyID.Type != p.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+
+	p.token.mu.Lock()
+	defer p.token.mu.Unlock()
+
+	priv, err := p.findObject(pkcs11.CKO_PRIVATE_KEY, keyID.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	mechanism := uint(pkcs11.CKM_ECDSA)
+	if p.keyType == KeyTypeEd25519 {
+		mechanism = ckmEdDSA
+	}
+	if err := p.token.ctx.SignInit(p.token.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)}, priv); err != nil {
+		log.Error(ctx, "cannot init PKCS#11 signature", "err", err, "keyID", keyID)
+		return nil, err
+	}
+	signature, err := p.token.ctx.Sign(p.token.session, data)
+	if err != nil {
+		log.Error(ctx, "cannot sign with PKCS#11 key", "err", err, "keyID", keyID)
+		return nil, err
+	}
+
+	if p.keyType == KeyTypeEd25519 {
+		return signature, nil
+	}
+
+	pub, err := p.findObject(pkcs11.CKO_PUBLIC_KEY, keyID.ID)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := p.publicKeyFromObject(pub)
+	if err != nil {
+		return nil, err
+	}
+	return ethSignatureFromRS(ctx, signature, pubKey, data)
+}
+
+func (p *pkcs11KeyProvider) LinkToIdentity(_ context.Context, keyID KeyID, identity w3c.DID) (KeyID, error) {
+	if keyID.Type != p.keyType {
+		return keyID, ErrIncorrectKeyType
+	}
+
+	p.token.mu.Lock()
+	defer p.token.mu.Unlock()
+
+	objects, err := p.findObjects(nil, keyID.ID)
+	if err != nil {
+		return keyID, err
+	}
+	if len(objects) == 0 {
+		return keyID, ErrKeyNotFound
+	}
+
+	keyID.ID = getKeyID(&identity, p.keyType, keyID.ID)
+	if err := p.setLabel(objects, keyID.ID); err != nil {
+		return KeyID{}, err
+	}
+	return keyID, nil
+}
+
+// ListByIdentity lists keys by identity
+func (p *pkcs11KeyProvider) ListByIdentity(_ context.Context, identity w3c.DID) ([]KeyID, error) {
+	p.token.mu.Lock()
+	defer p.token.mu.Unlock()
+
+	objects, err := p.findObjects([]*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, p.pkcs11KeyType()),
+	}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := identity.String() + "/" + string(p.keyType) + ":"
+	keyIDs := make([]KeyID, 0)
+	for _, object := range objects {
+		attrs, err := p.token.ctx.GetAttributeValue(p.token.session, object, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_LABEL, nil)})
+		if err != nil {
+			return nil, err
+		}
+		if label := string(attrs[0].Value); strings.HasPrefix(label, prefix) {
+			keyIDs = append(keyIDs, KeyID{Type: p.keyType, ID: label})
+		}
+	}
+	return keyIDs, nil
+}
+
+func (p *pkcs11KeyProvider) Delete(_ context.Context, keyID KeyID) error {
+	p.token.mu.Lock()
+	defer p.token.mu.Unlock()
+
+	objects, err := p.findObjects(nil, keyID.ID)
+	if err != nil {
+		return err
+	}
+	if len(objects) == 0 {
+		return ErrKeyNotFound
+	}
+	for _, object := range objects {
+		if err := p.token.ctx.DestroyObject(p.token.session, object); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *pkcs11KeyProvider) Exists(_ context.Context, keyID KeyID) (bool, error) {
+	p.token.mu.Lock()
+	defer p.token.mu.Unlock()
+
+	_, err := p.findObject(pkcs11.CKO_PRIVATE_KEY, keyID.ID)
+	if err != nil {
+		if stderr.Is(err, ErrKeyNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (p *pkcs11KeyProvider) keyGeneration() (mechanism uint, keyType uint, params []byte) {
+	if p.keyType == KeyTypeEd25519 {
+		return ckmECEdwardsKeyPairGen, ckkECEdwards, oidEd25519
+	}
+	return pkcs11.CKM_EC_KEY_PAIR_GEN, pkcs11.CKK_EC, oidSecp256k1
+}
+
+func (p *pkcs11KeyProvider) pkcs11KeyType() uint {
+	_, keyType, _ := p.keyGeneration()
+	return keyType
+}
+
+// findObject returns the key object of the given class with the given label
+func (p *pkcs11KeyProvider) findObject(class uint, label string) (pkcs11.ObjectHandle, error) {
+	objects, err := p.findObjects([]*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_CLASS, class)}, label)
+	if err != nil {
+		return 0, err
+	}
+	if len(objects) == 0 {
+		return 0, ErrKeyNotFound
+	}
+	return objects[0], nil
+}
+
+// findObjects returns the objects matching the template and, if not empty, the label
+func (p *pkcs11KeyProvider) findObjects(template []*pkcs11.Attribute, label string) ([]pkcs11.ObjectHandle, error) {
+	if label != "" {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_LABEL, label))
+	}
+	if err := p.token.ctx.FindObjectsInit(p.token.session, template); err != nil {
+		return nil, err
+	}
+
+	var objects []pkcs11.ObjectHandle
+	for {
+		found, _, err := p.token.ctx.FindObjects(p.token.session, pkcs11FindObjectsMax)
+		if err != nil {
+			_ = p.token.ctx.FindObjectsFinal(p.token.session)
+			return nil, err
+		}
+		objects = append(objects, found...)
+		if len(found) < pkcs11FindObjectsMax {
+			break
+		}
+	}
+	return objects, p.token.ctx.FindObjectsFinal(p.token.session)
+}
+
+func (p *pkcs11KeyProvider) setLabel(objects []pkcs11.ObjectHandle, label string) error {
+	for _, object := range objects {
+		if err := p.token.ctx.SetAttributeValue(p.token.session, object, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_LABEL, label)}); err != nil {
+			return fmt.Errorf("cannot label key: %w", err)
+		}
+	}
+	return nil
+}
+
+// publicKeyFromObject reads the CKA_EC_POINT of a public key object. Ethereum keys are returned compressed.
+func (p *pkcs11KeyProvider) publicKeyFromObject(object pkcs11.ObjectHandle) ([]byte, error) {
+	attrs, err := p.token.ctx.GetAttributeValue(p.token.session, object, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil)})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get public key: %w", err)
+	}
+
+	// the point should be DER encoded as an OCTET STRING, but some modules return it raw
+	point := attrs[0].Value
+	var decoded []byte
+	if rest, err := asn1.Unmarshal(point, &decoded); err == nil && len(rest) == 0 {
+		point = decoded
+	}
+
+	if p.keyType == KeyTypeEd25519 {
+		if len(point) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("unexpected Ed25519 public key length %d", len(point))
+		}
+		return point, nil
+	}
+
+	pubKey, err := crypto.UnmarshalPubkey(point)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode public key: %w", err)
+	}
+	return crypto.CompressPubkey(pubKey), nil
+}
+
+// ethSignatureFromRS converts a raw [R || S] ECDSA signature to the Ethereum format, normalizing S
+// and adding the recovery id.
+func ethSignatureFromRS(ctx context.Context, signature []byte, compressedPubKey []byte, data []byte) ([]byte, error) {
+	const (
+		rsLength     = 64
+		halfDivision = 2
+	)
+	if len(signature) != rsLength {
+		return nil, fmt.Errorf("unexpected signature length %d", len(signature))
+	}
+
+	pubKey, err := crypto.DecompressPubkey(compressedPubKey)
+	if err != nil {
+		return nil, err
+	}
+	pubKeyBytes := crypto.FromECDSAPub(pubKey)
+
+	secp256k1N := crypto.S256().Params().N
+	s := new(big.Int).SetBytes(signature[32:])
+	if s.Cmp(new(big.Int).Div(secp256k1N, big.NewInt(halfDivision))) > 0 {
+		s.Sub(secp256k1N, s)
+	}
+
+	ethSignature, err := getEthereumSignature(ctx, pubKeyBytes, data, bytes.Clone(signature[:32]), s.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if !verifySignature(pubKeyBytes, data, ethSignature) {
+		log.Error(ctx, "signature verification failed")
+		return nil, stderr.New("signature verification failed")
+	}
+	return ethSignature, nil
+}
@@ -0,0 +1,97 @@
+package kms
+
+import (
+	"context"
+	"crypto/ed25519"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pkcs11TestConfig returns the token used by the PKCS#11 tests. They run against SoftHSM, for example:
+//
+//	softhsm2-util --init-token --free --label issuer-node --pin 1234 --so-pin 1234
+//	ISSUER_KMS_PKCS11_TEST_MODULE=/usr/lib/softhsm/libsofthsm2.so ISSUER_KMS_PKCS11_TEST_TOKEN_LABEL=issuer-node \
+//	ISSUER_KMS_PKCS11_TEST_PIN=1234 go test ./internal/kms -run PKCS11
+func pkcs11TestConfig(t *testing.T) PKCS11Config {
+	t.Helper()
+	cfg := PKCS11Config{
+		ModulePath: os.Getenv("ISSUER_KMS_PKCS11_TEST_MODULE"),
+		TokenLabel: os.Getenv("ISSUER_KMS_PKCS11_TEST_TOKEN_LABEL"),
+		Pin:        os.Getenv("ISSUER_KMS_PKCS11_TEST_PIN"),
+	}
+	if cfg.ModulePath == "" || cfg.TokenLabel == "" || cfg.Pin == "" {
+		t.Skip("PKCS#11 test token is not configured")
+	}
+	return cfg
+}
+
+func TestPKCS11KeyProvider_Ethereum(t *testing.T) {
+	ctx := context.Background()
+	provider, err := NewPKCS11KeyProvider(KeyTypeEthereum, pkcs11TestConfig(t))
+	require.NoError(t, err)
+
+	keyID, err := provider.New(nil)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(keyID.ID, string(KeyTypeEthereum)+":"))
+
+	pubKey, err := provider.PublicKey(keyID)
+	require.NoError(t, err)
+	assert.Len(t, pubKey, 33)
+
+	did := randomDID(t)
+	keyID, err = provider.LinkToIdentity(ctx, keyID, did)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(keyID.ID, did.String()+"/"))
+
+	keyIDs, err := provider.ListByIdentity(ctx, did)
+	require.NoError(t, err)
+	assert.Equal(t, []KeyID{keyID}, keyIDs)
+
+	digest := crypto.Keccak256([]byte("data to sign"))
+	signature, err := provider.Sign(ctx, keyID, digest)
+	require.NoError(t, err)
+	recovered, err := crypto.SigToPub(digest, signature)
+	require.NoError(t, err)
+	assert.Equal(t, pubKey, crypto.CompressPubkey(recovered))
+
+	exists, err := provider.Exists(ctx, keyID)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	require.NoError(t, provider.Delete(ctx, keyID))
+	exists, err = provider.Exists(ctx, keyID)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestPKCS11KeyProvider_Ed25519(t *testing.T) {
+	ctx := context.Background()
+	provider, err := NewPKCS11KeyProvider(KeyTypeEd25519, pkcs11TestConfig(t))
+	require.NoError(t, err)
+
+	did := randomDID(t)
+	keyID, err := provider.New(&did)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(keyID.ID, did.String()+"/"+string(KeyTypeEd25519)+":"))
+
+	pubKey, err := provider.PublicKey(keyID)
+	require.NoError(t, err)
+	assert.Len(t, pubKey, ed25519.PublicKeySize)
+
+	data := []byte("data to sign")
+	signature, err := provider.Sign(ctx, keyID, data)
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(pubKey, data, signature))
+
+	require.NoError(t, provider.Delete(ctx, keyID))
+}
+
+func TestNewPKCS11KeyProvider_IncorrectKeyType(t *testing.T) {
+	_, err := NewPKCS11KeyProvider(KeyTypeBabyJubJub, PKCS11Config{})
+	assert.ErrorIs(t, err, ErrIncorrectKeyType)
+}
@@ -0,0 +1,203 @@
+package kms
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const maxRemoteSignerRequestSize = 1 << 20
+
+// NewRemoteSignerHandler returns the http handler of a remote signer that serves the keys of the key store with
+// the remote signer contract. Sign requests are not audited, the issuer node audits them before calling the signer.
+func NewRemoteSignerHandler(keyStore *KMS) http.Handler {
+	h := &remoteSignerHandler{keyStore: keyStore}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+remoteSignerNewPath, h.handle(h.newKey))
+	mux.HandleFunc("POST "+remoteSignerPublicKeyPath, h.handle(h.publicKey))
+	mux.HandleFunc("POST "+remoteSignerSignPath, h.handle(h.sign))
+	mux.HandleFunc("POST "+remoteSignerListPath, h.handle(h.list))
+	mux.HandleFunc("POST "+remoteSignerLinkPath, h.handle(h.link))
+	mux.HandleFunc("POST "+remoteSignerDeletePath, h.handle(h.delete))
+	mux.HandleFunc("POST "+remoteSignerExistsPath, h.handle(h.exists))
+	return mux
+}
+
+type remoteSignerHandler struct {
+	keyStore *KMS
+}
+
+// errInvalidRemoteSignerRequest is returned for requests that are rejected with a 400 status
+var errInvalidRemoteSignerRequest = errors.New("invalid request")
+
+type remoteSignerOperation func(ctx context.Context, provider KeyProvider, req remoteSignerRequest) (*remoteSignerResponse, error)
+
+func (h *remoteSignerHandler) handle(operation remoteSignerOperation) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		req := remoteSignerRequest{}
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRemoteSignerRequestSize)).Decode(&req); err != nil {
+			writeRemoteSignerResponse(w, http.StatusBadRequest, &remoteSignerResponse{Message: "invalid json body"})
+			return
+		}
+		provider, ok := h.keyStore.registry[req.KeyType]
+		if !ok {
+			writeRemoteSignerResponse(w, http.StatusBadRequest, &remoteSignerResponse{Message: ErrUnknownKeyType.Error()})
+			return
+		}
+
+		resp, err := operation(ctx, provider, req)
+		if err != nil {
+			status := http.StatusInternalServerError
+			switch {
+			case errors.Is(err, ErrKeyNotFound):
+				status = http.StatusNotFound
+			case errors.Is(err, errInvalidRemoteSignerRequest), errors.Is(err, ErrIncorrectKeyType):
+				status = http.StatusBadRequest
+			default:
+				log.Error(ctx, "remote signer operation failed", "err", err, "path", r.URL.Path, "keyType", req.KeyType)
+			}
+			writeRemoteSignerResponse(w, status, &remoteSignerResponse{Message: err.Error()})
+			return
+		}
+		writeRemoteSignerResponse(w, http.StatusOK, resp)
+	}
+}
+
+func (h *remoteSignerHandler) newKey(_ context.Context, provider KeyProvider, req remoteSignerRequest) (*remoteSignerResponse, error) {
+	var identity *w3c.DID
+	if req.Identity != "" {
+		did, err := parseRemoteSignerIdentity(req.Identity)
+		if err != nil {
+			return nil, err
+		}
+		identity = did
+	}
+	keyID, err := provider.New(identity)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteSignerResponse{KeyID: keyID.ID}, nil
+}
+
+func (h *remoteSignerHandler) publicKey(ctx context.Context, provider KeyProvider, req remoteSignerRequest) (*remoteSignerResponse, error) {
+	keyID, err := h.existingKeyID(ctx, provider, req)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := provider.PublicKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteSignerResponse{PublicKey: hex.EncodeToString(publicKey)}, nil
+}
+
+func (h *remoteSignerHandler) sign(ctx context.Context, provider KeyProvider, req remoteSignerRequest) (*remoteSignerResponse, error) {
+	data, err := hex.DecodeString(req.Data)
+	if err != nil || len(data) == 0 {
+		return nil, errors.Join(errInvalidRemoteSignerRequest, errors.New("data has to be a non empty hex string"))
+	}
+	keyID, err := h.existingKeyID(ctx, provider, req)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := provider.Sign(ctx, keyID, data)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteSignerResponse{Signature: hex.EncodeToString(signature)}, nil
+}
+
+func (h *remoteSignerHandler) list(ctx context.Context, provider KeyProvider, req remoteSignerRequest) (*remoteSignerResponse, error) {
+	identity, err := parseRemoteSignerIdentity(req.Identity)
+	if err != nil {
+		return nil, err
+	}
+	keyIDs, err := provider.ListByIdentity(ctx, *identity)
+	if err != nil {
+		return nil, err
+	}
+	resp := &remoteSignerResponse{KeyIDs: make([]string, 0, len(keyIDs))}
+	for _, keyID := range keyIDs {
+		resp.KeyIDs = append(resp.KeyIDs, keyID.ID)
+	}
+	return resp, nil
+}
+
+func (h *remoteSignerHandler) link(ctx context.Context, provider KeyProvider, req remoteSignerRequest) (*remoteSignerResponse, error) {
+	identity, err := parseRemoteSignerIdentity(req.Identity)
+	if err != nil {
+		return nil, err
+	}
+	if req.KeyID == "" {
+		return nil, errors.Join(errInvalidRemoteSignerRequest, errors.New("keyID is required"))
+	}
+	keyID, err := provider.LinkToIdentity(ctx, KeyID{Type: req.KeyType, ID: req.KeyID}, *identity)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteSignerResponse{KeyID: keyID.ID}, nil
+}
+
+func (h *remoteSignerHandler) delete(ctx context.Context, provider KeyProvider, req remoteSignerRequest) (*remoteSignerResponse, error) {
+	keyID, err := h.existingKeyID(ctx, provider, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := provider.Delete(ctx, keyID); err != nil {
+		return nil, err
+	}
+	return &remoteSignerResponse{}, nil
+}
+
+func (h *remoteSignerHandler) exists(ctx context.Context, provider KeyProvider, req remoteSignerRequest) (*remoteSignerResponse, error) {
+	if req.KeyID == "" {
+		return nil, errors.Join(errInvalidRemoteSignerRequest, errors.New("keyID is required"))
+	}
+	exists, err := provider.Exists(ctx, KeyID{Type: req.KeyType, ID: req.KeyID})
+	if err != nil {
+		return nil, err
+	}
+	return &remoteSignerResponse{Exists: exists}, nil
+}
+
+// existingKeyID returns the key id of the request, or ErrKeyNotFound if the provider doesn't have the key.
+// Unbound keys that have not been linked yet are only known by the provider that created them.
+func (h *remoteSignerHandler) existingKeyID(ctx context.Context, provider KeyProvider, req remoteSignerRequest) (KeyID, error) {
+	if req.KeyID == "" {
+		return KeyID{}, errors.Join(errInvalidRemoteSignerRequest, errors.New("keyID is required"))
+	}
+	keyID := KeyID{Type: req.KeyType, ID: req.KeyID}
+	if _, err := provider.PublicKey(keyID); err != nil {
+		exists, existsErr := provider.Exists(ctx, keyID)
+		if existsErr == nil && !exists {
+			return keyID, ErrKeyNotFound
+		}
+		return keyID, err
+	}
+	return keyID, nil
+}
+
+func parseRemoteSignerIdentity(identity string) (*w3c.DID, error) {
+	if identity == "" {
+		return nil, errors.Join(errInvalidRemoteSignerRequest, errors.New("identity is required"))
+	}
+	did, err := w3c.ParseDID(identity)
+	if err != nil {
+		return nil, errors.Join(errInvalidRemoteSignerRequest, err)
+	}
+	return did, nil
+}
+
+func writeRemoteSignerResponse(w http.ResponseWriter, status int, resp *remoteSignerResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	//nolint:errcheck
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,248 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/tracing"
+)
+
+// The remote signer contract. Every operation is a POST of a JSON remoteSignerRequest to its path and
+// returns a JSON remoteSignerResponse. Binary values (public keys, data to sign and signatures) are hex encoded
+// and the signature and public key formats are the ones of the local providers of the key type.
+// Unknown keys are reported with a 404 status, invalid requests with 400 and any other error with 500, with the
+// reason in the message field. The signer has to require a client certificate issued by a CA it trusts.
+const (
+	remoteSignerNewPath       = "/v1/keys/new"        // keyType, identity (optional) -> keyID
+	remoteSignerPublicKeyPath = "/v1/keys/public-key" // keyType, keyID -> publicKey
+	remoteSignerSignPath      = "/v1/keys/sign"       // keyType, keyID, data -> signature
+	remoteSignerListPath      = "/v1/keys/list"       // keyType, identity -> keyIDs
+	remoteSignerLinkPath      = "/v1/keys/link"       // keyType, keyID, identity -> keyID
+	remoteSignerDeletePath    = "/v1/keys/delete"     // keyType, keyID
+	remoteSignerExistsPath    = "/v1/keys/exists"     // keyType, keyID -> exists
+
+	defaultRemoteSignerTimeout = 10 * time.Second
+)
+
+type remoteSignerRequest struct {
+	KeyType  KeyType `json:"keyType"`
+	KeyID    string  `json:"keyID,omitempty"`
+	Identity string  `json:"identity,omitempty"`
+	Data     string  `json:"data,omitempty"`
+}
+
+type remoteSignerResponse struct {
+	KeyID     string   `json:"keyID,omitempty"`
+	KeyIDs    []string `json:"keyIDs,omitempty"`
+	PublicKey string   `json:"publicKey,omitempty"`
+	Signature string   `json:"signature,omitempty"`
+	Exists    bool     `json:"exists"`
+	Message   string   `json:"message,omitempty"`
+}
+
+// RemoteSignerConfig - configuration for the remote signer key provider. The client certificate and key
+// authenticate the issuer node to the signer and the CA verifies the certificate of the signer.
+type RemoteSignerConfig struct {
+	URL      string
+	CertPath string
+	KeyPath  string
+	CAPath   string
+	Timeout  time.Duration
+}
+
+type remoteSignerKeyProvider struct {
+	keyType KeyType
+	url     string
+	client  *http.Client
+}
+
+// NewRemoteSignerKeyProvider - creates new key provider that delegates the keys of the given type to an external
+// signing service over https with mutual TLS. The key material never leaves the signer.
+func NewRemoteSignerKeyProvider(keyType KeyType, cfg RemoteSignerConfig) (KeyProvider, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("remote signer url is not provided")
+	}
+	tlsConfig, err := newRemoteSignerClientTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultRemoteSignerTimeout
+	}
+	return &remoteSignerKeyProvider{
+		keyType: keyType,
+		url:     strings.TrimSuffix(cfg.URL, "/"),
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: tracing.Transport(&http.Transport{TLSClientConfig: tlsConfig}),
+		},
+	}, nil
+}
+
+func newRemoteSignerClientTLSConfig(cfg RemoteSignerConfig) (*tls.Config, error) {
+	if cfg.CertPath == "" || cfg.KeyPath == "" || cfg.CAPath == "" {
+		return nil, errors.New("remote signer client certificate, key and CA have to be provided")
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.CertPath, cfg.KeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load remote signer client certificate: %w", err)
+	}
+	caPool, err := LoadCertPool(cfg.CAPath)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      caPool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// LoadCertPool returns a pool with the PEM encoded certificates of the file
+func LoadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read CA certificate: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.New("no valid CA certificates found")
+	}
+	return pool, nil
+}
+
+func (rs *remoteSignerKeyProvider) New(identity *w3c.DID) (KeyID, error) {
+	req := remoteSignerRequest{KeyType: rs.keyType}
+	if identity != nil {
+		req.Identity = identity.String()
+	}
+	resp, err := rs.call(context.Background(), remoteSignerNewPath, req)
+	if err != nil {
+		return KeyID{Type: rs.keyType}, err
+	}
+	return KeyID{Type: rs.keyType, ID: resp.KeyID}, nil
+}
+
+func (rs *remoteSignerKeyProvider) PublicKey(keyID KeyID) ([]byte, error) {
+	if keyID.Type != rs.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+	resp, err := rs.call(context.Background(), remoteSignerPublicKeyPath, remoteSignerRequest{KeyType: rs.keyType, KeyID: keyID.ID})
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(resp.PublicKey)
+}
+
+func (rs *remoteSignerKeyProvider) Sign(ctx context.Context, keyID KeyID, data []byte) ([]byte, error) {
+	if keyID.Type != rs.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+	resp, err := rs.call(ctx, remoteSignerSignPath, remoteSignerRequest{KeyType: rs.keyType, KeyID: keyID.ID, Data: hex.EncodeToString(data)})
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(resp.Signature)
+}
+
+func (rs *remoteSignerKeyProvider) ListByIdentity(ctx context.Context, identity w3c.DID) ([]KeyID, error) {
+	resp, err := rs.call(ctx, remoteSignerListPath, remoteSignerRequest{KeyType: rs.keyType, Identity: identity.String()})
+	if err != nil {
+		return nil, err
+	}
+	keyIDs := make([]KeyID, 0, len(resp.KeyIDs))
+	for _, id := range resp.KeyIDs {
+		keyIDs = append(keyIDs, KeyID{Type: rs.keyType, ID: id})
+	}
+	return keyIDs, nil
+}
+
+func (rs *remoteSignerKeyProvider) LinkToIdentity(ctx context.Context, keyID KeyID, identity w3c.DID) (KeyID, error) {
+	if keyID.Type != rs.keyType {
+		return keyID, ErrIncorrectKeyType
+	}
+	resp, err := rs.call(ctx, remoteSignerLinkPath, remoteSignerRequest{KeyType: rs.keyType, KeyID: keyID.ID, Identity: identity.String()})
+	if err != nil {
+		return keyID, err
+	}
+	return KeyID{Type: rs.keyType, ID: resp.KeyID}, nil
+}
+
+func (rs *remoteSignerKeyProvider) Delete(ctx context.Context, keyID KeyID) error {
+	if keyID.Type != rs.keyType {
+		return ErrIncorrectKeyType
+	}
+	_, err := rs.call(ctx, remoteSignerDeletePath, remoteSignerRequest{KeyType: rs.keyType, KeyID: keyID.ID})
+	return err
+}
+
+func (rs *remoteSignerKeyProvider) Exists(ctx context.Context, keyID KeyID) (bool, error) {
+	if keyID.Type != rs.keyType {
+		return false, ErrIncorrectKeyType
+	}
+	resp, err := rs.call(ctx, remoteSignerExistsPath, remoteSignerRequest{KeyType: rs.keyType, KeyID: keyID.ID})
+	if err != nil {
+		return false, err
+	}
+	return resp.Exists, nil
+}
+
+// Ping checks that the remote signer is reachable and accepts the client certificate
+func (rs *remoteSignerKeyProvider) Ping(ctx context.Context) error {
+	_, err := rs.call(ctx, remoteSignerExistsPath, remoteSignerRequest{KeyType: rs.keyType, KeyID: "health-check"})
+	if errors.Is(err, ErrKeyNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (rs *remoteSignerKeyProvider) call(ctx context.Context, path string, req remoteSignerRequest) (*remoteSignerResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, rs.url+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := rs.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer request failed: %w", err)
+	}
+	//nolint:errcheck
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp := &remoteSignerResponse{}
+	if err := json.Unmarshal(respBody, resp); err != nil {
+		return nil, fmt.Errorf("invalid remote signer response, status %d: %w", httpResp.StatusCode, err)
+	}
+
+	switch httpResp.StatusCode {
+	case http.StatusOK:
+		return resp, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, resp.Message)
+	default:
+		return nil, fmt.Errorf("remote signer error, status %d: %s", httpResp.StatusCode, resp.Message)
+	}
+}
@@ -0,0 +1,164 @@
+package kms
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RemoteSignerKeyProvider(t *testing.T) {
+	ctx := context.Background()
+	certs := newRemoteSignerTestCerts(t)
+
+	keyStore, err := OpenForMigration(ctx, MigrationProviderLocalStorage, Config{LocalStoragePath: t.TempDir()})
+	require.NoError(t, err)
+	server := httptest.NewUnstartedServer(NewRemoteSignerHandler(keyStore))
+	caPool, err := LoadCertPool(certs.caPath)
+	require.NoError(t, err)
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  caPool,
+		MinVersion: tls.VersionTLS12,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	cfg := RemoteSignerConfig{URL: server.URL, CertPath: certs.certPath, KeyPath: certs.keyPath, CAPath: certs.caPath}
+	provider, err := NewRemoteSignerKeyProvider(KeyTypeP256, cfg)
+	require.NoError(t, err)
+	did := randomDID(t)
+
+	keyID, err := provider.New(nil)
+	require.NoError(t, err)
+	assert.Equal(t, KeyTypeP256, keyID.Type)
+	publicKey, err := provider.PublicKey(keyID)
+	require.NoError(t, err)
+	localPublicKey, err := keyStore.PublicKey(keyID)
+	require.NoError(t, err)
+	assert.Equal(t, localPublicKey, publicKey)
+
+	linkedKeyID, err := provider.LinkToIdentity(ctx, keyID, did)
+	require.NoError(t, err)
+	assert.Equal(t, did.String()+"/"+keyID.ID, linkedKeyID.ID)
+
+	t.Run("should list the keys of the identity", func(t *testing.T) {
+		keys, err := provider.ListByIdentity(ctx, did)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, linkedKeyID, keys[0])
+	})
+
+	t.Run("should sign with the remote key", func(t *testing.T) {
+		digest := sha256.Sum256([]byte("payload"))
+		signature, err := provider.Sign(ctx, linkedKeyID, digest[:])
+		require.NoError(t, err)
+		pub, err := DecodeP256PubKey(publicKey)
+		require.NoError(t, err)
+		assert.True(t, VerifyP256Signature(pub, digest[:], signature))
+	})
+
+	t.Run("should return key not found for unknown keys", func(t *testing.T) {
+		_, err := provider.Sign(ctx, KeyID{Type: KeyTypeP256, ID: "P256:unknown"}, []byte("data"))
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("should reject keys of another type", func(t *testing.T) {
+		_, err := provider.PublicKey(KeyID{Type: KeyTypeEthereum, ID: linkedKeyID.ID})
+		assert.ErrorIs(t, err, ErrIncorrectKeyType)
+	})
+
+	t.Run("should delete the remote key", func(t *testing.T) {
+		require.NoError(t, provider.Delete(ctx, linkedKeyID))
+		exists, err := provider.Exists(ctx, linkedKeyID)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("should ping the remote signer", func(t *testing.T) {
+		pinger, ok := provider.(Pinger)
+		require.True(t, ok)
+		assert.NoError(t, pinger.Ping(ctx))
+	})
+
+	t.Run("should reject clients without certificate", func(t *testing.T) {
+		_, err := server.Client().Post(server.URL+remoteSignerNewPath, "application/json", nil)
+		assert.Error(t, err)
+	})
+}
+
+type remoteSignerTestCerts struct {
+	caPath   string
+	certPath string
+	keyPath  string
+}
+
+// newRemoteSignerTestCerts creates a CA and a client certificate issued by it. The CA is used by the server to
+// verify the client and by the client to verify the httptest server certificate, so it is also added to the pool.
+func newRemoteSignerTestCerts(t *testing.T) remoteSignerTestCerts {
+	t.Helper()
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "remote signer test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	clientTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "issuer node"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	clientDER, err := x509.CreateCertificate(rand.Reader, clientTemplate, caTemplate, &clientKey.PublicKey, caKey)
+	require.NoError(t, err)
+	clientKeyDER, err := x509.MarshalECPrivateKey(clientKey)
+	require.NoError(t, err)
+
+	// the httptest server certificate is signed by its own internal CA
+	serverCA := httptest.NewUnstartedServer(nil)
+	serverCA.StartTLS()
+	serverCADER := serverCA.Certificate().Raw
+	serverCA.Close()
+
+	certs := remoteSignerTestCerts{
+		caPath:   filepath.Join(dir, "ca.pem"),
+		certPath: filepath.Join(dir, "client.pem"),
+		keyPath:  filepath.Join(dir, "client-key.pem"),
+	}
+	caPEM := append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: serverCADER})...)
+	require.NoError(t, os.WriteFile(certs.caPath, caPEM, 0o600))
+	require.NoError(t, os.WriteFile(certs.certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: clientDER}), 0o600))
+	require.NoError(t, os.WriteFile(certs.keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: clientKeyDER}), 0o600))
+	return certs
+}
@@ -0,0 +1,114 @@
+package kms
+
+import (
+	"crypto/rand"
+	stderr "errors"
+)
+
+// Shamir secret sharing over GF(2^8). Every byte of the secret is the constant term of a random polynomial of
+// degree threshold-1 and every share holds the value of the polynomials at a different x, appended as last byte.
+
+const shamirMaxShares = 255
+
+// ErrInvalidShares raises when the shares can not be split or combined
+var ErrInvalidShares = stderr.New("invalid shares")
+
+// splitSecret splits the secret in parts shares, threshold of them are needed to combine it
+func splitSecret(secret []byte, parts, threshold int) ([][]byte, error) {
+	if len(secret) == 0 || threshold < 2 || parts < threshold || parts > shamirMaxShares {
+		return nil, ErrInvalidShares
+	}
+
+	shares := make([][]byte, parts)
+	for i := range shares {
+		shares[i] = make([]byte, len(secret)+1)
+		shares[i][len(secret)] = byte(i + 1)
+	}
+
+	coefficients := make([]byte, threshold)
+	for i, b := range secret {
+		coefficients[0] = b
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, err
+		}
+		for _, share := range shares {
+			share[i] = gfEval(coefficients, share[len(secret)])
+		}
+	}
+	return shares, nil
+}
+
+// combineShares returns the secret of the shares. It can not detect a wrong secret if less than threshold shares
+// are given, the secret has to be authenticated.
+func combineShares(shares [][]byte) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, ErrInvalidShares
+	}
+	size := len(shares[0])
+	if size < 2 {
+		return nil, ErrInvalidShares
+	}
+
+	xs := make([]byte, len(shares))
+	seen := make(map[byte]bool, len(shares))
+	for i, share := range shares {
+		if len(share) != size {
+			return nil, ErrInvalidShares
+		}
+		x := share[size-1]
+		if x == 0 || seen[x] {
+			return nil, ErrInvalidShares
+		}
+		seen[x] = true
+		xs[i] = x
+	}
+
+	secret := make([]byte, size-1)
+	for i := range secret {
+		var value byte
+		for j, share := range shares {
+			// lagrange basis polynomial of xs[j] at 0
+			basis := byte(1)
+			for k := range shares {
+				if k == j {
+					continue
+				}
+				basis = gfMul(basis, gfDiv(xs[k], xs[k]^xs[j]))
+			}
+			value ^= gfMul(share[i], basis)
+		}
+		secret[i] = value
+	}
+	return secret, nil
+}
+
+// gfEval evaluates the polynomial at x with Horner's method
+func gfEval(coefficients []byte, x byte) byte {
+	var result byte
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		result = gfMul(result, x) ^ coefficients[i]
+	}
+	return result
+}
+
+// gfMul multiplies in GF(2^8) with the AES polynomial, without data dependent branches
+func gfMul(a, b byte) byte {
+	var result byte
+	for i := 0; i < 8; i++ {
+		result ^= -(b & 1) & a
+		carry := -(a >> 7) & 0x1b // nolint:mnd
+		a = (a << 1) ^ carry
+		b >>= 1
+	}
+	return result
+}
+
+// gfDiv divides in GF(2^8). b must not be zero.
+func gfDiv(a, b byte) byte {
+	// b^254 is the inverse of b
+	inverse := b
+	for i := 0; i < 6; i++ {
+		inverse = gfMul(gfMul(inverse, inverse), b)
+	}
+	return gfMul(a, gfMul(inverse, inverse))
+}
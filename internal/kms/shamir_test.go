@@ -0,0 +1,58 @@
+package kms
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShamir_SplitAndCombine(t *testing.T) {
+	secret := make([]byte, backupKeyLength)
+	_, err := rand.Read(secret)
+	require.NoError(t, err)
+
+	shares, err := splitSecret(secret, 5, 3)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+
+	t.Run("should combine any threshold of shares", func(t *testing.T) {
+		for _, subset := range [][]int{{0, 1, 2}, {0, 2, 4}, {4, 3, 1}, {1, 2, 3, 4}, {0, 1, 2, 3, 4}} {
+			selected := make([][]byte, 0, len(subset))
+			for _, i := range subset {
+				selected = append(selected, shares[i])
+			}
+			combined, err := combineShares(selected)
+			require.NoError(t, err)
+			assert.Equal(t, secret, combined, subset)
+		}
+	})
+
+	t.Run("should not combine the secret with less shares than the threshold", func(t *testing.T) {
+		combined, err := combineShares(shares[:2])
+		require.NoError(t, err)
+		assert.NotEqual(t, secret, combined)
+	})
+
+	t.Run("should reject duplicated shares", func(t *testing.T) {
+		_, err := combineShares([][]byte{shares[0], shares[0], shares[1]})
+		assert.ErrorIs(t, err, ErrInvalidShares)
+	})
+
+	t.Run("should reject invalid parameters", func(t *testing.T) {
+		_, err := splitSecret(secret, 2, 3)
+		assert.ErrorIs(t, err, ErrInvalidShares)
+		_, err = splitSecret(secret, 3, 1)
+		assert.ErrorIs(t, err, ErrInvalidShares)
+		_, err = splitSecret(secret, 256, 3)
+		assert.ErrorIs(t, err, ErrInvalidShares)
+	})
+}
+
+func TestShamir_GFDiv(t *testing.T) {
+	for a := 1; a < 256; a++ {
+		assert.Equal(t, byte(1), gfDiv(byte(a), byte(a)))
+		assert.Equal(t, byte(a), gfMul(gfDiv(byte(a), 7), 7))
+	}
+}
@@ -0,0 +1,66 @@
+package kms
+
+import (
+	"context"
+	"crypto/sha256"
+	stderr "errors"
+)
+
+// SignPurpose is the reason a key is asked to sign
+type SignPurpose string
+
+// List of sign purposes
+const (
+	SignPurposeStateTransition SignPurpose = "stateTransition" // SignPurposeStateTransition state transition signature or transaction
+	SignPurposeCredential      SignPurpose = "credential"      // SignPurposeCredential credential signature
+	SignPurposePaymentRequest  SignPurpose = "paymentRequest"  // SignPurposePaymentRequest payment request signature
+	SignPurposeRHSPublish      SignPurpose = "rhsPublish"      // SignPurposeRHSPublish reverse hash service publishing transaction
+)
+
+// SignPurposes returns all the supported sign purposes
+func SignPurposes() []SignPurpose {
+	return []SignPurpose{SignPurposeStateTransition, SignPurposeCredential, SignPurposePaymentRequest, SignPurposeRHSPublish}
+}
+
+// SignStatus is the outcome of a sign request
+type SignStatus string
+
+// List of sign outcomes
+const (
+	SignStatusSigned SignStatus = "signed" // SignStatusSigned the data was signed
+	SignStatusDenied SignStatus = "denied" // SignStatusDenied the key policy does not allow the request
+	SignStatusFailed SignStatus = "failed" // SignStatusFailed the key provider could not sign the data
+)
+
+// ErrSignPurposeNotAllowed raises when the policy of the key does not allow the sign purpose
+var ErrSignPurposeNotAllowed = stderr.New("sign purpose not allowed for key")
+
+// ErrSignRateLimitExceeded raises when the key already signed the maximum number of times allowed by its policy
+var ErrSignRateLimitExceeded = stderr.New("sign rate limit exceeded for key")
+
+// SignRecord describes a sign request. Digest is the sha256 of the signed data, so auditors can match it without
+// storing the data itself.
+type SignRecord struct {
+	KeyID   KeyID
+	Purpose SignPurpose
+	Digest  []byte
+	Status  SignStatus
+	Reason  string
+}
+
+// SignAuditor checks the policy of a key before it signs and records every sign request
+type SignAuditor interface {
+	// Allow returns ErrSignPurposeNotAllowed or ErrSignRateLimitExceeded if the key can not sign for the purpose
+	Allow(ctx context.Context, keyID KeyID, purpose SignPurpose) error
+	// Record stores the sign request. The signature is not returned if it can not be recorded.
+	Record(ctx context.Context, record SignRecord) error
+}
+
+func newSignRecord(keyID KeyID, purpose SignPurpose, data []byte, status SignStatus, err error) SignRecord {
+	digest := sha256.Sum256(data)
+	record := SignRecord{KeyID: keyID, Purpose: purpose, Digest: digest[:], Status: status}
+	if err != nil {
+		record.Reason = err.Error()
+	}
+	return record
+}
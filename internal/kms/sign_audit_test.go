@@ -0,0 +1,79 @@
+package kms
+
+import (
+	"context"
+	"crypto/sha256"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSignAuditor struct {
+	denied  map[SignPurpose]error
+	records []SignRecord
+}
+
+func (a *testSignAuditor) Allow(_ context.Context, _ KeyID, purpose SignPurpose) error {
+	return a.denied[purpose]
+}
+
+func (a *testSignAuditor) Record(_ context.Context, record SignRecord) error {
+	a.records = append(a.records, record)
+	return nil
+}
+
+func TestKMS_SignWithAuditor(t *testing.T) {
+	ctx := context.Background()
+	tmpFile, err := createTestFile(t)
+	require.NoError(t, err)
+	//nolint:errcheck
+	defer os.Remove(tmpFile.Name())
+
+	keyStore := NewKMS()
+	require.NoError(t, keyStore.RegisterKeyProvider(KeyTypeEthereum, NewLocalEthKeyProvider(KeyTypeEthereum, NewFileStorageManager(tmpFile.Name()))))
+	keyID, err := keyStore.CreateKey(KeyTypeEthereum, nil)
+	require.NoError(t, err)
+
+	auditor := &testSignAuditor{denied: map[SignPurpose]error{
+		SignPurposeStateTransition: ErrSignPurposeNotAllowed,
+		SignPurposeRHSPublish:      ErrSignRateLimitExceeded,
+	}}
+	keyStore.SetSignAuditor(auditor)
+
+	digest := sha256.Sum256([]byte("payment request"))
+	digestOfDigest := sha256.Sum256(digest[:])
+
+	t.Run("should sign and record an allowed purpose", func(t *testing.T) {
+		signature, err := keyStore.Sign(ctx, keyID, SignPurposePaymentRequest, digest[:])
+		require.NoError(t, err)
+		assert.NotEmpty(t, signature)
+		require.Len(t, auditor.records, 1)
+		assert.Equal(t, SignStatusSigned, auditor.records[0].Status)
+		assert.Equal(t, SignPurposePaymentRequest, auditor.records[0].Purpose)
+		assert.Equal(t, digestOfDigest[:], auditor.records[0].Digest)
+	})
+
+	t.Run("should deny and record a not allowed purpose", func(t *testing.T) {
+		_, err := keyStore.Sign(ctx, keyID, SignPurposeStateTransition, digest[:])
+		assert.ErrorIs(t, err, ErrSignPurposeNotAllowed)
+		require.Len(t, auditor.records, 2)
+		assert.Equal(t, SignStatusDenied, auditor.records[1].Status)
+		assert.Equal(t, ErrSignPurposeNotAllowed.Error(), auditor.records[1].Reason)
+	})
+
+	t.Run("should deny and record a rate limited key", func(t *testing.T) {
+		_, err := keyStore.Sign(ctx, keyID, SignPurposeRHSPublish, digest[:])
+		assert.ErrorIs(t, err, ErrSignRateLimitExceeded)
+		require.Len(t, auditor.records, 3)
+		assert.Equal(t, SignStatusDenied, auditor.records[2].Status)
+	})
+
+	t.Run("should record a failed signature", func(t *testing.T) {
+		_, err := keyStore.Sign(ctx, keyID, SignPurposePaymentRequest, []byte("not a digest"))
+		assert.Error(t, err)
+		require.Len(t, auditor.records, 4)
+		assert.Equal(t, SignStatusFailed, auditor.records[3].Status)
+	})
+}
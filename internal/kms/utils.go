@@ -32,6 +32,8 @@ func convertToKeyType(keyType string) KeyType {
 		return KeyTypeEthereum
 	case solanaEd25519:
 		return KeyTypeEd25519
+	case nistP256:
+		return KeyTypeP256
 	default:
 		return ""
 	}
@@ -47,6 +49,8 @@ func convertFromKeyType(keyType KeyType) string {
 		return ethereum
 	case KeyTypeEd25519:
 		return solanaEd25519
+	case KeyTypeP256:
+		return nistP256
 	default:
 		return ""
 	}
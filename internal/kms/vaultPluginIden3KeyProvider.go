@@ -2,6 +2,7 @@ package kms
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
@@ -171,6 +172,11 @@ func (v *vaultPluginIden3KeyProvider) Delete(ctx context.Context, keyID KeyID) e
 	return err
 }
 
+// Ping checks that vault is reachable and its token is valid
+func (v *vaultPluginIden3KeyProvider) Ping(ctx context.Context) error {
+	return pingVault(ctx, v.vaultCli)
+}
+
 func (v *vaultPluginIden3KeyProvider) Exists(ctx context.Context, keyID KeyID) (bool, error) {
 	_, err := publicKey(v.vaultCli, v.keyPathFromID(keyID))
 	if err != nil {
@@ -182,6 +188,59 @@ func (v *vaultPluginIden3KeyProvider) Exists(ctx context.Context, keyID KeyID) (
 	return true, nil
 }
 
+func (v *vaultPluginIden3KeyProvider) exportKey(_ context.Context, keyID KeyID) ([]byte, error) {
+	if keyID.Type != v.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+
+	secret, err := v.vaultCli.Logical().Read(v.keyPathFromID(keyID).private())
+	if err != nil {
+		return nil, err
+	}
+	data, err := getSecretData(secret)
+	if err != nil {
+		return nil, err
+	}
+	privateKeyStr, ok := data[jsonPrivateKey].(string)
+	if !ok {
+		return nil, errors.New("unable to get private key from secret")
+	}
+	privateKey, err := hex.DecodeString(privateKeyStr)
+	if err != nil {
+		return nil, err
+	}
+	if keyID.Type == KeyTypeEd25519 && len(privateKey) == ed25519.PrivateKeySize {
+		privateKey = ed25519.PrivateKey(privateKey).Seed()
+	}
+	return privateKey, nil
+}
+
+func (v *vaultPluginIden3KeyProvider) importKeyID(identity *w3c.DID, keyID KeyID, publicKey []byte) string {
+	if identity == nil {
+		return keyID.ID
+	}
+	return v.keyPathFromPublic(identity, hex.EncodeToString(publicKey)).keyID
+}
+
+func (v *vaultPluginIden3KeyProvider) importKey(_ context.Context, identity *w3c.DID, keyID KeyID, privateKey []byte) (KeyID, error) {
+	publicKey, err := publicKeyFromPrivate(v.keyType, privateKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+	pluginKeyType, err := toPluginKeyType(v.keyType)
+	if err != nil {
+		return KeyID{}, err
+	}
+
+	newKeyID := KeyID{Type: v.keyType, ID: v.importKeyID(identity, keyID, publicKey)}
+	data := map[string]interface{}{
+		jsonKeyType:    pluginKeyType,
+		jsonPrivateKey: hex.EncodeToString(privateKey),
+	}
+	_, err = v.vaultCli.Logical().Write(v.keyPathFromID(newKeyID).importKey(), data)
+	return newKeyID, err
+}
+
 func (v *vaultPluginIden3KeyProvider) randomKeyPath() (keyPathT, error) {
 	var rnd [16]byte
 	_, err := rand.Read(rnd[:])
@@ -390,6 +449,14 @@ func (p keyPathT) new() string {
 	return p.join("new")
 }
 
+func (p keyPathT) private() string {
+	return p.join("private")
+}
+
+func (p keyPathT) importKey() string {
+	return p.join("import")
+}
+
 func toPluginKeyType(keyType KeyType) (pluginIden3KeyTp, error) {
 	switch keyType {
 	case KeyTypeBabyJubJub:
@@ -152,7 +152,3 @@ func getETHPrivateKey(t testing.TB, cli *api.Client, keyPath keyPathT) *ecdsa.Pr
 
 	return privKey
 }
-
-func (p keyPathT) private() string {
-	return p.join("private")
-}
@@ -236,3 +236,27 @@ func DecodeBJJSignature(sigBytes []byte) (*babyjub.Signature, error) {
 	sig, err := sigComp.Decompress()
 	return sig, err
 }
+
+func (v *vaultBJJKeyProvider) exportKey(_ context.Context, keyID KeyID) ([]byte, error) {
+	return v.privateKey(keyID)
+}
+
+func (v *vaultBJJKeyProvider) importKeyID(identity *w3c.DID, keyID KeyID, publicKey []byte) string {
+	if identity == nil {
+		return keyID.ID
+	}
+	return keyPath(identity, v.keyType, hex.EncodeToString(publicKey))
+}
+
+func (v *vaultBJJKeyProvider) importKey(_ context.Context, identity *w3c.DID, keyID KeyID, privateKey []byte) (KeyID, error) {
+	publicKey, err := publicKeyFromPrivate(v.keyType, privateKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+	newKeyID := KeyID{Type: v.keyType, ID: v.importKeyID(identity, keyID, publicKey)}
+	keyMaterial := map[string]string{
+		jsonKeyType: string(v.keyType),
+		jsonKeyData: hex.EncodeToString(privateKey),
+	}
+	return newKeyID, saveKeyMaterial(v.vaultCli, newKeyID.ID, keyMaterial)
+}
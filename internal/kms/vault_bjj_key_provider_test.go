@@ -82,7 +82,7 @@ func TestBJJKeyProvider_NoIdentity(t *testing.T) {
 	// Test signature
 	msg := new(big.Int).Sub(constants.Q, big.NewInt(10))
 	digest := utils.SwapEndianness(msg.Bytes())
-	sigBytes, err := k.KMS.Sign(context.Background(), keyID, digest)
+	sigBytes, err := k.KMS.Sign(context.Background(), keyID, SignPurposeStateTransition, digest)
 	require.NoError(t, err)
 	var sigComp babyjub.SignatureComp
 	require.Len(t, sigBytes, len(sigComp))
@@ -177,7 +177,7 @@ func testBoundedBJJKey(t *testing.T, k TestKMS, keyID KeyID, identity w3c.DID) {
 	// Test signature
 	msg := new(big.Int).Sub(constants.Q, big.NewInt(10))
 	digest := utils.SwapEndianness(msg.Bytes())
-	sigBytes, err := k.KMS.Sign(context.Background(), keyID, digest)
+	sigBytes, err := k.KMS.Sign(context.Background(), keyID, SignPurposeStateTransition, digest)
 	require.NoError(t, err)
 	var sigComp babyjub.SignatureComp
 	require.Len(t, sigBytes, len(sigComp))
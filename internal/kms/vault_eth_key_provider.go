@@ -198,6 +198,11 @@ func (v *vaultETHKeyProvider) Delete(ctx context.Context, keyID KeyID) error {
 	return err
 }
 
+// Ping checks that vault is reachable and its token is valid
+func (v *vaultETHKeyProvider) Ping(ctx context.Context) error {
+	return pingVault(ctx, v.vaultCli)
+}
+
 func (v *vaultETHKeyProvider) Exists(ctx context.Context, keyID KeyID) (bool, error) {
 	return false, errors.New("not implemented")
 }
@@ -215,3 +220,32 @@ func DecodeETHPubKey(key []byte) (*ecdsa.PublicKey, error) {
 	pubKey, err := crypto.DecompressPubkey(key)
 	return pubKey, errors.WithStack(err)
 }
+
+func (v *vaultETHKeyProvider) exportKey(_ context.Context, keyID KeyID) ([]byte, error) {
+	return v.privateKey(keyID)
+}
+
+func (v *vaultETHKeyProvider) importKeyID(identity *w3c.DID, keyID KeyID, publicKey []byte) string {
+	if identity == nil {
+		return keyID.ID
+	}
+	return keyPath(identity, v.keyType, hex.EncodeToString(publicKey))
+}
+
+// importKey stores bound keys in the identity format and unbound keys in the root format, where the
+// private key is stored under the key id
+func (v *vaultETHKeyProvider) importKey(_ context.Context, identity *w3c.DID, keyID KeyID, privateKey []byte) (KeyID, error) {
+	publicKey, err := publicKeyFromPrivate(v.keyType, privateKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+	newKeyID := KeyID{Type: v.keyType, ID: v.importKeyID(identity, keyID, publicKey)}
+	keyMaterial := map[string]string{newKeyID.ID: hex.EncodeToString(privateKey)}
+	if identity != nil {
+		keyMaterial = map[string]string{
+			jsonKeyType: string(KeyTypeEthereum),
+			jsonKeyData: hex.EncodeToString(privateKey),
+		}
+	}
+	return newKeyID, saveKeyMaterial(v.vaultCli, newKeyID.ID, keyMaterial)
+}
@@ -67,7 +67,7 @@ func TestEthKeyProvider(t *testing.T) {
 	// Test signature
 	text := []byte("abc")
 	digest := crypto.Keccak256(text)
-	sig, err := k.KMS.Sign(context.Background(), keyID, digest)
+	sig, err := k.KMS.Sign(context.Background(), keyID, SignPurposeStateTransition, digest)
 	require.NoError(t, err)
 	require.True(t, crypto.VerifySignature(pubKeyBytes, digest, sig[:64]))
 
@@ -0,0 +1,188 @@
+package kms
+
+import (
+	"context"
+	"encoding/hex"
+	"regexp"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/pkg/errors"
+)
+
+// vaultP256KeyProvider stores P-256 keys in the vault kv v2 secrets engine, the iden3 plugin does not support them
+type vaultP256KeyProvider struct {
+	keyType          KeyType
+	vaultCli         *api.Client
+	reIdenKeyPathHex *regexp.Regexp
+	reAnonKeyPathHex *regexp.Regexp
+}
+
+// NewVaultP256KeyProvider creates new provider for P-256 keys stored in vault
+func NewVaultP256KeyProvider(vaultCli *api.Client, keyType KeyType) KeyProvider {
+	keyTypeRE := regexp.QuoteMeta(string(keyType))
+	return &vaultP256KeyProvider{
+		keyType:          keyType,
+		vaultCli:         vaultCli,
+		reIdenKeyPathHex: regexp.MustCompile("^(?i).*/" + keyTypeRE + ":([a-f0-9]{66})$"),
+		reAnonKeyPathHex: regexp.MustCompile("^(?i)" + keyTypeRE + ":([a-f0-9]{66})$"),
+	}
+}
+
+func (v *vaultP256KeyProvider) New(identity *w3c.DID) (KeyID, error) {
+	privKey, pubKey, err := newP256PrivateKey()
+	if err != nil {
+		return KeyID{}, err
+	}
+	keyID := KeyID{
+		Type: v.keyType,
+		ID:   keyPath(identity, v.keyType, hex.EncodeToString(pubKey)),
+	}
+	keyMaterial := map[string]string{
+		jsonKeyType: string(KeyTypeP256),
+		jsonKeyData: hex.EncodeToString(privKey),
+	}
+	return keyID, saveKeyMaterial(v.vaultCli, keyID.ID, keyMaterial)
+}
+
+func (v *vaultP256KeyProvider) LinkToIdentity(_ context.Context, keyID KeyID, identity w3c.DID) (KeyID, error) {
+	if keyID.Type != v.keyType {
+		return keyID, ErrIncorrectKeyType
+	}
+
+	ss := v.reAnonKeyPathHex.FindStringSubmatch(keyID.ID)
+	if len(ss) != partsNumber {
+		return keyID, errors.New("key ID does not looks like unbound")
+	}
+
+	newKeyID := KeyID{
+		Type: keyID.Type,
+		ID:   keyPath(&identity, v.keyType, ss[1]),
+	}
+	return newKeyID, moveSecretData(v.vaultCli, keyID.ID, newKeyID.ID)
+}
+
+// Sign signs the digest and returns the r||s signature
+func (v *vaultP256KeyProvider) Sign(_ context.Context, keyID KeyID, data []byte) ([]byte, error) {
+	privKeyData, err := v.privateKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	privKey, err := decodeP256PrivateKey(privKeyData)
+	if err != nil {
+		return nil, err
+	}
+	return signP256(privKey, data)
+}
+
+func (v *vaultP256KeyProvider) ListByIdentity(_ context.Context, identity w3c.DID) ([]KeyID, error) {
+	path := identityPath(&identity)
+	entries, err := listDirectoryEntries(v.vaultCli, path)
+	if err != nil {
+		return nil, err
+	}
+
+	reVaultKeyHex := regexp.MustCompile("^(?i)" + regexp.QuoteMeta(string(v.keyType)) + ":([a-f0-9]{66})$")
+	result := make([]KeyID, 0)
+	for _, k := range entries {
+		if !reVaultKeyHex.MatchString(k) {
+			// ignore unknown keys
+			continue
+		}
+		result = append(result, KeyID{
+			Type: v.keyType,
+			ID:   path + "/" + k,
+		})
+	}
+	return result, nil
+}
+
+// PublicKey returns the compressed public key
+func (v *vaultP256KeyProvider) PublicKey(keyID KeyID) ([]byte, error) {
+	if keyID.Type != v.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+
+	ss := v.reIdenKeyPathHex.FindStringSubmatch(keyID.ID)
+	if len(ss) != partsNumber {
+		ss = v.reAnonKeyPathHex.FindStringSubmatch(keyID.ID)
+	}
+	if len(ss) != partsNumber {
+		return nil, errors.New("unable to get public key from key ID")
+	}
+
+	val, err := hex.DecodeString(ss[1])
+	return val, errors.WithStack(err)
+}
+
+func (v *vaultP256KeyProvider) Delete(_ context.Context, keyID KeyID) error {
+	_, err := v.vaultCli.Logical().Delete(absVaultSecretPath(keyID.ID))
+	return errors.WithStack(err)
+}
+
+// Ping checks that vault is reachable and its token is valid
+func (v *vaultP256KeyProvider) Ping(ctx context.Context) error {
+	return pingVault(ctx, v.vaultCli)
+}
+
+func (v *vaultP256KeyProvider) Exists(_ context.Context, keyID KeyID) (bool, error) {
+	secret, err := v.vaultCli.Logical().Read(absVaultSecretPath(keyID.ID))
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return secret != nil && secret.Data != nil, nil
+}
+
+func (v *vaultP256KeyProvider) privateKey(keyID KeyID) ([]byte, error) {
+	if keyID.Type != v.keyType {
+		return nil, errors.WithStack(ErrIncorrectKeyType)
+	}
+
+	secret, err := v.vaultCli.Logical().Read(absVaultSecretPath(keyID.ID))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	secData, err := getKVv2SecretData(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	keyType, ok := secData[jsonKeyType].(string)
+	if !ok || KeyType(keyType) != v.keyType {
+		return nil, errors.WithStack(ErrIncorrectKeyType)
+	}
+	keyHex, ok := secData[jsonKeyData].(string)
+	if !ok {
+		return nil, errors.New("unexpected format for private key")
+	}
+	val, err := hex.DecodeString(keyHex)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(val) != p256KeyLength {
+		return nil, errors.New("incorrect private key")
+	}
+	return val, nil
+}
+
+func (v *vaultP256KeyProvider) exportKey(_ context.Context, keyID KeyID) ([]byte, error) {
+	return v.privateKey(keyID)
+}
+
+func (v *vaultP256KeyProvider) importKeyID(identity *w3c.DID, _ KeyID, publicKey []byte) string {
+	return keyPath(identity, v.keyType, hex.EncodeToString(publicKey))
+}
+
+func (v *vaultP256KeyProvider) importKey(_ context.Context, identity *w3c.DID, keyID KeyID, privateKey []byte) (KeyID, error) {
+	publicKey, err := publicKeyFromPrivate(v.keyType, privateKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+	newKeyID := KeyID{Type: v.keyType, ID: v.importKeyID(identity, keyID, publicKey)}
+	keyMaterial := map[string]string{
+		jsonKeyType: string(KeyTypeP256),
+		jsonKeyData: hex.EncodeToString(privateKey),
+	}
+	return newKeyID, saveKeyMaterial(v.vaultCli, newKeyID.ID, keyMaterial)
+}
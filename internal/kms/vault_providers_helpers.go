@@ -1,6 +1,7 @@
 package kms
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"fmt"
 	"strings"
@@ -25,6 +26,12 @@ const (
 	LocalStorageFileName = "kms_localstorage_keys.json"
 )
 
+// pingVault checks that vault is reachable and that the token of the client is still valid
+func pingVault(ctx context.Context, vaultCli *api.Client) error {
+	_, err := vaultCli.Auth().Token().LookupSelfWithContext(ctx)
+	return errors.WithStack(err)
+}
+
 func saveKeyMaterial(vaultCli *api.Client, path string, jsonObj map[string]string) error {
 	secret := map[string]interface{}{"data": jsonObj}
 	vaultPath := absVaultSecretPath(path)
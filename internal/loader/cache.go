@@ -6,6 +6,7 @@ import (
 
 	"github.com/polygonid/sh-id-platform/internal/cache"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/metrics"
 )
 
 type schemaData struct {
@@ -24,9 +25,11 @@ type cached struct {
 // and caches it.
 // TTL for cached items is forever
 func (c *cached) Load(ctx context.Context) (schema []byte, extension string, err error) {
-	log.With("key", c.key(c.url))
+	ctx = log.With(ctx, "key", c.key(c.url))
 	d := schemaData{}
-	if found := c.cache.Get(ctx, c.key(c.url), &d); found {
+	found := c.cache.Get(ctx, c.key(c.url), &d)
+	metrics.LoaderCache(metrics.CacheSchema, found)
+	if found {
 		log.Debug(ctx, "schema found in cache")
 		return d.Schema, d.Extension, nil
 	}
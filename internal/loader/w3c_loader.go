@@ -8,6 +8,8 @@ import (
 	"github.com/iden3/go-schema-processor/v2/loaders"
 	shell "github.com/ipfs/go-ipfs-api"
 	"github.com/piprate/json-gold/ld"
+
+	"github.com/polygonid/sh-id-platform/internal/metrics"
 )
 
 const defaultSchemaCacheDuration = 30 * time.Minute
@@ -33,6 +35,7 @@ func (c *w3CDocumentLoaderLocalCache) Get(key string) (doc *ld.RemoteDocument, e
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
 	doc, ok := c.data[key]
+	metrics.LoaderCache(metrics.CacheDocument, ok)
 	if !ok {
 		return nil, time.Time{}, nil
 	}
@@ -9,21 +9,20 @@ import (
 )
 
 // ChiMiddleware installs an http middleware that logs any http request.
-func ChiMiddleware(ctx context.Context) func(handler http.Handler) http.Handler {
+func ChiMiddleware(_ context.Context) func(handler http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
-		return requestLogger(ctx)(next)
+		return requestLogger()(next)
 	}
 }
 
-func requestLogger(ctx context.Context) func(next http.Handler) http.Handler {
+func requestLogger() func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		fn := func(w http.ResponseWriter, r *http.Request) {
 			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
 			t1 := time.Now()
-			//nolint:contextcheck
 			defer func() {
 				ua := r.Header.Get("User-Agent")
-				Info(ctx,
+				Info(r.Context(),
 					"http req",
 					"req-id", middleware.GetReqID(r.Context()),
 					"method", r.Method,
@@ -5,6 +5,8 @@ import (
 	"context"
 	"io"
 	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Log configuration constants
@@ -33,13 +35,38 @@ func Config(level, format int, w io.Writer) {
 	if format == OutputJSON {
 		handler = slog.NewJSONHandler(w, &opts)
 	}
-	slog.SetDefault(slog.New(handler))
+	slog.SetDefault(slog.New(contextHandler{handler}))
+}
+
+type attrsContextKey struct{}
+
+// With returns a copy of ctx that adds the extra attributes from args parameters to the logs written with it.
+func With(ctx context.Context, args ...any) context.Context {
+	attrs, _ := ctx.Value(attrsContextKey{}).([]any)
+	return context.WithValue(ctx, attrsContextKey{}, append(attrs[:len(attrs):len(attrs)], args...))
+}
+
+// contextHandler adds to the records the attributes of the context and the ids of its trace span, if any.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if attrs, ok := ctx.Value(attrsContextKey{}).([]any); ok {
+		r.Add(attrs...)
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String("trace-id", sc.TraceID().String()), slog.String("span-id", sc.SpanID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
 }
 
-// With changes the default logger to include the extra attributes
-// from args parameters.
-func With(args ...any) {
-	slog.With(args...)
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
 }
 
 // Debug logs a debug message  using context logger
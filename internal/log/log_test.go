@@ -0,0 +1,34 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestContextAttributes(t *testing.T) {
+	var buf bytes.Buffer
+	Config(LevelDebug, OutputJSON, &buf)
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "request")
+	defer span.End()
+	ctx = With(ctx, "req-id", "abc")
+	first := With(ctx, "step", "first")
+	_ = With(ctx, "step", "second")
+
+	Info(first, "message", "key", "value")
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "message", record["msg"])
+	assert.Equal(t, "value", record["key"])
+	assert.Equal(t, "abc", record["req-id"])
+	assert.Equal(t, "first", record["step"])
+	assert.Equal(t, span.SpanContext().TraceID().String(), record["trace-id"])
+	assert.Equal(t, span.SpanContext().SpanID().String(), record["span-id"])
+}
@@ -0,0 +1,185 @@
+package metrics
+
+import (
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "issuer"
+
+const (
+	OutcomeSuccess = "success" // OutcomeSuccess operation succeeded
+	OutcomeFailure = "failure" // OutcomeFailure operation failed
+
+	StateConfirmed = "confirmed" // StateConfirmed state transaction confirmed
+	StateFailed    = "failed"    // StateFailed state transaction failed
+
+	CacheSchema   = "schema"   // CacheSchema cache of the schemas loaded by url
+	CacheDocument = "document" // CacheDocument cache of the JSON-LD documents
+
+	// AgentMessageUnsupported is the type label of the agent messages the node does not handle
+	AgentMessageUnsupported = "unsupported"
+)
+
+var (
+	credentialsIssued = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "credentials_issued_total",
+		Help:      "Credentials issued by schema type.",
+	}, []string{"schema"})
+
+	credentialsRevoked = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "credentials_revoked_total",
+		Help:      "Credentials revoked by schema type.",
+	}, []string{"schema"})
+
+	statePublishDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "state_publish_duration_seconds",
+		Help:      "Time to calculate the new state of an identity, generate the proof and send the transaction.",
+		Buckets:   []float64{0.5, 1, 2.5, 5, 10, 20, 30, 60, 120},
+	}, []string{"outcome"})
+
+	stateConfirmationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "state_confirmation_duration_seconds",
+		Help:      "Time from the state transaction until it is confirmed or failed, by network.",
+		Buckets:   []float64{10, 30, 60, 120, 300, 600, 1200, 3600},
+	}, []string{"network", "outcome"})
+
+	statePendingTransactions = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "state_pending_transactions",
+		Help:      "State transactions sent and waiting for confirmation, as seen in the last check.",
+	})
+
+	stateGasUsed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "state_gas_used_total",
+		Help:      "Gas used by the state transactions, by network.",
+	}, []string{"network"})
+
+	stateGasCost = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "state_gas_cost_wei_total",
+		Help:      "Cost in wei of the state transactions, gas used by effective gas price, by network.",
+	}, []string{"network"})
+
+	kmsSignDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "kms_sign_duration_seconds",
+		Help:      "Latency of the sign requests by key provider and key type.",
+		Buckets:   []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+	}, []string{"provider", "key_type", "outcome"})
+
+	agentMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "agent_messages_total",
+		Help:      "Messages received by the agent endpoints by type and media type.",
+	}, []string{"type", "media_type"})
+
+	loaderCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "loader_cache_requests_total",
+		Help:      "Lookups in the schema and document loader caches by result, hit or miss.",
+	}, []string{"cache", "result"})
+
+	rateLimitedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limited_requests_total",
+		Help:      "Requests to the public endpoints rejected by the rate limits, by endpoint class and limit, ip or did.",
+	}, []string{"class", "limit"})
+
+	auditLogRecordFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "audit_log_record_failures_total",
+		Help:      "Audit log entries that could not be recorded after the retries.",
+	})
+)
+
+// Handler returns the handler of the /metrics endpoint
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// CredentialIssued counts an issued credential. The identity is not a label, as the number of identities is not bounded.
+func CredentialIssued(schemaType string) {
+	credentialsIssued.WithLabelValues(schemaType).Inc()
+}
+
+// CredentialRevoked counts a revoked credential
+func CredentialRevoked(schemaType string) {
+	credentialsRevoked.WithLabelValues(schemaType).Inc()
+}
+
+// ObserveStatePublish records the time to publish a state since start and its outcome
+func ObserveStatePublish(start time.Time, err error) {
+	statePublishDuration.WithLabelValues(outcome(err)).Observe(time.Since(start).Seconds())
+}
+
+// ObserveStateConfirmation records the time since the state transaction was sent until it was confirmed or failed
+func ObserveStateConfirmation(network string, sentAt time.Time, confirmed bool) {
+	result := StateFailed
+	if confirmed {
+		result = StateConfirmed
+	}
+	stateConfirmationDuration.WithLabelValues(network, result).Observe(time.Since(sentAt).Seconds())
+}
+
+// SetPendingStateTransactions sets the number of state transactions waiting for confirmation
+func SetPendingStateTransactions(n int) {
+	statePendingTransactions.Set(float64(n))
+}
+
+// AddStateGas adds the gas used by a state transaction and its cost. gasPrice can be nil if it is unknown.
+func AddStateGas(network string, gasUsed uint64, gasPrice *big.Int) {
+	stateGasUsed.WithLabelValues(network).Add(float64(gasUsed))
+	if gasPrice == nil {
+		return
+	}
+	cost, _ := new(big.Float).SetInt(new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), gasPrice)).Float64()
+	stateGasCost.WithLabelValues(network).Add(cost)
+}
+
+// ObserveKMSSign records the latency of a sign request since start
+func ObserveKMSSign(provider, keyType string, start time.Time, err error) {
+	kmsSignDuration.WithLabelValues(provider, keyType, outcome(err)).Observe(time.Since(start).Seconds())
+}
+
+// AgentMessage counts a message received by the agent. The type must be one of the handled by the node or
+// AgentMessageUnsupported, so the callers cannot create new series.
+func AgentMessage(messageType, mediaType string) {
+	agentMessages.WithLabelValues(messageType, mediaType).Inc()
+}
+
+// LoaderCache counts a lookup in a loader cache
+func LoaderCache(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	loaderCacheRequests.WithLabelValues(cache, result).Inc()
+}
+
+// RateLimited counts a request rejected by the limit of an endpoint class
+func RateLimited(class, limit string) {
+	rateLimitedRequests.WithLabelValues(class, limit).Inc()
+}
+
+// AuditLogRecordFailed counts an audit log entry that could not be recorded
+func AuditLogRecordFailed() {
+	auditLogRecordFailures.Inc()
+}
+
+func outcome(err error) string {
+	if err != nil {
+		return OutcomeFailure
+	}
+	return OutcomeSuccess
+}
@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	t.Run("should count the credentials", func(t *testing.T) {
+		CredentialIssued("KYCAgeCredential")
+		CredentialIssued("KYCAgeCredential")
+		CredentialRevoked("KYCAgeCredential")
+		assert.Equal(t, float64(2), testutil.ToFloat64(credentialsIssued.WithLabelValues("KYCAgeCredential")))
+		assert.Equal(t, float64(1), testutil.ToFloat64(credentialsRevoked.WithLabelValues("KYCAgeCredential")))
+	})
+
+	t.Run("should add the gas and its cost", func(t *testing.T) {
+		AddStateGas("polygon:amoy", 21000, big.NewInt(30_000_000_000))
+		AddStateGas("polygon:amoy", 1000, nil)
+		assert.Equal(t, float64(22000), testutil.ToFloat64(stateGasUsed.WithLabelValues("polygon:amoy")))
+		assert.Equal(t, float64(21000*30_000_000_000), testutil.ToFloat64(stateGasCost.WithLabelValues("polygon:amoy")))
+	})
+
+	t.Run("should count the cache lookups", func(t *testing.T) {
+		LoaderCache(CacheSchema, true)
+		LoaderCache(CacheSchema, false)
+		LoaderCache(CacheSchema, false)
+		assert.Equal(t, float64(1), testutil.ToFloat64(loaderCacheRequests.WithLabelValues(CacheSchema, "hit")))
+		assert.Equal(t, float64(2), testutil.ToFloat64(loaderCacheRequests.WithLabelValues(CacheSchema, "miss")))
+	})
+
+	t.Run("should count the audit log failures", func(t *testing.T) {
+		AuditLogRecordFailed()
+		assert.Equal(t, float64(1), testutil.ToFloat64(auditLogRecordFailures))
+	})
+
+	t.Run("should expose the metrics", func(t *testing.T) {
+		ObserveKMSSign("vault", "BJJ", time.Now(), nil)
+		ObserveStatePublish(time.Now(), errors.New("failed"))
+		SetPendingStateTransactions(3)
+
+		rr := httptest.NewRecorder()
+		Handler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		body := rr.Body.String()
+		assert.Contains(t, body, `issuer_kms_sign_duration_seconds_count{key_type="BJJ",outcome="success",provider="vault"} 1`)
+		assert.Contains(t, body, `issuer_state_publish_duration_seconds_count{outcome="failure"} 1`)
+		assert.Contains(t, body, "issuer_state_pending_transactions 3")
+		assert.Contains(t, body, "go_goroutines")
+	})
+}
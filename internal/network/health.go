@@ -0,0 +1,87 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/health"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+// HealthChecks returns the readiness checks of the configured networks:
+// network:<blockchain>:<network> checks that the RPC serves the expected chain and that its latest block is not older than maxBlockAge.
+// rhs:<blockchain>:<network> checks that the reverse hash service answers, for the networks publishing off chain.
+// balance:<blockchain>:<network> checks that the publishing account holds at least minBalance wei, except in gas less networks.
+func (r *Resolver) HealthChecks(publishingKey kms.KeyID, maxBlockAge time.Duration, minBalance *big.Int) health.Monitors {
+	monitors := make(health.Monitors)
+	for prefix, clientConfig := range r.ethereumClients {
+		monitors["network:"+string(prefix)] = rpcCheck(clientConfig, maxBlockAge)
+		if !clientConfig.gasLess {
+			monitors["balance:"+string(prefix)] = balanceCheck(clientConfig, publishingKey, minBalance)
+		}
+		if settings, ok := r.rhsSettings[prefix]; ok && settings.RhsUrl != nil && (settings.Mode == OffChain || settings.Mode == All) {
+			monitors["rhs:"+string(prefix)] = rhsCheck(*settings.RhsUrl)
+		}
+	}
+	return monitors
+}
+
+func rpcCheck(clientConfig ResolverClientConfig, maxBlockAge time.Duration) health.Pinger {
+	return func(ctx context.Context) error {
+		chainID, err := clientConfig.client.ChainID(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot get chain id: %w", err)
+		}
+		if chainID.Cmp(big.NewInt(int64(clientConfig.chainID))) != 0 {
+			return fmt.Errorf("rpc serves chain id %s, expected %d", chainID, clientConfig.chainID)
+		}
+		header, err := clientConfig.client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("cannot get latest block: %w", err)
+		}
+		age := time.Since(time.Unix(int64(header.Time), 0))
+		if age > maxBlockAge {
+			return fmt.Errorf("latest block %s is %s old", header.Number, age.Truncate(time.Second))
+		}
+		return nil
+	}
+}
+
+func balanceCheck(clientConfig ResolverClientConfig, publishingKey kms.KeyID, minBalance *big.Int) health.Pinger {
+	return func(ctx context.Context) error {
+		address, err := clientConfig.client.Address(publishingKey)
+		if err != nil {
+			return fmt.Errorf("cannot get publishing address: %w", err)
+		}
+		balance, err := clientConfig.client.BalanceAt(ctx, address)
+		if err != nil {
+			return fmt.Errorf("cannot get balance of %s: %w", address, err)
+		}
+		if balance.Cmp(minBalance) < 0 {
+			return fmt.Errorf("balance of %s is %s wei, minimum %s", address, balance, minBalance)
+		}
+		return nil
+	}
+}
+
+// rhsCheck checks that the reverse hash service answers. Any response but a server error means it is reachable.
+func rhsCheck(url string) health.Pinger {
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("rhs answered %s", resp.Status)
+		}
+		return nil
+	}
+}
@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/iden3/contracts-abi/state/go/abi"
 	"github.com/iden3/go-iden3-auth/v2/pubsignals"
 	"github.com/iden3/go-iden3-auth/v2/state"
@@ -23,6 +25,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/eth"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/tracing"
 )
 
 const (
@@ -45,6 +48,8 @@ type StateResolvers map[string]pubsignals.StateResolver
 type ResolverClientConfig struct {
 	client          *eth.Client
 	contractAddress string
+	chainID         core.ChainID
+	gasLess         bool
 }
 
 // Resolver holds the resolver
@@ -125,11 +130,12 @@ func NewResolver(ctx context.Context, cfg config.Configuration, kms *kms.KMS, re
 				}
 			}
 			resolverPrefixKey := getResolverPrefixKey(chainName, networkName)
-			ethClient, err := ethclient.Dial(networkSettings.NetworkURL)
+			rpcClient, err := rpc.DialOptions(ctx, networkSettings.NetworkURL, rpc.WithHTTPClient(&http.Client{Transport: tracing.Transport(nil)}))
 			if err != nil {
 				log.Error(ctx, "cannot connect to ethereum network", "err", err, "networkURL", networkSettings.NetworkURL)
 				return nil, err
 			}
+			ethClient := ethclient.NewClient(rpcClient)
 
 			client := eth.NewClient(ethClient, &eth.ClientConfig{
 				DefaultGasLimit:        networkSettings.DefaultGasLimit,
@@ -144,17 +150,19 @@ func NewResolver(ctx context.Context, cfg config.Configuration, kms *kms.KMS, re
 				WaitBlockCycleTime:     networkSettings.WaitBlockCycleTime,
 			}, kms)
 
+			chainID, err := core.GetChainID(core.Blockchain(chainName), core.NetworkID(networkName))
+			if err != nil {
+				log.Error(ctx, "cannot get chain ID from blockchain and network", "err", err, "blockchain", chainName, "networl", networkName)
+				return nil, err
+			}
 			resolverClientConfig := &ResolverClientConfig{
 				client:          client,
 				contractAddress: networkSettings.ContractAddress,
+				chainID:         chainID,
+				gasLess:         networkSettings.GasLess,
 			}
 
 			ethereumClients[resolverPrefix(resolverPrefixKey)] = *resolverClientConfig
-			chainID, err := core.GetChainID(core.Blockchain(chainName), core.NetworkID(networkName))
-			if err != nil {
-				log.Error(ctx, "cannot get chain ID from blockchain and network", "err", err, "blockchain", chainName, "networl", networkName)
-				return nil, err
-			}
 			ethereumClientsByChainID[chainID] = *resolverClientConfig
 			settings := networkSettings.RhsSettings
 			settings.Iden3CommAgentStatus = strings.TrimSuffix(cfg.ServerUrl, "/")
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/iden3/iden3comm/v2"
 	"github.com/iden3/iden3comm/v2/packers"
 	"github.com/iden3/iden3comm/v2/protocol"
 
@@ -58,6 +59,22 @@ func NewRevokedMsg(claim *domain.Claim) ([]byte, error) {
 	return json.Marshal(statusUpdate)
 }
 
+// NewBasicMsg returns a plain iden3comm message built from the given connection message
+func NewBasicMsg(conn *domain.Connection, message *domain.ConnectionMessage) ([]byte, error) {
+	createdTime := message.CreatedAt.Unix()
+	basicMessage := &iden3comm.BasicMessage{
+		ID:          message.ID.String(),
+		Typ:         packers.MediaTypePlainMessage,
+		Type:        iden3comm.ProtocolMessage(message.Type),
+		ThreadID:    message.ThreadID,
+		Body:        message.Body,
+		From:        conn.IssuerDID.String(),
+		To:          conn.UserDID.String(),
+		CreatedTime: &createdTime,
+	}
+	return json.Marshal(basicMessage)
+}
+
 func toProtocolCredentialOffer(credentials []*domain.Claim) []protocol.CredentialOffer {
 	offers := make([]protocol.CredentialOffer, len(credentials))
 	for i := range credentials {
@@ -0,0 +1,293 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+const (
+	discoveryPath       = "/.well-known/openid-configuration"
+	defaultCacheTTL     = time.Hour
+	minRefreshInterval  = time.Minute
+	acceptableClockSkew = 30 * time.Second
+	httpTimeout         = 10 * time.Second
+)
+
+// ErrInvalidToken is returned when the bearer token is not valid
+var ErrInvalidToken = errors.New("invalid token")
+
+// Config - configuration of the verifier of the tokens of an OIDC provider
+type Config struct {
+	IssuerURL       string
+	Audience        string
+	JWKSURL         string
+	JWKSFile        string
+	CacheTTL        time.Duration
+	RolesClaim      string
+	IdentitiesClaim string
+	TenantClaim     string
+	AdminRole       string
+	ViewerRole      string
+}
+
+// Claims are the authorization claims of a verified token. The identities of a token of a tenant are the ones of
+// the tenant, set by the caller once the tenant identities are loaded.
+type Claims struct {
+	Subject    string
+	Roles      []string
+	Identities []string
+	TenantID   *uuid.UUID
+	scopes     map[domain.APIKeyScope]bool
+}
+
+// HasScope returns true if the roles of the token grant the scope
+func (c *Claims) HasScope(scope domain.APIKeyScope) bool {
+	return c.scopes[scope]
+}
+
+// IsRestricted returns true if the token can only manage some identities
+func (c *Claims) IsRestricted() bool {
+	return len(c.Identities) > 0 || c.TenantID != nil
+}
+
+// AllowsIdentity returns true if the token can manage the identity
+func (c *Claims) AllowsIdentity(did string) bool {
+	if !c.IsRestricted() {
+		return true
+	}
+	for _, identity := range c.Identities {
+		if identity == did {
+			return true
+		}
+	}
+	return false
+}
+
+// Verifier verifies the bearer JWTs issued by an OIDC provider with the keys of its JWKS.
+// The keys are cached and fetched again when they expire or a token is signed with an unknown key.
+type Verifier struct {
+	cfg         Config
+	client      *http.Client
+	mu          sync.Mutex
+	keys        jwk.Set
+	jwksURL     string
+	fetchedAt   time.Time
+	lastRefresh time.Time
+}
+
+// NewVerifier returns a verifier of the tokens of the provider. The local JWKS file, if any, is loaded once and
+// the remote keys are fetched on the first verification.
+func NewVerifier(cfg Config) (*Verifier, error) {
+	if cfg.IssuerURL == "" {
+		return nil, errors.New("oidc issuer url is not provided")
+	}
+	if cfg.Audience == "" {
+		return nil, errors.New("oidc audience is not provided")
+	}
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = defaultCacheTTL
+	}
+	v := &Verifier{cfg: cfg, client: &http.Client{Timeout: httpTimeout}, jwksURL: cfg.JWKSURL}
+	if cfg.JWKSFile != "" {
+		content, err := os.ReadFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read jwks file: %w", err)
+		}
+		if v.keys, err = jwk.Parse(content); err != nil {
+			return nil, fmt.Errorf("invalid jwks file: %w", err)
+		}
+	}
+	return v, nil
+}
+
+// Verify checks the signature, issuer, audience and validity period of the token and returns its claims
+func (v *Verifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	keys, err := v.keySet(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := v.parse(token, keys)
+	if err != nil && v.cfg.JWKSFile == "" {
+		// the provider may have rotated its keys
+		if keys, refreshErr := v.keySet(ctx, true); refreshErr == nil {
+			parsed, err = v.parse(token, keys)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	claims, err := v.claims(parsed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	return claims, nil
+}
+
+func (v *Verifier) parse(token string, keys jwk.Set) (jwt.Token, error) {
+	return jwt.ParseString(token,
+		jwt.WithKeySet(keys, jws.WithInferAlgorithmFromKey(true)),
+		jwt.WithValidate(true),
+		jwt.WithIssuer(v.cfg.IssuerURL),
+		jwt.WithAudience(v.cfg.Audience),
+		jwt.WithAcceptableSkew(acceptableClockSkew),
+	)
+}
+
+// keySet returns the cached keys, fetching them when they have expired. A forced refresh is only done once per
+// minRefreshInterval so tokens signed with unknown keys can't be used to flood the provider.
+func (v *Verifier) keySet(ctx context.Context, force bool) (jwk.Set, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.cfg.JWKSFile != "" {
+		return v.keys, nil
+	}
+	now := time.Now()
+	expired := v.keys == nil || now.Sub(v.fetchedAt) > v.cfg.CacheTTL
+	if !expired && (!force || now.Sub(v.lastRefresh) < minRefreshInterval) {
+		if force {
+			return nil, errors.New("jwks refreshed recently")
+		}
+		return v.keys, nil
+	}
+	v.lastRefresh = now
+	keys, err := v.fetchKeys(ctx)
+	if err != nil {
+		if v.keys != nil && !force {
+			// keep serving the cached keys while the provider is not reachable
+			return v.keys, nil
+		}
+		return nil, err
+	}
+	v.keys, v.fetchedAt = keys, now
+	return keys, nil
+}
+
+func (v *Verifier) fetchKeys(ctx context.Context) (jwk.Set, error) {
+	if v.jwksURL == "" {
+		discovery := struct {
+			JWKSURI string `json:"jwks_uri"`
+		}{}
+		if err := v.getJSON(ctx, strings.TrimSuffix(v.cfg.IssuerURL, "/")+discoveryPath, &discovery); err != nil {
+			return nil, fmt.Errorf("cannot discover the oidc configuration: %w", err)
+		}
+		if discovery.JWKSURI == "" {
+			return nil, errors.New("the oidc configuration has no jwks_uri")
+		}
+		v.jwksURL = discovery.JWKSURI
+	}
+	var raw json.RawMessage
+	if err := v.getJSON(ctx, v.jwksURL, &raw); err != nil {
+		return nil, fmt.Errorf("cannot fetch the jwks: %w", err)
+	}
+	return jwk.Parse(raw)
+}
+
+func (v *Verifier) getJSON(ctx context.Context, url string, dst any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(dst)
+}
+
+// claims maps the roles of the token to scopes. The admin role grants every scope but the approval of pending
+// actions, which is a separate duty, the viewer role the read scopes and any other role that is a scope name grants
+// that scope. The tenant claim, if present, has to be a single tenant id.
+func (v *Verifier) claims(token jwt.Token) (*Claims, error) {
+	claims := &Claims{scopes: make(map[domain.APIKeyScope]bool)}
+	claims.Subject, _ = token.Subject()
+	claims.Roles = stringsClaim(token, v.cfg.RolesClaim)
+	claims.Identities = stringsClaim(token, v.cfg.IdentitiesClaim)
+	if tenant := stringsClaim(token, v.cfg.TenantClaim); len(tenant) > 0 {
+		if len(tenant) > 1 {
+			return nil, errors.New("the token has more than one tenant")
+		}
+		tenantID, err := uuid.Parse(tenant[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid tenant: %w", err)
+		}
+		claims.TenantID = &tenantID
+	}
+
+	known := make(map[domain.APIKeyScope]bool)
+	for _, scope := range domain.APIKeyScopes() {
+		known[scope] = true
+	}
+	for _, role := range claims.Roles {
+		switch {
+		case v.cfg.AdminRole != "" && role == v.cfg.AdminRole:
+			for scope := range known {
+				if scope != domain.APIKeyScopePendingActionsApprove {
+					claims.scopes[scope] = true
+				}
+			}
+		case v.cfg.ViewerRole != "" && role == v.cfg.ViewerRole:
+			for scope := range known {
+				if strings.HasSuffix(string(scope), ":read") {
+					claims.scopes[scope] = true
+				}
+			}
+		case known[domain.APIKeyScope(role)]:
+			claims.scopes[domain.APIKeyScope(role)] = true
+		}
+	}
+	return claims, nil
+}
+
+// stringsClaim returns the values of a claim that is a string list or a space separated string.
+// Nested claims are separated by dots.
+func stringsClaim(token jwt.Token, name string) []string {
+	if name == "" {
+		return nil
+	}
+	path := strings.Split(name, ".")
+	var value any
+	if err := token.Get(path[0], &value); err != nil {
+		return nil
+	}
+	for _, key := range path[1:] {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	switch value := value.(type) {
+	case string:
+		return strings.Fields(value)
+	case []any:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	case []string:
+		return value
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+const (
+	testAudience = "issuer-node"
+	testDID      = "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"
+)
+
+func TestVerifier_Verify(t *testing.T) {
+	ctx := context.Background()
+	key, jwks := newTestKey(t, "key-1")
+
+	var fetches atomic.Int32
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case discoveryPath:
+			assert.NoError(t, json.NewEncoder(w).Encode(map[string]string{"jwks_uri": server.URL + "/jwks"}))
+		case "/jwks":
+			fetches.Add(1)
+			assert.NoError(t, json.NewEncoder(w).Encode(jwks))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	verifier, err := NewVerifier(Config{
+		IssuerURL:       server.URL,
+		Audience:        testAudience,
+		RolesClaim:      "realm_access.roles",
+		IdentitiesClaim: "identities",
+		TenantClaim:     "tenant",
+		AdminRole:       "issuer-admin",
+		ViewerRole:      "issuer-viewer",
+	})
+	require.NoError(t, err)
+
+	t.Run("should map the roles to scopes", func(t *testing.T) {
+		token := signTestToken(t, key, server.URL, testAudience, time.Hour, map[string]any{
+			"realm_access": map[string]any{"roles": []string{"issuer-viewer", "credentials:write", "unknown"}},
+			"identities":   []string{testDID},
+		})
+		claims, err := verifier.Verify(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", claims.Subject)
+		assert.True(t, claims.HasScope(domain.APIKeyScopeCredentialsRead))
+		assert.True(t, claims.HasScope(domain.APIKeyScopeCredentialsWrite))
+		assert.False(t, claims.HasScope(domain.APIKeyScopeIdentitiesWrite))
+		assert.True(t, claims.AllowsIdentity(testDID))
+		assert.False(t, claims.AllowsIdentity("did:polygonid:polygon:amoy:other"))
+	})
+
+	t.Run("should grant every scope but the approvals to admins", func(t *testing.T) {
+		token := signTestToken(t, key, server.URL, testAudience, time.Hour, map[string]any{
+			"realm_access": map[string]any{"roles": []string{"issuer-admin"}},
+		})
+		claims, err := verifier.Verify(ctx, token)
+		require.NoError(t, err)
+		for _, scope := range domain.APIKeyScopes() {
+			assert.Equal(t, scope != domain.APIKeyScopePendingActionsApprove, claims.HasScope(scope), scope)
+		}
+		assert.False(t, claims.IsRestricted())
+	})
+
+	t.Run("should read the tenant", func(t *testing.T) {
+		tenantID := uuid.New()
+		token := signTestToken(t, key, server.URL, testAudience, time.Hour, map[string]any{
+			"realm_access": map[string]any{"roles": []string{"issuer-admin"}},
+			"tenant":       tenantID.String(),
+		})
+		claims, err := verifier.Verify(ctx, token)
+		require.NoError(t, err)
+		require.NotNil(t, claims.TenantID)
+		assert.Equal(t, tenantID, *claims.TenantID)
+		assert.True(t, claims.IsRestricted())
+		assert.False(t, claims.AllowsIdentity(testDID))
+
+		_, err = verifier.Verify(ctx, signTestToken(t, key, server.URL, testAudience, time.Hour, map[string]any{"tenant": "acme"}))
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("should cache the keys", func(t *testing.T) {
+		assert.Equal(t, int32(1), fetches.Load())
+	})
+
+	for _, tc := range []struct {
+		name     string
+		issuer   string
+		audience string
+		validFor time.Duration
+	}{
+		{name: "should reject another audience", issuer: server.URL, audience: "other", validFor: time.Hour},
+		{name: "should reject another issuer", issuer: "https://other", audience: testAudience, validFor: time.Hour},
+		{name: "should reject expired tokens", issuer: server.URL, audience: testAudience, validFor: -time.Hour},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := verifier.Verify(ctx, signTestToken(t, key, tc.issuer, tc.audience, tc.validFor, nil))
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+
+	t.Run("should reject tokens of unknown keys", func(t *testing.T) {
+		otherKey, _ := newTestKey(t, "key-2")
+		_, err := verifier.Verify(ctx, signTestToken(t, otherKey, server.URL, testAudience, time.Hour, nil))
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
+
+func TestVerifier_JWKSFile(t *testing.T) {
+	key, jwks := newTestKey(t, "key-1")
+	content, err := json.Marshal(jwks)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+
+	verifier, err := NewVerifier(Config{IssuerURL: "https://sso.example.com", Audience: testAudience, JWKSFile: path, RolesClaim: "roles"})
+	require.NoError(t, err)
+	claims, err := verifier.Verify(context.Background(), signTestToken(t, key, "https://sso.example.com", testAudience, time.Hour, map[string]any{"roles": "keys:read schemas:read"}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"keys:read", "schemas:read"}, claims.Roles)
+	assert.True(t, claims.HasScope(domain.APIKeyScopeKeysRead))
+}
+
+func newTestKey(t *testing.T, kid string) (jwk.Key, jwk.Set) {
+	t.Helper()
+	raw, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	key, err := jwk.Import(raw)
+	require.NoError(t, err)
+	require.NoError(t, key.Set(jwk.KeyIDKey, kid))
+	require.NoError(t, key.Set(jwk.AlgorithmKey, jwa.ES256()))
+	public, err := key.PublicKey()
+	require.NoError(t, err)
+	set := jwk.NewSet()
+	require.NoError(t, set.AddKey(public))
+	return key, set
+}
+
+func signTestToken(t *testing.T, key jwk.Key, issuer, audience string, validFor time.Duration, claims map[string]any) string {
+	t.Helper()
+	builder := jwt.NewBuilder().
+		Issuer(issuer).
+		Audience([]string{audience}).
+		Subject("user-1").
+		IssuedAt(time.Now().Add(-2 * time.Hour)).
+		Expiration(time.Now().Add(validFor))
+	for name, value := range claims {
+		builder = builder.Claim(name, value)
+	}
+	token, err := builder.Build()
+	require.NoError(t, err)
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.ES256(), key))
+	require.NoError(t, err)
+	return string(signed)
+}
@@ -21,12 +21,13 @@ var (
 
 // BJJSinger represents signer with BJJ key
 type BJJSinger struct {
-	kms   kms.KMSType
-	keyID kms.KeyID
+	kms     kms.KMSType
+	keyID   kms.KeyID
+	purpose kms.SignPurpose
 }
 
-// NewBJJSigner creates new instance oj BJJ signer
-func NewBJJSigner(keyMS kms.KMSType, keyID kms.KeyID) (*BJJSinger, error) {
+// NewBJJSigner creates new instance oj BJJ signer that signs for the given purpose
+func NewBJJSigner(keyMS kms.KMSType, keyID kms.KeyID, purpose kms.SignPurpose) (*BJJSinger, error) {
 	if keyID.Type != kms.KeyTypeBabyJubJub {
 		return nil, errors.New("wrong key type")
 	}
@@ -36,7 +37,7 @@ func NewBJJSigner(keyMS kms.KMSType, keyID kms.KeyID) (*BJJSinger, error) {
 	if keyMS == nil {
 		return nil, errors.New("KMS is nil")
 	}
-	return &BJJSinger{keyMS, keyID}, nil
+	return &BJJSinger{keyMS, keyID, purpose}, nil
 }
 
 // Sign signs prepared data ( value in field Q)
@@ -44,7 +45,7 @@ func (s *BJJSinger) Sign(ctx context.Context, data []byte) ([]byte, error) {
 	if s.kms == nil {
 		return nil, errors.WithStack(errorNotInitialized)
 	}
-	return s.kms.Sign(ctx, s.keyID, data)
+	return s.kms.Sign(ctx, s.keyID, s.purpose, data)
 }
 
 // BJJVerifier represents verifier with BJJ key
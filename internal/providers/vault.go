@@ -7,6 +7,8 @@ import (
 	"time"
 
 	vault "github.com/hashicorp/vault/api"
+	approle "github.com/hashicorp/vault/api/auth/approle"
+	kubernetes "github.com/hashicorp/vault/api/auth/kubernetes"
 	auth2 "github.com/hashicorp/vault/api/auth/userpass"
 
 	"github.com/polygonid/sh-id-platform/internal/log"
@@ -15,13 +17,26 @@ import (
 const (
 	increment = 1440
 	user      = "issuernode"
+
+	// reloginDelay is the time to wait before trying to log in again after a failed login
+	reloginDelay = 10 * time.Second
+)
+
+// List of vault authentication methods
+const (
+	AuthMethodToken      = "token"      // AuthMethodToken static token
+	AuthMethodUserPass   = "userpass"   // AuthMethodUserPass userpass auth with the issuernode user
+	AuthMethodAppRole    = "approle"    // AuthMethodAppRole approle auth with a role id and a secret id
+	AuthMethodKubernetes = "kubernetes" // AuthMethodKubernetes kubernetes auth with the service account token of the pod
 )
 
 // HTTPClientTimeout http client timeout TODO: move to config
 const HTTPClientTimeout = 10 * time.Second
 
 // Config vault configuration
-// If UserPassAuthEnabled is true, then vault client will be created with userpass auth and Pass must be provided
+// If UserPassAuthEnabled is true, then vault client will be created with userpass auth and Pass must be provided.
+// AuthMethod selects the approle or kubernetes auth methods, it takes precedence over UserPassAuthEnabled.
+// AuthMountPath is the mount path of the auth method, the default path of the method if empty.
 type Config struct {
 	Address             string
 	UserPassAuthEnabled bool
@@ -30,24 +45,55 @@ type Config struct {
 	TLSEnabled          bool
 	CertPath            string
 	MountPath           string
+	AuthMethod          string
+	AuthMountPath       string
+	AppRoleRoleID       string
+	AppRoleSecretID     string
+	AppRoleSecretIDFile string
+	KubernetesRole      string
+	KubernetesTokenPath string
+}
+
+// authMethod returns the name of the configured auth method
+func (c Config) authMethod() string {
+	if c.AuthMethod != "" {
+		return c.AuthMethod
+	}
+	if c.UserPassAuthEnabled {
+		return AuthMethodUserPass
+	}
+	return AuthMethodToken
+}
+
+// LoginRequired returns true if the client logs in to get its token, so it has to be renewed with RenewToken
+func (c Config) LoginRequired() bool {
+	return c.authMethod() != AuthMethodToken
 }
 
 // VaultClient checks vault configuration and creates new vault client
 func VaultClient(ctx context.Context, cfg Config) (*vault.Client, error) {
 	var vaultCli *vault.Client
 	var err error
-	if cfg.UserPassAuthEnabled {
+	switch cfg.authMethod() {
+	case AuthMethodAppRole, AuthMethodKubernetes:
+		log.Info(ctx, "Vault auth method enabled", "method", cfg.authMethod())
+		vaultCli, _, err = newVaultClientWithLogin(ctx, cfg)
+		if err != nil {
+			log.Error(ctx, "cannot init vault client with login: ", "err", err, "method", cfg.authMethod())
+			return nil, err
+		}
+	case AuthMethodUserPass:
 		log.Info(ctx, "Vault userpass auth enabled")
 		if cfg.Pass == "" {
 			log.Error(ctx, "Vault userpass auth enabled but password not provided")
 			return nil, errors.New("Vault userpass auth enabled but password not provided")
 		}
-		vaultCli, _, err = newVaultClientWithUserPassAuth(ctx, cfg)
+		vaultCli, _, err = newVaultClientWithLogin(ctx, cfg)
 		if err != nil {
 			log.Error(ctx, "cannot init vault client with userpass auth: ", "err", err)
 			return nil, err
 		}
-	} else {
+	case AuthMethodToken:
 		log.Info(ctx, "Vault userpass auth not enabled")
 		if cfg.Token == "" {
 			log.Error(ctx, "Vault userpass auth not enabled but token not provided")
@@ -58,6 +104,8 @@ func VaultClient(ctx context.Context, cfg Config) (*vault.Client, error) {
 			log.Error(ctx, "cannot init vault client: ", "err", err)
 			return nil, err
 		}
+	default:
+		return nil, fmt.Errorf("unknown vault auth method %q", cfg.AuthMethod)
 	}
 
 	return vaultCli, nil
@@ -92,8 +140,8 @@ func newVaultClientWithToken(cfg Config) (*vault.Client, error) {
 	return client, nil
 }
 
-// newVaultClientWithUserPassAuth checks vault configuration and creates new vault client with userpass auth
-func newVaultClientWithUserPassAuth(ctx context.Context, cfg Config) (*vault.Client, *vault.Secret, error) {
+// newVaultClientWithLogin checks vault configuration and creates new vault client logged in with the auth method
+func newVaultClientWithLogin(ctx context.Context, cfg Config) (*vault.Client, *vault.Secret, error) {
 	config := vault.DefaultConfig()
 	config.Address = cfg.Address
 	config.HttpClient.Timeout = HTTPClientTimeout
@@ -109,48 +157,85 @@ func newVaultClientWithUserPassAuth(ctx context.Context, cfg Config) (*vault.Cli
 
 	client, err := vault.NewClient(config)
 	if err != nil {
-		log.Error(ctx, "error creating vault client with userpass auth", "error", err)
+		log.Error(ctx, "error creating vault client with login", "error", err, "method", cfg.authMethod())
 		return nil, nil, err
 	}
 
-	secret, err := login(ctx, client, user, cfg.Pass)
+	secret, err := login(ctx, client, cfg)
 	if err != nil {
-		log.Error(ctx, "error logging in to vault with userpass auth", "error", err)
 		return nil, nil, err
 	}
 
-	log.Info(ctx, "successfully logged in to vault with userpass auth", "token", secret.Auth.ClientToken)
+	log.Info(ctx, "successfully logged in to vault", "method", cfg.authMethod())
 	return client, secret, nil
 }
 
-func login(ctx context.Context, client *vault.Client, user string, pass string) (*vault.Secret, error) {
-	userPass, err := auth2.NewUserpassAuth(user, &auth2.Password{
-		FromString: pass,
-	})
+// newAuthMethod returns the vault auth method of the configuration
+func newAuthMethod(cfg Config) (vault.AuthMethod, error) {
+	switch cfg.authMethod() {
+	case AuthMethodUserPass:
+		opts := make([]auth2.LoginOption, 0)
+		if cfg.AuthMountPath != "" {
+			opts = append(opts, auth2.WithMountPath(cfg.AuthMountPath))
+		}
+		return auth2.NewUserpassAuth(user, &auth2.Password{FromString: cfg.Pass}, opts...)
+	case AuthMethodAppRole:
+		if cfg.AppRoleRoleID == "" {
+			return nil, errors.New("vault approle role id is not specified")
+		}
+		secretID := &approle.SecretID{FromString: cfg.AppRoleSecretID, FromFile: cfg.AppRoleSecretIDFile}
+		opts := make([]approle.LoginOption, 0)
+		if cfg.AuthMountPath != "" {
+			opts = append(opts, approle.WithMountPath(cfg.AuthMountPath))
+		}
+		return approle.NewAppRoleAuth(cfg.AppRoleRoleID, secretID, opts...)
+	case AuthMethodKubernetes:
+		if cfg.KubernetesRole == "" {
+			return nil, errors.New("vault kubernetes role is not specified")
+		}
+		opts := make([]kubernetes.LoginOption, 0)
+		if cfg.AuthMountPath != "" {
+			opts = append(opts, kubernetes.WithMountPath(cfg.AuthMountPath))
+		}
+		if cfg.KubernetesTokenPath != "" {
+			opts = append(opts, kubernetes.WithServiceAccountTokenPath(cfg.KubernetesTokenPath))
+		}
+		return kubernetes.NewKubernetesAuth(cfg.KubernetesRole, opts...)
+	default:
+		return nil, fmt.Errorf("vault auth method %q does not log in", cfg.authMethod())
+	}
+}
+
+func login(ctx context.Context, client *vault.Client, cfg Config) (*vault.Secret, error) {
+	authMethod, err := newAuthMethod(cfg)
 	if err != nil {
-		log.Error(ctx, "error creating userpass auth", "error", err)
+		log.Error(ctx, "error creating vault auth method", "error", err, "method", cfg.authMethod())
 		return nil, err
 	}
 
-	secret, err := client.Auth().Login(ctx, userPass)
+	secret, err := client.Auth().Login(ctx, authMethod)
 	if err != nil {
-		log.Error(ctx, "error logging in to vault with userpass auth", "error", err)
+		log.Error(ctx, "error logging in to vault", "error", err, "method", cfg.authMethod())
 		return nil, err
 	}
 
 	return secret, nil
 }
 
-// RenewToken renews token
+// RenewToken renews the token of a client created with a login auth method and logs in again when the token
+// can no longer be renewed or expires. It returns when the context is cancelled.
 func RenewToken(ctx context.Context, client *vault.Client, cfg Config) {
-	for {
-		vaultLoginResp, err := login(ctx, client, user, cfg.Pass)
+	for ctx.Err() == nil {
+		vaultLoginResp, err := login(ctx, client, cfg)
 		if err != nil {
-			log.Error(ctx, "unable to authenticate to Vault: %v", "err", err)
+			log.Error(ctx, "unable to authenticate to Vault", "err", err)
+			wait(ctx, reloginDelay)
+			continue
 		}
 		tokenErr := manageTokenLifecycle(ctx, client, vaultLoginResp)
 		if tokenErr != nil {
-			log.Error(ctx, "unable to start managing token lifecycle: %v", "err", tokenErr)
+			log.Error(ctx, "unable to start managing token lifecycle", "err", tokenErr)
+			wait(ctx, reloginDelay)
 		}
 	}
 }
@@ -158,7 +243,13 @@ func RenewToken(ctx context.Context, client *vault.Client, cfg Config) {
 func manageTokenLifecycle(ctx context.Context, client *vault.Client, token *vault.Secret) error {
 	renew := token.Auth.Renewable // You may notice a different top-level field called Renewable. That one is used for dynamic secrets renewal, not token renewal.
 	if !renew {
-		log.Info(ctx, "Token is not configured to be renewable. Re-attempting login.")
+		// log in again when two thirds of the token ttl have passed
+		ttl := time.Duration(token.Auth.LeaseDuration) * time.Second * 2 / 3
+		if ttl < reloginDelay {
+			ttl = reloginDelay
+		}
+		log.Info(ctx, "Token is not configured to be renewable. Re-attempting login before it expires.", "in", ttl)
+		wait(ctx, ttl)
 		return nil
 	}
 
@@ -175,6 +266,8 @@ func manageTokenLifecycle(ctx context.Context, client *vault.Client, token *vaul
 
 	for {
 		select {
+		case <-ctx.Done():
+			return nil
 		// `DoneCh` will return if renewal fails, or if the remaining lease
 		// duration is under a built-in threshold and either renewing is not
 		// extending it or renewing is disabled. In any case, the caller
@@ -194,3 +287,13 @@ func manageTokenLifecycle(ctx context.Context, client *vault.Client, token *vaul
 		}
 	}
 }
+
+// wait blocks for the given duration or until the context is cancelled
+func wait(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
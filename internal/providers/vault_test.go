@@ -0,0 +1,72 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultClient_LoginAuthMethods(t *testing.T) {
+	ctx := context.Background()
+	logins := make(map[string]map[string]interface{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := make(map[string]interface{})
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		logins[r.URL.Path] = body
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"auth": map[string]interface{}{"client_token": "token-" + r.URL.Path, "renewable": true, "lease_duration": 3600},
+		}))
+	}))
+	defer server.Close()
+
+	t.Run("should log in with approle", func(t *testing.T) {
+		cfg := Config{Address: server.URL, AuthMethod: AuthMethodAppRole, AppRoleRoleID: "role-id", AppRoleSecretID: "secret-id"}
+		client, err := VaultClient(ctx, cfg)
+		require.NoError(t, err)
+		assert.True(t, cfg.LoginRequired())
+		assert.Equal(t, "token-/v1/auth/approle/login", client.Token())
+		assert.Equal(t, map[string]interface{}{"role_id": "role-id", "secret_id": "secret-id"}, logins["/v1/auth/approle/login"])
+	})
+
+	t.Run("should log in with kubernetes service account token", func(t *testing.T) {
+		tokenPath := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(tokenPath, []byte("service-account-jwt"), 0o600))
+		cfg := Config{
+			Address:             server.URL,
+			AuthMethod:          AuthMethodKubernetes,
+			AuthMountPath:       "k8s-cluster",
+			KubernetesRole:      "issuer-node",
+			KubernetesTokenPath: tokenPath,
+		}
+		client, err := VaultClient(ctx, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "token-/v1/auth/k8s-cluster/login", client.Token())
+		assert.Equal(t, map[string]interface{}{"role": "issuer-node", "jwt": "service-account-jwt"}, logins["/v1/auth/k8s-cluster/login"])
+	})
+
+	t.Run("should fail without approle role id", func(t *testing.T) {
+		_, err := VaultClient(ctx, Config{Address: server.URL, AuthMethod: AuthMethodAppRole, AppRoleSecretID: "secret-id"})
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail with unknown auth method", func(t *testing.T) {
+		_, err := VaultClient(ctx, Config{Address: server.URL, AuthMethod: "ldap"})
+		assert.Error(t, err)
+	})
+
+	t.Run("should use the static token", func(t *testing.T) {
+		cfg := Config{Address: server.URL, Token: "static-token"}
+		client, err := VaultClient(ctx, cfg)
+		require.NoError(t, err)
+		assert.False(t, cfg.LoginRequired())
+		assert.Equal(t, "static-token", client.Token())
+	})
+}
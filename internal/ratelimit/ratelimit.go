@@ -0,0 +1,72 @@
+// Package ratelimit limits the requests of the callers of the public endpoints with fixed window counters kept in
+// the cache, so the limits are shared by all the instances of the node.
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/cache"
+)
+
+const keyPrefix = "issuer-rate-limit:"
+
+// Limit is the maximum number of requests allowed in each window. A limit without requests is disabled.
+type Limit struct {
+	Requests int
+	Window   time.Duration
+}
+
+// Enabled returns true when the limit rejects requests
+func (l Limit) Enabled() bool {
+	return l.Requests > 0 && l.Window > 0
+}
+
+// Limiter counts the requests of each caller
+type Limiter struct {
+	store cache.Cache
+}
+
+// New returns a Limiter that keeps the counters in store
+func New(store cache.Cache) *Limiter {
+	return &Limiter{store: store}
+}
+
+// Allow counts a request identified by key, like the endpoint class and the caller ip, and tells whether it is
+// within the limit. When it is not, it also returns the time until the window of the key ends.
+func (l *Limiter) Allow(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	if !limit.Enabled() {
+		return true, 0, nil
+	}
+	count, ttl, err := l.store.Increment(ctx, keyPrefix+key, limit.Window)
+	if err != nil {
+		return false, 0, err
+	}
+	if count <= int64(limit.Requests) {
+		return true, 0, nil
+	}
+	if ttl <= 0 || ttl > limit.Window {
+		ttl = limit.Window
+	}
+	return false, ttl, nil
+}
+
+// ClientIP returns the ip of the caller. When header is set, the ip is taken from that header, that has to be set by
+// a trusted proxy. For headers with a list of addresses, like X-Forwarded-For, the last one is used, as it is the one
+// added by the proxy. Otherwise, or when the header is missing, the remote address of the connection is used.
+func ClientIP(r *http.Request, header string) string {
+	if header != "" {
+		if value := r.Header.Get(header); value != "" {
+			addresses := strings.Split(value, ",")
+			return strings.TrimSpace(addresses[len(addresses)-1])
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
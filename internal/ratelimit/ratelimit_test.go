@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/cache"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	ctx := context.Background()
+	limiter := New(cache.NewMemoryCache())
+	limit := Limit{Requests: 2, Window: time.Minute}
+
+	for i := 0; i < limit.Requests; i++ {
+		allowed, _, err := limiter.Allow(ctx, "agent:ip:10.0.0.1", limit)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	}
+
+	allowed, retryAfter, err := limiter.Allow(ctx, "agent:ip:10.0.0.1", limit)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Greater(t, retryAfter, 50*time.Second)
+	assert.LessOrEqual(t, retryAfter, time.Minute)
+
+	t.Run("should count each key apart", func(t *testing.T) {
+		allowed, _, err := limiter.Allow(ctx, "agent:ip:10.0.0.2", limit)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("should allow everything when disabled", func(t *testing.T) {
+		allowed, _, err := limiter.Allow(ctx, "agent:ip:10.0.0.1", Limit{Window: time.Minute})
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("should start a new window", func(t *testing.T) {
+		short := Limit{Requests: 1, Window: 50 * time.Millisecond}
+		allowed, _, err := limiter.Allow(ctx, "callback:ip:10.0.0.1", short)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+		allowed, _, err = limiter.Allow(ctx, "callback:ip:10.0.0.1", short)
+		require.NoError(t, err)
+		assert.False(t, allowed)
+		time.Sleep(60 * time.Millisecond)
+		allowed, _, err = limiter.Allow(ctx, "callback:ip:10.0.0.1", short)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	})
+}
+
+func TestClientIP(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/v2/agent", nil)
+	r.RemoteAddr = "10.0.0.1:51234"
+	r.Header.Set("X-Forwarded-For", "1.1.1.1, 203.0.113.7")
+
+	assert.Equal(t, "10.0.0.1", ClientIP(r, ""))
+	assert.Equal(t, "203.0.113.7", ClientIP(r, "X-Forwarded-For"))
+	assert.Equal(t, "10.0.0.1", ClientIP(r, "X-Real-IP"))
+}
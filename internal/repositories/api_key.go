@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrAPIKeyNotFound api key not found
+var ErrAPIKeyNotFound = errors.New("api key not found")
+
+type apiKey struct{}
+
+// NewAPIKey returns a new api keys repository
+func NewAPIKey() ports.APIKeyRepository {
+	return &apiKey{}
+}
+
+// Save stores the api key. Only the name, the expiration and the revocation of an existing key can change.
+func (a *apiKey) Save(ctx context.Context, conn db.Querier, key *domain.APIKey) error {
+	scopes := make([]string, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+	identities := key.Identities
+	if identities == nil {
+		identities = []string{}
+	}
+	_, err := conn.Exec(ctx,
+		`INSERT INTO api_keys (id, name, key_hash, scopes, identities, created_at, expires_at, revoked_at, last_used_at, tenant_id)
+				VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (id) DO
+				UPDATE SET name=$2, expires_at=$7, revoked_at=$8`,
+		key.ID, key.Name, key.KeyHash, scopes, identities, key.CreatedAt, key.ExpiresAt, key.RevokedAt, key.LastUsedAt, key.TenantID)
+	return err
+}
+
+// GetByID returns the api key with the given id
+func (a *apiKey) GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.APIKey, error) {
+	row := conn.QueryRow(ctx,
+		`SELECT id, name, key_hash, scopes, identities, created_at, expires_at, revoked_at, last_used_at, tenant_id
+				FROM api_keys WHERE id = $1`, id)
+	key, err := scanAPIKey(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	return key, nil
+}
+
+// GetAll returns all the api keys, the newest first
+func (a *apiKey) GetAll(ctx context.Context, conn db.Querier) ([]domain.APIKey, error) {
+	rows, err := conn.Query(ctx,
+		`SELECT id, name, key_hash, scopes, identities, created_at, expires_at, revoked_at, last_used_at, tenant_id
+				FROM api_keys ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]domain.APIKey, 0)
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *key)
+	}
+	return keys, rows.Err()
+}
+
+// UpdateLastUsed sets the last time the api key was used
+func (a *apiKey) UpdateLastUsed(ctx context.Context, conn db.Querier, id uuid.UUID, lastUsedAt time.Time) error {
+	_, err := conn.Exec(ctx, `UPDATE api_keys SET last_used_at = $2 WHERE id = $1`, id, lastUsedAt)
+	return err
+}
+
+func scanAPIKey(row pgx.Row) (*domain.APIKey, error) {
+	var key domain.APIKey
+	var scopes []string
+	if err := row.Scan(&key.ID, &key.Name, &key.KeyHash, &scopes, &key.Identities, &key.CreatedAt, &key.ExpiresAt, &key.RevokedAt, &key.LastUsedAt, &key.TenantID); err != nil {
+		return nil, err
+	}
+	key.Scopes = make([]domain.APIKeyScope, 0, len(scopes))
+	for _, scope := range scopes {
+		key.Scopes = append(key.Scopes, domain.APIKeyScope(scope))
+	}
+	return &key, nil
+}
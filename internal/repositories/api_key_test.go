@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestAPIKeys(t *testing.T) {
+	ctx := context.Background()
+	repo := NewAPIKey()
+	issuerDID := randomDID(t)
+
+	key := domain.NewAPIKey("business unit", []byte{1, 2, 3}, []domain.APIKeyScope{domain.APIKeyScopeCredentialsWrite}, []string{issuerDID.String()}, nil)
+	unrestricted := domain.NewAPIKey("admin", []byte{4, 5, 6}, []domain.APIKeyScope{domain.APIKeyScopeAPIKeysAdmin}, nil, common.ToPointer(time.Now().Add(time.Hour)))
+
+	t.Run("should save and get the api keys", func(t *testing.T) {
+		require.NoError(t, repo.Save(ctx, storage.Pgx, key))
+		require.NoError(t, repo.Save(ctx, storage.Pgx, unrestricted))
+
+		got, err := repo.GetByID(ctx, storage.Pgx, key.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "business unit", got.Name)
+		assert.Equal(t, []byte{1, 2, 3}, got.KeyHash)
+		assert.Equal(t, []domain.APIKeyScope{domain.APIKeyScopeCredentialsWrite}, got.Scopes)
+		assert.Equal(t, []string{issuerDID.String()}, got.Identities)
+		assert.Nil(t, got.ExpiresAt)
+
+		got, err = repo.GetByID(ctx, storage.Pgx, unrestricted.ID)
+		require.NoError(t, err)
+		assert.Empty(t, got.Identities)
+		assert.NotNil(t, got.ExpiresAt)
+
+		keys, err := repo.GetAll(ctx, storage.Pgx)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, len(keys), 2)
+	})
+
+	t.Run("should revoke and update the last use of the api key", func(t *testing.T) {
+		now := time.Now()
+		require.NoError(t, repo.UpdateLastUsed(ctx, storage.Pgx, key.ID, now))
+		key.RevokedAt = &now
+		require.NoError(t, repo.Save(ctx, storage.Pgx, key))
+
+		got, err := repo.GetByID(ctx, storage.Pgx, key.ID)
+		require.NoError(t, err)
+		require.NotNil(t, got.RevokedAt)
+		require.NotNil(t, got.LastUsedAt)
+		assert.False(t, got.IsActive(time.Now()))
+	})
+
+	t.Run("should return not found", func(t *testing.T) {
+		_, err := repo.GetByID(ctx, storage.Pgx, uuid.New())
+		assert.ErrorIs(t, err, ErrAPIKeyNotFound)
+	})
+}
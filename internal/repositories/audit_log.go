@@ -0,0 +1,105 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const auditLogFields = `id, sequence, actor, action, identifier, resource, request_id, status_code, outcome, created_at, previous_hash, hash`
+
+type auditLog struct{}
+
+// NewAuditLog returns a new audit log repository
+func NewAuditLog() ports.AuditLogRepository {
+	return &auditLog{}
+}
+
+// Append locks the table so entries are chained one after the other, chains the entry to the last one and stores it
+func (a *auditLog) Append(ctx context.Context, conn db.Querier, entry *domain.AuditLog) error {
+	if _, err := conn.Exec(ctx, `LOCK TABLE audit_logs IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+		return err
+	}
+	last, err := scanAuditLog(conn.QueryRow(ctx, `SELECT `+auditLogFields+` FROM audit_logs ORDER BY sequence DESC LIMIT 1`))
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	entry.Chain(last)
+	_, err = conn.Exec(ctx,
+		`INSERT INTO audit_logs (`+auditLogFields+`) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		entry.ID, entry.Sequence, entry.Actor, entry.Action, entry.Identifier, entry.Resource, entry.RequestID,
+		entry.StatusCode, entry.Outcome, entry.CreatedAt, entry.PreviousHash, entry.Hash)
+	return err
+}
+
+// Find returns the entries that match the filter sorted by sequence and the total number of them
+func (a *auditLog) Find(ctx context.Context, conn db.Querier, filter *ports.AuditLogFilter) ([]domain.AuditLog, uint, error) {
+	conditions := []string{"true"}
+	args := make([]interface{}, 0)
+	add := func(condition string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if filter.Actor != nil {
+		add("actor = $%d", *filter.Actor)
+	}
+	if filter.Action != nil {
+		add("action = $%d", *filter.Action)
+	}
+	if filter.Identifier != nil {
+		add("identifier = $%d", *filter.Identifier)
+	}
+	if filter.Outcome != nil {
+		add("outcome = $%d", string(*filter.Outcome))
+	}
+	if filter.From != nil {
+		add("created_at >= $%d", *filter.From)
+	}
+	if filter.To != nil {
+		add("created_at < $%d", *filter.To)
+	}
+	where := strings.Join(conditions, " AND ")
+
+	var total uint
+	if err := conn.QueryRow(ctx, `SELECT count(*) FROM audit_logs WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, filter.Pagination.GetOffset(), filter.Pagination.GetLimit())
+	rows, err := conn.Query(ctx,
+		fmt.Sprintf(`SELECT %s FROM audit_logs WHERE %s ORDER BY sequence OFFSET $%d LIMIT $%d`, auditLogFields, where, len(args)-1, len(args)),
+		args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	entries := make([]domain.AuditLog, 0)
+	for rows.Next() {
+		entry, err := scanAuditLog(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		entries = append(entries, *entry)
+	}
+	return entries, total, rows.Err()
+}
+
+func scanAuditLog(row pgx.Row) (*domain.AuditLog, error) {
+	var entry domain.AuditLog
+	var outcome string
+	if err := row.Scan(&entry.ID, &entry.Sequence, &entry.Actor, &entry.Action, &entry.Identifier, &entry.Resource,
+		&entry.RequestID, &entry.StatusCode, &outcome, &entry.CreatedAt, &entry.PreviousHash, &entry.Hash); err != nil {
+		return nil, err
+	}
+	entry.Outcome = domain.AuditOutcome(outcome)
+	entry.CreatedAt = entry.CreatedAt.UTC()
+	return &entry, nil
+}
@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/pagination"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+func TestAuditLogs(t *testing.T) {
+	ctx := context.Background()
+	repo := NewAuditLog()
+	did := randomDID(t)
+	identifier := did.String()
+	actor := "apikey:" + uuid.NewString()
+
+	first := domain.NewAuditLog(actor, "CreateCredential", &identifier, "POST /v2/identities/"+identifier+"/credentials", "req-1", http.StatusCreated)
+	second := domain.NewAuditLog(actor, "RevokeCredential", &identifier, "POST /v2/identities/"+identifier+"/credentials/revoke/1", "req-2", http.StatusForbidden)
+
+	t.Run("should append the entries to the chain", func(t *testing.T) {
+		require.NoError(t, storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+			if err := repo.Append(ctx, tx, first); err != nil {
+				return err
+			}
+			return repo.Append(ctx, tx, second)
+		}))
+		assert.Equal(t, first.Sequence+1, second.Sequence)
+		assert.Equal(t, first.Hash, second.PreviousHash)
+	})
+
+	t.Run("should find the entries", func(t *testing.T) {
+		entries, total, err := repo.Find(ctx, storage.Pgx, &ports.AuditLogFilter{Identifier: &identifier, Pagination: *pagination.NewFilter(nil, nil)})
+		require.NoError(t, err)
+		assert.Equal(t, uint(2), total)
+		require.Len(t, entries, 2)
+		assert.Equal(t, first.ID, entries[0].ID)
+		assert.True(t, entries[1].FollowsChain(&entries[0]))
+
+		entries, total, err = repo.Find(ctx, storage.Pgx, &ports.AuditLogFilter{
+			Actor:      &actor,
+			Outcome:    common.ToPointer(domain.AuditOutcomeFailure),
+			Pagination: *pagination.NewFilter(nil, nil),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint(1), total)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "RevokeCredential", entries[0].Action)
+	})
+
+	t.Run("should not update or delete the entries", func(t *testing.T) {
+		_, err := storage.Pgx.Exec(ctx, `UPDATE audit_logs SET outcome = 'success' WHERE id = $1`, second.ID)
+		assert.Error(t, err)
+		_, err = storage.Pgx.Exec(ctx, `DELETE FROM audit_logs WHERE id = $1`, first.ID)
+		assert.Error(t, err)
+	})
+}
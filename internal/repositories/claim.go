@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -900,6 +901,10 @@ func buildGetAllQueryAndFilters(issuerID w3c.DID, filter *ports.ClaimsFilter) (q
 		filters = append(filters, filter.Subject)
 		query = fmt.Sprintf("%s and other_identifier = $%d ", query, len(filters))
 	}
+	if len(filter.Subjects) > 0 {
+		filters = append(filters, filter.Subjects)
+		query = fmt.Sprintf("%s and other_identifier = ANY($%d) ", query, len(filters))
+	}
 	if filter.SchemaHash != "" {
 		filters = append(filters, fmt.Sprintf("%s%%", filter.SchemaHash))
 		query = fmt.Sprintf("%s and schema_hash like $%d", query, len(filters))
@@ -920,6 +925,17 @@ func buildGetAllQueryAndFilters(issuerID w3c.DID, filter *ports.ClaimsFilter) (q
 		filters = append(filters, filter.QueryField, filter.QueryFieldValue)
 		query = fmt.Sprintf("%s and data -> 'credentialSubject'  ->>$%d = $%d ", query, len(filters)-1, len(filters))
 	}
+	if len(filter.Attributes) > 0 {
+		fields := make([]string, 0, len(filter.Attributes))
+		for field := range filter.Attributes {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			filters = append(filters, field, filter.Attributes[field])
+			query = fmt.Sprintf("%s and data -> 'credentialSubject'  ->>$%d = $%d ", query, len(filters)-1, len(filters))
+		}
+	}
 	if filter.ExpiredOn != nil {
 		t := *filter.ExpiredOn
 		filters = append(filters, t.Unix())
@@ -947,7 +963,7 @@ func buildGetAllQueryAndFilters(issuerID w3c.DID, filter *ports.ClaimsFilter) (q
 		for _, term := range terms {
 			filters = append(filters, term)
 		}
-		if filter.Subject == "" {
+		if filter.Subject == "" && len(filter.Subjects) == 0 {
 			ftsConds += fmt.Sprintf(" %s %s", cond, buildPartialQueryDidLikes("claims.other_identifier", tokenizeQuery(filter.FTSQuery), cond))
 		}
 		query = fmt.Sprintf("%s AND (%s) ", query, ftsConds)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -28,6 +29,8 @@ type dbConnection struct {
 	UserDoc    pgtype.JSONB
 	CreatedAt  time.Time
 	ModifiedAt time.Time
+	Tags       []string
+	Notes      *string
 }
 
 type dbConnectionWithCredentials struct {
@@ -62,9 +65,76 @@ func (c *connection) SaveUserAuthentication(ctx context.Context, conn db.Querier
 	return err
 }
 
+// UpdateTagsAndNotes updates the issuer defined metadata (tags and notes) of the given connection
+func (c *connection) UpdateTagsAndNotes(ctx context.Context, conn db.Querier, connection *domain.Connection) error {
+	tags := connection.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	sql := `UPDATE connections SET tags = $1, notes = $2, modified_at = $3 WHERE id = $4 AND issuer_id = $5`
+	cmd, err := conn.Exec(ctx, sql, tags, connection.Notes, connection.ModifiedAt, connection.ID.String(), connection.IssuerDID.String())
+	if err != nil {
+		return err
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return ErrConnectionDoesNotExist
+	}
+
+	return nil
+}
+
+// UpdateUserDID replaces the holder identity and its DID document of the given connection
+func (c *connection) UpdateUserDID(ctx context.Context, conn db.Querier, connection *domain.Connection) error {
+	sql := `UPDATE connections SET user_id = $1, user_doc = $2, modified_at = $3 WHERE id = $4 AND issuer_id = $5`
+	cmd, err := conn.Exec(ctx, sql, connection.UserDID.String(), connection.UserDoc, connection.ModifiedAt, connection.ID.String(), connection.IssuerDID.String())
+	if err != nil {
+		return err
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return ErrConnectionDoesNotExist
+	}
+
+	return nil
+}
+
+// Merge moves the authentications, messages, proof requests and previous merges of the source connection to the target one,
+// adds the source tags and notes to the target and deletes the source connection.
+func (c *connection) Merge(ctx context.Context, conn db.Querier, issuerDID w3c.DID, sourceID uuid.UUID, targetID uuid.UUID) error {
+	sqlTags := `UPDATE connections AS target
+				SET tags = ARRAY(SELECT DISTINCT unnest(target.tags || source.tags)),
+					notes = NULLIF(concat_ws(E'\n', target.notes, source.notes), ''),
+					modified_at = $4
+				FROM connections AS source
+				WHERE target.id = $1 AND source.id = $2 AND target.issuer_id = $3 AND source.issuer_id = $3`
+	cmd, err := conn.Exec(ctx, sqlTags, targetID, sourceID, issuerDID.String(), time.Now())
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrConnectionDoesNotExist
+	}
+
+	for _, sql := range []string{
+		`UPDATE user_authentications SET connection_id = $1 WHERE connection_id = $2`,
+		`UPDATE connection_messages SET connection_id = $1 WHERE connection_id = $2`,
+		`UPDATE proof_requests SET connection_id = $1 WHERE connection_id = $2`,
+		`UPDATE connection_merges SET connection_id = $1 WHERE connection_id = $2`,
+	} {
+		if _, err := conn.Exec(ctx, sql, targetID, sourceID); err != nil {
+			return err
+		}
+	}
+
+	_, err = conn.Exec(ctx, `DELETE FROM connections WHERE id = $1 AND issuer_id = $2`, sourceID, issuerDID.String())
+	return err
+}
+
 func (c *connection) Delete(ctx context.Context, conn db.Querier, id uuid.UUID, issuerDID w3c.DID) error {
-	sqlAuthentications := `DELETE FROM user_authentications WHERE connection_id = $1`
-	_, err := conn.Exec(ctx, sqlAuthentications, id.String())
+	sqlAuthentications := `DELETE FROM user_authentications USING connections
+						   WHERE user_authentications.connection_id = connections.id AND connections.id = $1 AND connections.issuer_id = $2`
+	_, err := conn.Exec(ctx, sqlAuthentications, id.String(), issuerDID.String())
 	if err != nil {
 		return err
 	}
@@ -92,7 +162,7 @@ func (c *connection) DeleteCredentials(ctx context.Context, conn db.Querier, id
 func (c *connection) GetByIDAndIssuerID(ctx context.Context, conn db.Querier, id uuid.UUID, issuerID w3c.DID) (*domain.Connection, error) {
 	connection := dbConnection{}
 	err := conn.QueryRow(ctx,
-		`SELECT id, issuer_id,user_id,issuer_doc,user_doc,created_at,modified_at 
+		`SELECT id, issuer_id,user_id,issuer_doc,user_doc,created_at,modified_at,tags,notes 
 				FROM connections 
 				WHERE connections.id = $1 AND connections.issuer_id = $2`, id.String(), issuerID.String()).Scan(
 		&connection.ID,
@@ -102,6 +172,8 @@ func (c *connection) GetByIDAndIssuerID(ctx context.Context, conn db.Querier, id
 		&connection.UserDoc,
 		&connection.CreatedAt,
 		&connection.ModifiedAt,
+		&connection.Tags,
+		&connection.Notes,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -116,7 +188,7 @@ func (c *connection) GetByIDAndIssuerID(ctx context.Context, conn db.Querier, id
 func (c *connection) GetByUserSessionID(ctx context.Context, conn db.Querier, sessionID uuid.UUID) (*domain.Connection, error) {
 	connection := dbConnection{}
 	err := conn.QueryRow(ctx,
-		`SELECT connections.id, connections.issuer_id,connections.user_id,connections.issuer_doc,connections.user_doc,connections.created_at,connections.modified_at 
+		`SELECT connections.id, connections.issuer_id,connections.user_id,connections.issuer_doc,connections.user_doc,connections.created_at,connections.modified_at,connections.tags,connections.notes 
 				FROM connections 
 				JOIN user_authentications ON connections.id = user_authentications.connection_id
 				WHERE user_authentications.session_id = $1`, sessionID.String()).Scan(
@@ -127,6 +199,8 @@ func (c *connection) GetByUserSessionID(ctx context.Context, conn db.Querier, se
 		&connection.UserDoc,
 		&connection.CreatedAt,
 		&connection.ModifiedAt,
+		&connection.Tags,
+		&connection.Notes,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -141,7 +215,7 @@ func (c *connection) GetByUserSessionID(ctx context.Context, conn db.Querier, se
 func (c *connection) GetByUserID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, userDID w3c.DID) (*domain.Connection, error) {
 	connection := dbConnection{}
 	err := conn.QueryRow(ctx,
-		`SELECT id, issuer_id,user_id,issuer_doc,user_doc,created_at,modified_at 
+		`SELECT id, issuer_id,user_id,issuer_doc,user_doc,created_at,modified_at,tags,notes 
 				FROM connections 
 				WHERE   connections.issuer_id = $1 AND  connections.user_id = $2`, issuerDID.String(), userDID.String()).Scan(
 		&connection.ID,
@@ -151,6 +225,8 @@ func (c *connection) GetByUserID(ctx context.Context, conn db.Querier, issuerDID
 		&connection.UserDoc,
 		&connection.CreatedAt,
 		&connection.ModifiedAt,
+		&connection.Tags,
+		&connection.Notes,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -194,6 +270,8 @@ func buildGetAllWithCredentialsQueryAndFilters(issuerDID w3c.DID, filter *ports.
 		"connections.user_doc",
 		"connections.created_at",
 		"connections.modified_at",
+		"connections.tags",
+		"connections.notes",
 	}
 
 	sqlQuery := `SELECT ##QUERYFIELDS## FROM connections`
@@ -209,6 +287,18 @@ func buildGetAllWithCredentialsQueryAndFilters(issuerDID w3c.DID, filter *ports.
 		}
 	}
 
+	if len(filter.Tags) > 0 {
+		sqlArgs = append(sqlArgs, filter.Tags)
+		sqlQuery += fmt.Sprintf(" AND connections.tags @> $%d", len(sqlArgs))
+	}
+
+	if !filter.CredentialsFilter.IsEmpty() {
+		var credConds string
+		credConds, sqlArgs = buildConnectionCredentialsConditions(filter.CredentialsFilter, sqlArgs)
+		sqlQuery += fmt.Sprintf(` AND EXISTS (SELECT 1 FROM claims 
+			WHERE claims.issuer = connections.issuer_id AND claims.other_identifier = connections.user_id %s)`, credConds)
+	}
+
 	countQuery := strings.Replace(sqlQuery, "##QUERYFIELDS##", "COUNT(*)", 1)
 	sqlQuery = strings.Replace(sqlQuery, "##QUERYFIELDS##", strings.Join(fields, ","), 1)
 
@@ -220,6 +310,31 @@ func buildGetAllWithCredentialsQueryAndFilters(issuerDID w3c.DID, filter *ports.
 	return sqlQuery, countQuery, sqlArgs
 }
 
+// buildConnectionCredentialsConditions returns the sql conditions over the claims table for the given filter
+// and the args list with the new values appended.
+func buildConnectionCredentialsConditions(filter *ports.ConnectionCredentialsFilter, sqlArgs []interface{}) (string, []interface{}) {
+	conds := ""
+	if filter.SchemaType != "" {
+		sqlArgs = append(sqlArgs, fmt.Sprintf("%%%s%%", filter.SchemaType))
+		conds += fmt.Sprintf(" AND claims.schema_type LIKE $%d", len(sqlArgs))
+	}
+	if filter.Revoked != nil {
+		sqlArgs = append(sqlArgs, *filter.Revoked)
+		conds += fmt.Sprintf(" AND claims.revoked = $%d", len(sqlArgs))
+	}
+	// Sort the attribute names to produce always the same query for the same filter
+	fields := make([]string, 0, len(filter.Attributes))
+	for field := range filter.Attributes {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		sqlArgs = append(sqlArgs, field, filter.Attributes[field])
+		conds += fmt.Sprintf(" AND claims.data -> 'credentialSubject' ->> $%d = $%d", len(sqlArgs)-1, len(sqlArgs))
+	}
+	return conds, sqlArgs
+}
+
 func toConnectionsWithCredentials(rows pgx.Rows) ([]domain.Connection, error) {
 	resp := make([]domain.Connection, 0)
 	for rows.Next() {
@@ -231,7 +346,9 @@ func toConnectionsWithCredentials(rows pgx.Rows) ([]domain.Connection, error) {
 			&dbConn.IssuerDoc,
 			&dbConn.UserDoc,
 			&dbConn.dbConnection.CreatedAt,
-			&dbConn.ModifiedAt)
+			&dbConn.ModifiedAt,
+			&dbConn.Tags,
+			&dbConn.Notes)
 		if err != nil {
 			return nil, err
 		}
@@ -261,6 +378,11 @@ func toConnectionDomain(c *dbConnection) (*domain.Connection, error) {
 		UserDID:    *usrDID,
 		CreatedAt:  c.CreatedAt,
 		ModifiedAt: c.ModifiedAt,
+		Tags:       c.Tags,
+		Notes:      c.Notes,
+	}
+	if conn.Tags == nil {
+		conn.Tags = []string{}
 	}
 
 	if err := c.UserDoc.AssignTo(&conn.UserDoc); err != nil {
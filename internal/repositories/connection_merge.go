@@ -0,0 +1,172 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// ErrConnectionMergeDoesNotExist connection merge does not exist
+	ErrConnectionMergeDoesNotExist = errors.New("connection merge does not exist")
+	// ErrConnectionMergeAlreadyExists the identity has already been merged
+	ErrConnectionMergeAlreadyExists = errors.New("identity already merged")
+)
+
+const connectionMergeColumns = `id, issuer_id, connection_id, merged_connection_id, merged_user_id, method, status, reason, reissue_credentials, reissued_credentials, request, qr_code_url, failure_reason, created_at, completed_at`
+
+type dbConnectionMerge struct {
+	ID                  uuid.UUID
+	IssuerDID           string
+	ConnectionID        uuid.UUID
+	MergedConnectionID  *uuid.UUID
+	MergedUserDID       *string
+	Method              string
+	Status              string
+	Reason              *string
+	ReissueCredentials  bool
+	ReissuedCredentials []string
+	Request             pgtype.JSONB
+	QRCodeURL           *string
+	FailureReason       *string
+	CreatedAt           time.Time
+	CompletedAt         *time.Time
+}
+
+type connectionMerge struct{}
+
+// NewConnectionMerge returns a new connection merges repository
+func NewConnectionMerge() ports.ConnectionMergeRepository {
+	return &connectionMerge{}
+}
+
+// Save stores in the database the given connection merge and updates its outcome in case already exists
+func (c *connectionMerge) Save(ctx context.Context, conn db.Querier, merge *domain.ConnectionMerge) error {
+	var request []byte
+	if merge.Request != nil {
+		var err error
+		if request, err = json.Marshal(merge.Request); err != nil {
+			return err
+		}
+	}
+
+	sql := `INSERT INTO connection_merges (` + connectionMergeColumns + `)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) ON CONFLICT (id) DO
+			UPDATE SET connection_id=$3, merged_connection_id=$4, merged_user_id=$5, status=$7, reissued_credentials=$10, failure_reason=$13, completed_at=$15`
+	_, err := conn.Exec(ctx, sql, merge.ID, merge.IssuerDID.String(), merge.ConnectionID, merge.MergedConnectionID, merge.MergedUserDID,
+		string(merge.Method), string(merge.Status), merge.Reason, merge.ReissueCredentials, merge.ReissuedCredentials, request,
+		merge.QRCodeURL, merge.FailureReason, merge.CreatedAt, merge.CompletedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return ErrConnectionMergeAlreadyExists
+		}
+	}
+
+	return err
+}
+
+// GetByID returns the connection merge with the given id
+func (c *connectionMerge) GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.ConnectionMerge, error) {
+	var merge dbConnectionMerge
+	row := conn.QueryRow(ctx, `SELECT `+connectionMergeColumns+` FROM connection_merges WHERE id = $1`, id)
+	if err := scanConnectionMerge(row, &merge); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, ErrConnectionMergeDoesNotExist
+		}
+		return nil, err
+	}
+
+	return toConnectionMergeDomain(&merge)
+}
+
+// GetByConnectionID returns the merges of the given connection, newest first
+func (c *connectionMerge) GetByConnectionID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, connectionID uuid.UUID) ([]domain.ConnectionMerge, error) {
+	rows, err := conn.Query(ctx,
+		`SELECT `+connectionMergeColumns+` FROM connection_merges
+				WHERE connection_id = $1 AND issuer_id = $2
+				ORDER BY created_at DESC`, connectionID, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	merges := make([]domain.ConnectionMerge, 0)
+	for rows.Next() {
+		var merge dbConnectionMerge
+		if err := scanConnectionMerge(rows, &merge); err != nil {
+			return nil, err
+		}
+		domainMerge, err := toConnectionMergeDomain(&merge)
+		if err != nil {
+			return nil, err
+		}
+		merges = append(merges, *domainMerge)
+	}
+
+	return merges, rows.Err()
+}
+
+func scanConnectionMerge(row pgx.Row, merge *dbConnectionMerge) error {
+	return row.Scan(
+		&merge.ID,
+		&merge.IssuerDID,
+		&merge.ConnectionID,
+		&merge.MergedConnectionID,
+		&merge.MergedUserDID,
+		&merge.Method,
+		&merge.Status,
+		&merge.Reason,
+		&merge.ReissueCredentials,
+		&merge.ReissuedCredentials,
+		&merge.Request,
+		&merge.QRCodeURL,
+		&merge.FailureReason,
+		&merge.CreatedAt,
+		&merge.CompletedAt,
+	)
+}
+
+func toConnectionMergeDomain(m *dbConnectionMerge) (*domain.ConnectionMerge, error) {
+	issuerDID, err := w3c.ParseDID(m.IssuerDID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing issuer DID from connection merge: %w", err)
+	}
+
+	merge := &domain.ConnectionMerge{
+		ID:                  m.ID,
+		IssuerDID:           *issuerDID,
+		ConnectionID:        m.ConnectionID,
+		MergedConnectionID:  m.MergedConnectionID,
+		MergedUserDID:       m.MergedUserDID,
+		Method:              domain.ConnectionMergeMethod(m.Method),
+		Status:              domain.ConnectionMergeStatus(m.Status),
+		Reason:              m.Reason,
+		ReissueCredentials:  m.ReissueCredentials,
+		ReissuedCredentials: m.ReissuedCredentials,
+		QRCodeURL:           m.QRCodeURL,
+		FailureReason:       m.FailureReason,
+		CreatedAt:           m.CreatedAt,
+		CompletedAt:         m.CompletedAt,
+	}
+
+	if m.Request.Status == pgtype.Present {
+		if err := m.Request.AssignTo(&merge.Request); err != nil {
+			return nil, fmt.Errorf("parsing request from connection merge: %w", err)
+		}
+	}
+
+	return merge, nil
+}
@@ -0,0 +1,131 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestConnectionMerges(t *testing.T) {
+	ctx := context.Background()
+	connectionsRepo := NewConnection()
+	connectionMessagesRepo := NewConnectionMessage()
+	mergesRepo := NewConnectionMerge()
+
+	fixture := NewFixture(storage)
+	issuerDID := randomDID(t)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerDID.String()})
+	userDID := randomDID(t)
+	duplicatedDID := randomDID(t)
+
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  issuerDID,
+		UserDID:    userDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+	duplicatedID := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  issuerDID,
+		UserDID:    duplicatedDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+	require.NoError(t, connectionsRepo.UpdateTagsAndNotes(ctx, storage.Pgx, &domain.Connection{ID: connID, IssuerDID: issuerDID, Tags: []string{"gold"}, Notes: common.ToPointer("vip"), ModifiedAt: time.Now()}))
+	require.NoError(t, connectionsRepo.UpdateTagsAndNotes(ctx, storage.Pgx, &domain.Connection{ID: duplicatedID, IssuerDID: issuerDID, Tags: []string{"gold", "newsletter"}, Notes: common.ToPointer("new wallet"), ModifiedAt: time.Now()}))
+
+	message := domain.NewConnectionMessage(issuerDID, duplicatedID, "https://iden3-communication.io/basic/1.0/message", "", []byte(`{}`))
+	require.NoError(t, connectionMessagesRepo.Save(ctx, storage.Pgx, message))
+
+	t.Run("should merge the duplicated connection", func(t *testing.T) {
+		require.NoError(t, connectionsRepo.Merge(ctx, storage.Pgx, issuerDID, duplicatedID, connID))
+
+		_, err := connectionsRepo.GetByIDAndIssuerID(ctx, storage.Pgx, duplicatedID, issuerDID)
+		assert.ErrorIs(t, err, ErrConnectionDoesNotExist)
+
+		conn, err := connectionsRepo.GetByIDAndIssuerID(ctx, storage.Pgx, connID, issuerDID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"gold", "newsletter"}, conn.Tags)
+		require.NotNil(t, conn.Notes)
+		assert.Equal(t, "vip\nnew wallet", *conn.Notes)
+
+		messages, err := connectionMessagesRepo.GetByConnectionID(ctx, storage.Pgx, issuerDID, connID)
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		assert.Equal(t, message.ID, messages[0].ID)
+	})
+
+	t.Run("should fail for a non existing connection", func(t *testing.T) {
+		assert.ErrorIs(t, connectionsRepo.Merge(ctx, storage.Pgx, issuerDID, uuid.New(), connID), ErrConnectionDoesNotExist)
+	})
+
+	t.Run("should save the merges of the connection", func(t *testing.T) {
+		admin := domain.NewConnectionMerge(issuerDID, connID, domain.ConnectionMergeMethodAdmin, common.ToPointer("wallet reinstalled"), true)
+		admin.Completed(&duplicatedID, duplicatedDID.String())
+		admin.ReissuedCredentials = []string{uuid.NewString()}
+		require.NoError(t, mergesRepo.Save(ctx, storage.Pgx, admin))
+
+		proof := domain.NewConnectionMerge(issuerDID, connID, domain.ConnectionMergeMethodProof, nil, false)
+		proof.QRCodeURL = common.ToPointer("iden3comm://?request_uri=https://issuer.com/qr")
+		require.NoError(t, mergesRepo.Save(ctx, storage.Pgx, proof))
+
+		merge, err := mergesRepo.GetByID(ctx, storage.Pgx, admin.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ConnectionMergeStatusCompleted, merge.Status)
+		assert.Equal(t, duplicatedID, *merge.MergedConnectionID)
+		assert.Equal(t, duplicatedDID.String(), *merge.MergedUserDID)
+		assert.Equal(t, admin.ReissuedCredentials, merge.ReissuedCredentials)
+		assert.NotNil(t, merge.CompletedAt)
+
+		proof.Failed("invalid proof")
+		require.NoError(t, mergesRepo.Save(ctx, storage.Pgx, proof))
+
+		merges, err := mergesRepo.GetByConnectionID(ctx, storage.Pgx, issuerDID, connID)
+		require.NoError(t, err)
+		require.Len(t, merges, 2)
+		assert.Equal(t, proof.ID, merges[0].ID)
+		assert.Equal(t, domain.ConnectionMergeStatusFailed, merges[0].Status)
+		assert.Equal(t, "invalid proof", *merges[0].FailureReason)
+		assert.Equal(t, admin.ID, merges[1].ID)
+	})
+
+	t.Run("should not merge the same identity twice", func(t *testing.T) {
+		again := domain.NewConnectionMerge(issuerDID, connID, domain.ConnectionMergeMethodAdmin, nil, false)
+		again.Completed(nil, duplicatedDID.String())
+		assert.ErrorIs(t, mergesRepo.Save(ctx, storage.Pgx, again), ErrConnectionMergeAlreadyExists)
+	})
+
+	t.Run("should replace the identity of the connection", func(t *testing.T) {
+		newDID := randomDID(t)
+		conn := &domain.Connection{ID: connID, IssuerDID: issuerDID, UserDID: newDID, UserDoc: []byte(`{"id":"` + newDID.String() + `"}`), ModifiedAt: time.Now()}
+		require.NoError(t, connectionsRepo.UpdateUserDID(ctx, storage.Pgx, conn))
+
+		got, err := connectionsRepo.GetByUserID(ctx, storage.Pgx, issuerDID, newDID)
+		require.NoError(t, err)
+		assert.Equal(t, connID, got.ID)
+		assert.JSONEq(t, string(conn.UserDoc), string(got.UserDoc))
+
+		assert.ErrorIs(t, connectionsRepo.UpdateUserDID(ctx, storage.Pgx, &domain.Connection{ID: uuid.New(), IssuerDID: issuerDID, UserDID: newDID}), ErrConnectionDoesNotExist)
+	})
+
+	t.Run("should keep the merges of a deleted connection", func(t *testing.T) {
+		require.NoError(t, connectionsRepo.Delete(ctx, storage.Pgx, connID, issuerDID))
+
+		merges, err := mergesRepo.GetByConnectionID(ctx, storage.Pgx, issuerDID, connID)
+		require.NoError(t, err)
+		assert.Len(t, merges, 2)
+	})
+
+	t.Run("should not find a non existing merge", func(t *testing.T) {
+		_, err := mergesRepo.GetByID(ctx, storage.Pgx, uuid.New())
+		assert.ErrorIs(t, err, ErrConnectionMergeDoesNotExist)
+	})
+}
@@ -0,0 +1,157 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrConnectionMessageDoesNotExist connection message does not exist
+var ErrConnectionMessageDoesNotExist = errors.New("connection message does not exist")
+
+type dbConnectionMessage struct {
+	ID            uuid.UUID
+	IssuerDID     string
+	ConnectionID  uuid.UUID
+	Type          string
+	ThreadID      string
+	Body          pgtype.JSONB
+	Status        string
+	FailureReason *string
+	CreatedAt     time.Time
+	DeliveredAt   *time.Time
+}
+
+type connectionMessage struct{}
+
+// NewConnectionMessage returns a new connection messages repository
+func NewConnectionMessage() ports.ConnectionMessageRepository {
+	return &connectionMessage{}
+}
+
+// Save stores in the database the given connection message
+func (c *connectionMessage) Save(ctx context.Context, conn db.Querier, message *domain.ConnectionMessage) error {
+	sql := `INSERT INTO connection_messages (id, issuer_id, connection_id, type, thread_id, body, status, failure_reason, created_at, delivered_at)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	_, err := conn.Exec(ctx, sql, message.ID, message.IssuerDID.String(), message.ConnectionID, message.Type, message.ThreadID,
+		message.Body, string(message.Status), message.FailureReason, message.CreatedAt, message.DeliveredAt)
+
+	return err
+}
+
+// UpdateStatus stores the delivery status of the given connection message
+func (c *connectionMessage) UpdateStatus(ctx context.Context, conn db.Querier, message *domain.ConnectionMessage) error {
+	sql := `UPDATE connection_messages SET status = $3, failure_reason = $4, delivered_at = $5 WHERE id = $1 AND issuer_id = $2`
+	cmd, err := conn.Exec(ctx, sql, message.ID, message.IssuerDID.String(), string(message.Status), message.FailureReason, message.DeliveredAt)
+	if err != nil {
+		return err
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return ErrConnectionMessageDoesNotExist
+	}
+
+	return nil
+}
+
+// GetByID returns the connection message with the given id
+func (c *connectionMessage) GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.ConnectionMessage, error) {
+	var message dbConnectionMessage
+	err := conn.QueryRow(ctx,
+		`SELECT id, issuer_id, connection_id, type, thread_id, body, status, failure_reason, created_at, delivered_at
+				FROM connection_messages
+				WHERE id = $1 AND issuer_id = $2`, id, issuerDID.String()).Scan(
+		&message.ID,
+		&message.IssuerDID,
+		&message.ConnectionID,
+		&message.Type,
+		&message.ThreadID,
+		&message.Body,
+		&message.Status,
+		&message.FailureReason,
+		&message.CreatedAt,
+		&message.DeliveredAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, ErrConnectionMessageDoesNotExist
+		}
+		return nil, err
+	}
+
+	return toConnectionMessageDomain(&message)
+}
+
+// GetByConnectionID returns the messages sent to the given connection, newest first
+func (c *connectionMessage) GetByConnectionID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, connectionID uuid.UUID) ([]domain.ConnectionMessage, error) {
+	rows, err := conn.Query(ctx,
+		`SELECT id, issuer_id, connection_id, type, thread_id, body, status, failure_reason, created_at, delivered_at
+				FROM connection_messages
+				WHERE connection_id = $1 AND issuer_id = $2
+				ORDER BY created_at DESC`, connectionID, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]domain.ConnectionMessage, 0)
+	for rows.Next() {
+		var message dbConnectionMessage
+		if err := rows.Scan(
+			&message.ID,
+			&message.IssuerDID,
+			&message.ConnectionID,
+			&message.Type,
+			&message.ThreadID,
+			&message.Body,
+			&message.Status,
+			&message.FailureReason,
+			&message.CreatedAt,
+			&message.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		domainMessage, err := toConnectionMessageDomain(&message)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, *domainMessage)
+	}
+
+	return messages, rows.Err()
+}
+
+func toConnectionMessageDomain(m *dbConnectionMessage) (*domain.ConnectionMessage, error) {
+	issuerDID, err := w3c.ParseDID(m.IssuerDID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing issuer DID from connection message: %w", err)
+	}
+
+	message := &domain.ConnectionMessage{
+		ID:            m.ID,
+		IssuerDID:     *issuerDID,
+		ConnectionID:  m.ConnectionID,
+		Type:          m.Type,
+		ThreadID:      m.ThreadID,
+		Status:        domain.ConnectionMessageStatus(m.Status),
+		FailureReason: m.FailureReason,
+		CreatedAt:     m.CreatedAt,
+		DeliveredAt:   m.DeliveredAt,
+	}
+
+	if err := m.Body.AssignTo(&message.Body); err != nil {
+		return nil, fmt.Errorf("parsing body from connection message: %w", err)
+	}
+
+	return message, nil
+}
@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestConnectionMessages(t *testing.T) {
+	ctx := context.Background()
+	messagesRepo := NewConnectionMessage()
+
+	fixture := NewFixture(storage)
+	issuerDID := randomDID(t)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerDID.String()})
+	userDID := randomDID(t)
+
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  issuerDID,
+		UserDID:    userDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	first := domain.NewConnectionMessage(issuerDID, connID, "https://iden3-communication.io/proofs/1.0/request", "", json.RawMessage(`{"reason":"verify"}`))
+	first.CreatedAt = time.Now().Add(-time.Minute)
+	second := domain.NewConnectionMessage(issuerDID, connID, "https://iden3-communication.io/proofs/1.0/request", first.ThreadID, json.RawMessage(`{}`))
+
+	t.Run("should save the messages as pending", func(t *testing.T) {
+		require.NoError(t, messagesRepo.Save(ctx, storage.Pgx, first))
+		require.NoError(t, messagesRepo.Save(ctx, storage.Pgx, second))
+
+		message, err := messagesRepo.GetByID(ctx, storage.Pgx, issuerDID, first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, connID, message.ConnectionID)
+		assert.Equal(t, first.ID.String(), message.ThreadID)
+		assert.Equal(t, domain.ConnectionMessageStatusPending, message.Status)
+		assert.JSONEq(t, `{"reason":"verify"}`, string(message.Body))
+		assert.Nil(t, message.DeliveredAt)
+	})
+
+	t.Run("should update the delivery status", func(t *testing.T) {
+		first.Delivered()
+		require.NoError(t, messagesRepo.UpdateStatus(ctx, storage.Pgx, first))
+		second.Failed("no devices")
+		require.NoError(t, messagesRepo.UpdateStatus(ctx, storage.Pgx, second))
+
+		messages, err := messagesRepo.GetByConnectionID(ctx, storage.Pgx, issuerDID, connID)
+		require.NoError(t, err)
+		require.Len(t, messages, 2)
+		assert.Equal(t, second.ID, messages[0].ID)
+		assert.Equal(t, domain.ConnectionMessageStatusFailed, messages[0].Status)
+		require.NotNil(t, messages[0].FailureReason)
+		assert.Equal(t, "no devices", *messages[0].FailureReason)
+		assert.Equal(t, first.ID, messages[1].ID)
+		assert.Equal(t, domain.ConnectionMessageStatusDelivered, messages[1].Status)
+		assert.NotNil(t, messages[1].DeliveredAt)
+	})
+
+	t.Run("should not return messages of another issuer", func(t *testing.T) {
+		_, err := messagesRepo.GetByID(ctx, storage.Pgx, randomDID(t), first.ID)
+		assert.ErrorIs(t, err, ErrConnectionMessageDoesNotExist)
+		assert.ErrorIs(t, messagesRepo.UpdateStatus(ctx, storage.Pgx, &domain.ConnectionMessage{ID: uuid.New(), IssuerDID: issuerDID}), ErrConnectionMessageDoesNotExist)
+	})
+}
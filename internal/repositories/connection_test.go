@@ -406,3 +406,152 @@ func TestGetByUserID(t *testing.T) {
 		assert.NotNil(t, conn)
 	})
 }
+
+func TestUpdateTagsAndNotes(t *testing.T) {
+	ctx := context.Background()
+	connectionsRepo := NewConnection()
+
+	fixture := NewFixture(storage)
+	issuerDID := randomDID(t)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerDID.String()})
+	userDID := randomDID(t)
+
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  issuerDID,
+		UserDID:    userDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	t.Run("should return an empty list of tags for a new connection", func(t *testing.T) {
+		conn, err := connectionsRepo.GetByIDAndIssuerID(ctx, storage.Pgx, connID, issuerDID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{}, conn.Tags)
+		assert.Nil(t, conn.Notes)
+	})
+
+	t.Run("should update tags and notes", func(t *testing.T) {
+		conn, err := connectionsRepo.GetByIDAndIssuerID(ctx, storage.Pgx, connID, issuerDID)
+		require.NoError(t, err)
+		conn.Tags = []string{"gold", "newsletter"}
+		conn.Notes = common.ToPointer("some notes")
+		require.NoError(t, connectionsRepo.UpdateTagsAndNotes(ctx, storage.Pgx, conn))
+
+		conn, err = connectionsRepo.GetByIDAndIssuerID(ctx, storage.Pgx, connID, issuerDID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gold", "newsletter"}, conn.Tags)
+		require.NotNil(t, conn.Notes)
+		assert.Equal(t, "some notes", *conn.Notes)
+	})
+
+	t.Run("should keep tags and notes when the connection is saved again", func(t *testing.T) {
+		_, err := connectionsRepo.Save(ctx, storage.Pgx, &domain.Connection{
+			ID:         uuid.New(),
+			IssuerDID:  issuerDID,
+			UserDID:    userDID,
+			CreatedAt:  time.Now(),
+			ModifiedAt: time.Now(),
+		})
+		require.NoError(t, err)
+		conn, err := connectionsRepo.GetByIDAndIssuerID(ctx, storage.Pgx, connID, issuerDID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gold", "newsletter"}, conn.Tags)
+	})
+
+	t.Run("should return an error for a non existing connection", func(t *testing.T) {
+		err := connectionsRepo.UpdateTagsAndNotes(ctx, storage.Pgx, &domain.Connection{ID: uuid.New(), IssuerDID: issuerDID})
+		assert.ErrorIs(t, err, ErrConnectionDoesNotExist)
+	})
+}
+
+func TestGetAllWithCredentialsByIssuerIDFilteringByTagsAndCredentials(t *testing.T) {
+	ctx := context.Background()
+	connectionsRepo := NewConnection()
+
+	fixture := NewFixture(storage)
+	issuerDID := randomDID(t)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerDID.String()})
+
+	newConn := func(tags []string) (uuid.UUID, w3c.DID) {
+		userDID := randomDID(t)
+		conn := &domain.Connection{
+			ID:         uuid.New(),
+			IssuerDID:  issuerDID,
+			UserDID:    userDID,
+			CreatedAt:  time.Now(),
+			ModifiedAt: time.Now(),
+			Tags:       tags,
+		}
+		fixture.CreateConnection(t, conn)
+		require.NoError(t, connectionsRepo.UpdateTagsAndNotes(ctx, storage.Pgx, conn))
+		return conn.ID, userDID
+	}
+	newCredential := func(userDID w3c.DID, tier string, revoked bool) {
+		claim := fixture.NewClaim(t, issuerDID.String())
+		claim.OtherIdentifier = userDID.String()
+		claim.HIndex = uuid.NewString()
+		claim.SchemaType = "MembershipCredential"
+		claim.Revoked = revoked
+		require.NoError(t, claim.Data.Set(map[string]any{"credentialSubject": map[string]any{"id": userDID.String(), "tier": tier}}))
+		fixture.CreateClaim(t, claim)
+	}
+
+	goldConn, goldUser := newConn([]string{"gold", "newsletter"})
+	newCredential(goldUser, "gold", false)
+	revokedGoldConn, revokedGoldUser := newConn([]string{"gold"})
+	newCredential(revokedGoldUser, "gold", true)
+	silverConn, silverUser := newConn(nil)
+	newCredential(silverUser, "silver", false)
+
+	for _, tc := range []struct {
+		name     string
+		filter   *ports.NewGetAllConnectionsRequest
+		expected []uuid.UUID
+	}{
+		{
+			name:     "by one tag",
+			filter:   &ports.NewGetAllConnectionsRequest{Tags: []string{"gold"}},
+			expected: []uuid.UUID{revokedGoldConn, goldConn},
+		},
+		{
+			name:     "by several tags",
+			filter:   &ports.NewGetAllConnectionsRequest{Tags: []string{"gold", "newsletter"}},
+			expected: []uuid.UUID{goldConn},
+		},
+		{
+			name: "by credential schema type",
+			filter: &ports.NewGetAllConnectionsRequest{CredentialsFilter: &ports.ConnectionCredentialsFilter{
+				SchemaType: "Membership",
+			}},
+			expected: []uuid.UUID{silverConn, revokedGoldConn, goldConn},
+		},
+		{
+			name: "by non revoked credential attributes",
+			filter: &ports.NewGetAllConnectionsRequest{CredentialsFilter: &ports.ConnectionCredentialsFilter{
+				SchemaType: "MembershipCredential",
+				Attributes: map[string]string{"tier": "gold"},
+				Revoked:    common.ToPointer(false),
+			}},
+			expected: []uuid.UUID{goldConn},
+		},
+		{
+			name: "by tags and credential attributes",
+			filter: &ports.NewGetAllConnectionsRequest{Tags: []string{"gold"}, CredentialsFilter: &ports.ConnectionCredentialsFilter{
+				Attributes: map[string]string{"tier": "silver"},
+			}},
+			expected: []uuid.UUID{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			conns, total, err := connectionsRepo.GetAllWithCredentialsByIssuerID(ctx, storage.Pgx, issuerDID, tc.filter)
+			require.NoError(t, err)
+			require.Equal(t, uint(len(tc.expected)), total)
+			ids := make([]uuid.UUID, len(conns))
+			for i := range conns {
+				ids[i] = conns[i].ID
+			}
+			assert.Equal(t, tc.expected, ids)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
@@ -40,10 +41,14 @@ func (d DisplayMethod) Save(ctx context.Context, displayMethod domain.DisplayMet
 	var id uuid.UUID
 	sql := `INSERT INTO display_methods (id, name, url, issuer_did, type)
 			VALUES($1, $2, $3, $4, $5) ON CONFLICT (id) DO
-			UPDATE SET name=$2, url=$3, issuer_did=$4, type=$5, updated_at=NOW()
+			UPDATE SET name=$2, url=$3, type=$5, updated_at=NOW()
+			WHERE display_methods.issuer_did = EXCLUDED.issuer_did
 			RETURNING id`
 	err := d.conn.Pgx.QueryRow(ctx, sql, displayMethod.ID, displayMethod.Name, displayMethod.URL, displayMethod.IssuerCoreDID().String(), displayMethod.Type).Scan(&id)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, DisplayMethodNotFoundErr
+		}
 		if strings.Contains(err.Error(), "violates unique constraint") {
 			return nil, DisplayMethodDuplicateNameErr
 		}
@@ -37,6 +37,20 @@ func TestSaveDisplayMethod(t *testing.T) {
 		require.NotNil(t, id)
 	})
 
+	t.Run("should not update the display method of another identity", func(t *testing.T) {
+		otherDID, err := w3c.ParseDID("did:iden3:privado:main:2SdUfDwHK3koyaH5WzhvPhpcjFfdem2xD625aymTNc")
+		require.NoError(t, err)
+		_, err = storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", otherDID.String(), "BJJ")
+		require.NoError(t, err)
+		other := domain.NewDisplayMethod(displayMethod.ID, *otherDID, "other", "http://other.com", common.ToPointer("Iden3BasicDisplayMethodV1"))
+		_, err = displayMethodRepository.Save(ctx, other)
+		require.ErrorIs(t, err, DisplayMethodNotFoundErr)
+
+		got, err := displayMethodRepository.GetByID(ctx, *issuerDID, displayMethod.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "test", got.Name)
+	})
+
 	t.Run("Save display method with same name", func(t *testing.T) {
 		displayMethod.ID = uuid.New()
 		id, err := displayMethodRepository.Save(ctx, displayMethod)
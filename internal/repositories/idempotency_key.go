@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrIdempotencyKeyNotFound idempotency key not found
+var ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+
+const idempotencyKeyFields = `identifier, operation, key, request_hash, status_code, response, created_at, expires_at`
+
+type idempotencyKey struct{}
+
+// NewIdempotencyKey returns a new idempotency keys repository
+func NewIdempotencyKey() ports.IdempotencyKeyRepository {
+	return &idempotencyKey{}
+}
+
+// Create stores the key. An expired key with the same identifier, operation and key is replaced.
+func (ik *idempotencyKey) Create(ctx context.Context, conn db.Querier, key *domain.IdempotencyKey) (bool, error) {
+	res, err := conn.Exec(ctx,
+		`INSERT INTO idempotency_keys (`+idempotencyKeyFields+`) VALUES($1, $2, $3, $4, $5, $6, $7, $8)
+				ON CONFLICT (identifier, operation, key) DO
+				UPDATE SET request_hash=$4, status_code=$5, response=$6, created_at=$7, expires_at=$8
+				WHERE idempotency_keys.expires_at <= $7`,
+		key.Identifier, key.Operation, key.Key, key.RequestHash, key.StatusCode, key.Response, key.CreatedAt, key.ExpiresAt)
+	if err != nil {
+		return false, err
+	}
+	return res.RowsAffected() == 1, nil
+}
+
+// Get returns the key
+func (ik *idempotencyKey) Get(ctx context.Context, conn db.Querier, identifier, operation, key string) (*domain.IdempotencyKey, error) {
+	var k domain.IdempotencyKey
+	err := conn.QueryRow(ctx,
+		`SELECT `+idempotencyKeyFields+` FROM idempotency_keys WHERE identifier = $1 AND operation = $2 AND key = $3`,
+		identifier, operation, key).Scan(
+		&k.Identifier, &k.Operation, &k.Key, &k.RequestHash, &k.StatusCode, &k.Response, &k.CreatedAt, &k.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrIdempotencyKeyNotFound
+		}
+		return nil, err
+	}
+	return &k, nil
+}
+
+// Complete stores the response of the key and its new expiration. The key is not updated when its lock expired and
+// another request took it.
+func (ik *idempotencyKey) Complete(ctx context.Context, conn db.Querier, key *domain.IdempotencyKey) error {
+	res, err := conn.Exec(ctx,
+		`UPDATE idempotency_keys SET status_code = $4, response = $5, expires_at = $6
+				WHERE identifier = $1 AND operation = $2 AND key = $3 AND created_at = $7 AND status_code IS NULL`,
+		key.Identifier, key.Operation, key.Key, key.StatusCode, key.Response, key.ExpiresAt, key.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrIdempotencyKeyNotFound
+	}
+	return nil
+}
+
+// Delete removes the locked key. The key is not removed when its lock expired and another request took it.
+func (ik *idempotencyKey) Delete(ctx context.Context, conn db.Querier, key *domain.IdempotencyKey) error {
+	_, err := conn.Exec(ctx,
+		`DELETE FROM idempotency_keys WHERE identifier = $1 AND operation = $2 AND key = $3 AND created_at = $4 AND status_code IS NULL`,
+		key.Identifier, key.Operation, key.Key, key.CreatedAt)
+	return err
+}
+
+// DeleteExpired removes the keys expired at now
+func (ik *idempotencyKey) DeleteExpired(ctx context.Context, conn db.Querier, now time.Time) (int64, error) {
+	res, err := conn.Exec(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected(), nil
+}
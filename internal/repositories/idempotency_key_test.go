@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	ctx := context.Background()
+	fixture := NewFixture(storage)
+	repo := NewIdempotencyKey()
+
+	did := randomDID(t)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+
+	t.Run("should lock the key once and store its response", func(t *testing.T) {
+		key := domain.NewIdempotencyKey(did.String(), "CreateCredential", "key-1", []byte(`{"a":1}`), time.Minute)
+		created, err := repo.Create(ctx, storage.Pgx, key)
+		require.NoError(t, err)
+		assert.True(t, created)
+
+		retry := domain.NewIdempotencyKey(did.String(), "CreateCredential", "key-1", []byte(`{"a":1}`), time.Minute)
+		created, err = repo.Create(ctx, storage.Pgx, retry)
+		require.NoError(t, err)
+		assert.False(t, created)
+
+		key.Complete(http.StatusCreated, []byte(`{"id":"1"}`), time.Hour)
+		require.NoError(t, repo.Complete(ctx, storage.Pgx, key))
+
+		got, err := repo.Get(ctx, storage.Pgx, did.String(), "CreateCredential", "key-1")
+		require.NoError(t, err)
+		assert.True(t, got.Completed())
+		assert.Equal(t, http.StatusCreated, *got.StatusCode)
+		assert.Equal(t, []byte(`{"id":"1"}`), got.Response)
+		assert.Equal(t, key.RequestHash, got.RequestHash)
+
+		assert.NoError(t, repo.Delete(ctx, storage.Pgx, key))
+		_, err = repo.Get(ctx, storage.Pgx, did.String(), "CreateCredential", "key-1")
+		assert.NoError(t, err, "a completed key is not released")
+	})
+
+	t.Run("should scope the keys to the operation", func(t *testing.T) {
+		key := domain.NewIdempotencyKey(did.String(), "CreateLink", "key-1", []byte(`{"a":1}`), time.Minute)
+		created, err := repo.Create(ctx, storage.Pgx, key)
+		require.NoError(t, err)
+		assert.True(t, created)
+	})
+
+	t.Run("should replace an expired lock and ignore the request that lost it", func(t *testing.T) {
+		key := domain.NewIdempotencyKey(did.String(), "PublishIdentityState", "key-2", []byte(`{}`), -time.Second)
+		created, err := repo.Create(ctx, storage.Pgx, key)
+		require.NoError(t, err)
+		require.True(t, created)
+
+		retry := domain.NewIdempotencyKey(did.String(), "PublishIdentityState", "key-2", []byte(`{}`), time.Minute)
+		created, err = repo.Create(ctx, storage.Pgx, retry)
+		require.NoError(t, err)
+		require.True(t, created)
+
+		key.Complete(http.StatusAccepted, []byte(`{}`), time.Hour)
+		assert.ErrorIs(t, repo.Complete(ctx, storage.Pgx, key), ErrIdempotencyKeyNotFound)
+		require.NoError(t, repo.Delete(ctx, storage.Pgx, key))
+
+		got, err := repo.Get(ctx, storage.Pgx, did.String(), "PublishIdentityState", "key-2")
+		require.NoError(t, err)
+		assert.False(t, got.Completed())
+		assert.Equal(t, retry.CreatedAt, got.CreatedAt.UTC())
+
+		require.NoError(t, repo.Delete(ctx, storage.Pgx, retry))
+		_, err = repo.Get(ctx, storage.Pgx, did.String(), "PublishIdentityState", "key-2")
+		assert.ErrorIs(t, err, ErrIdempotencyKeyNotFound)
+	})
+
+	t.Run("should delete the expired keys", func(t *testing.T) {
+		key := domain.NewIdempotencyKey(did.String(), "CreatePaymentRequest", "key-3", []byte(`{}`), time.Minute)
+		_, err := repo.Create(ctx, storage.Pgx, key)
+		require.NoError(t, err)
+
+		deleted, err := repo.DeleteExpired(ctx, storage.Pgx, time.Now().Add(2*time.Hour))
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, deleted, int64(1))
+		_, err = repo.Get(ctx, storage.Pgx, did.String(), "CreatePaymentRequest", "key-3")
+		assert.ErrorIs(t, err, ErrIdempotencyKeyNotFound)
+	})
+}
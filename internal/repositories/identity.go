@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/jackc/pgconn"
 
@@ -29,7 +30,7 @@ func NewIdentity() ports.IdentityRepository {
 
 // Save - Create new identity
 func (i *identity) Save(ctx context.Context, conn db.Querier, identity *domain.Identity) error {
-	_, err := conn.Exec(ctx, `INSERT INTO identities (identifier, address, keyType, display_name) VALUES ($1, $2, $3, $4)`, identity.Identifier, identity.Address, identity.KeyType, identity.DisplayName)
+	_, err := conn.Exec(ctx, `INSERT INTO identities (identifier, address, keyType, display_name, tenant_id) VALUES ($1, $2, $3, $4, $5)`, identity.Identifier, identity.Address, identity.KeyType, identity.DisplayName, identity.TenantID)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
@@ -55,6 +56,7 @@ func (i *identity) GetByID(ctx context.Context, conn db.Querier, identifier w3c.
 						identities.keyType,
 						identities.address,
 						identities.display_name,
+						identities.tenant_id,
        					state_id,
    						state,           
     					root_of_roots,
@@ -82,6 +84,7 @@ func (i *identity) GetByID(ctx context.Context, conn db.Querier, identifier w3c.
 		&identity.KeyType,
 		&identity.Address,
 		&identity.DisplayName,
+		&identity.TenantID,
 		&identity.State.StateID,
 		&identity.State.State,
 		&identity.State.RootOfRoots,
@@ -104,8 +107,9 @@ func (i *identity) GetByID(ctx context.Context, conn db.Querier, identifier w3c.
 	return &identity, err
 }
 
-func (i *identity) Get(ctx context.Context, conn db.Querier) (identities []domain.IdentityDisplayName, err error) {
-	rows, err := conn.Query(ctx, `SELECT identifier, display_name FROM identities`)
+// Get - returns all the identities, or the identities of the tenant if tenantID is not nil
+func (i *identity) Get(ctx context.Context, conn db.Querier, tenantID *uuid.UUID) (identities []domain.IdentityDisplayName, err error) {
+	rows, err := conn.Query(ctx, `SELECT identifier, display_name FROM identities WHERE $1::uuid IS NULL OR tenant_id = $1`, tenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -28,7 +28,7 @@ func TestGetIdentities(t *testing.T) {
 
 	identityRepo := NewIdentity()
 	t.Run("should get identities", func(t *testing.T) {
-		identities, err := identityRepo.Get(context.Background(), storage.Pgx)
+		identities, err := identityRepo.Get(context.Background(), storage.Pgx, nil)
 		assert.NoError(t, err)
 		assert.True(t, len(identities) >= 2)
 	})
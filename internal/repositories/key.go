@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
@@ -37,10 +38,10 @@ func (k *key) Save(ctx context.Context, conn db.Querier, key *domain.Key) (uuid.
 	if conn == nil {
 		conn = k.conn.Pgx
 	}
-	sql := `INSERT INTO keys (id, issuer_did, public_key, name)
-			VALUES($1, $2, $3, $4) ON CONFLICT (id) DO
-			UPDATE SET name=$4`
-	_, err := conn.Exec(ctx, sql, key.ID, key.IssuerCoreDID().String(), key.PublicKey, key.Name)
+	sql := `INSERT INTO keys (id, issuer_did, public_key, name, published, expires_at, expiry_warned_at, expired_at)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (id) DO
+			UPDATE SET name=$4, published=$5, expires_at=$6, expiry_warned_at=$7, expired_at=$8`
+	_, err := conn.Exec(ctx, sql, key.ID, key.IssuerCoreDID().String(), key.PublicKey, key.Name, key.Published, key.ExpiresAt, key.ExpiryWarnedAt, key.ExpiredAt)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
@@ -53,12 +54,12 @@ func (k *key) Save(ctx context.Context, conn db.Querier, key *domain.Key) (uuid.
 
 // GetByPublicKey returns a key by its public key
 func (k *key) GetByPublicKey(ctx context.Context, issuerDID w3c.DID, publicKey string) (*domain.Key, error) {
-	sql := `SELECT id, issuer_did, public_key, name 
+	sql := `SELECT id, issuer_did, public_key, name, published, expires_at, expiry_warned_at, expired_at
 			FROM keys WHERE  issuer_did=$1 and public_key=$2`
 	row := k.conn.Pgx.QueryRow(ctx, sql, issuerDID.String(), publicKey)
 
 	key := domain.Key{}
-	err := row.Scan(&key.ID, &key.IssuerDID, &key.PublicKey, &key.Name)
+	err := row.Scan(&key.ID, &key.IssuerDID, &key.PublicKey, &key.Name, &key.Published, &key.ExpiresAt, &key.ExpiryWarnedAt, &key.ExpiredAt)
 	if err != nil {
 		log.Error(ctx, "error getting key by public key", "err", err)
 		if strings.Contains(err.Error(), "no rows in result set") {
@@ -78,12 +79,12 @@ func (k *key) Delete(ctx context.Context, issuerDID w3c.DID, publicKey string) e
 
 // GetByName returns a key by its name
 func (k *key) GetByName(ctx context.Context, issuerDID w3c.DID, name string) (*domain.Key, error) {
-	sql := `SELECT id, issuer_did, public_key, name 
+	sql := `SELECT id, issuer_did, public_key, name, published, expires_at, expiry_warned_at, expired_at
 			FROM keys WHERE  issuer_did=$1 and name=$2`
 	row := k.conn.Pgx.QueryRow(ctx, sql, issuerDID.String(), name)
 
 	key := domain.Key{}
-	err := row.Scan(&key.ID, &key.IssuerDID, &key.PublicKey, &key.Name)
+	err := row.Scan(&key.ID, &key.IssuerDID, &key.PublicKey, &key.Name, &key.Published, &key.ExpiresAt, &key.ExpiryWarnedAt, &key.ExpiredAt)
 	if err != nil {
 		log.Error(ctx, "error getting key by name", "err", err)
 		if strings.Contains(err.Error(), "no rows in result set") {
@@ -93,3 +94,26 @@ func (k *key) GetByName(ctx context.Context, issuerDID w3c.DID, name string) (*d
 	}
 	return &key, nil
 }
+
+// GetExpiring returns the keys of all the issuers that expire before the given time and whose expiration
+// has not been processed yet
+func (k *key) GetExpiring(ctx context.Context, before time.Time) ([]*domain.Key, error) {
+	sql := `SELECT id, issuer_did, public_key, name, published, expires_at, expiry_warned_at, expired_at
+			FROM keys WHERE expires_at <= $1 AND expired_at IS NULL
+			ORDER BY expires_at`
+	rows, err := k.conn.Pgx.Query(ctx, sql, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]*domain.Key, 0)
+	for rows.Next() {
+		key := domain.Key{}
+		if err := rows.Scan(&key.ID, &key.IssuerDID, &key.PublicKey, &key.Name, &key.Published, &key.ExpiresAt, &key.ExpiryWarnedAt, &key.ExpiredAt); err != nil {
+			return nil, err
+		}
+		keys = append(keys, &key)
+	}
+	return keys, rows.Err()
+}
@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+// ErrKeyPolicyNotFound key policy not found
+var ErrKeyPolicyNotFound = errors.New("key policy not found")
+
+type keyPolicy struct{}
+
+// NewKeyPolicy returns a new key policies repository
+func NewKeyPolicy() ports.KeyPolicyRepository {
+	return &keyPolicy{}
+}
+
+// Save stores the policy of the key, replacing the previous one
+func (k *keyPolicy) Save(ctx context.Context, conn db.Querier, policy *domain.KeyPolicy) error {
+	purposes := make([]string, 0, len(policy.AllowedPurposes))
+	for _, purpose := range policy.AllowedPurposes {
+		purposes = append(purposes, string(purpose))
+	}
+	_, err := conn.Exec(ctx,
+		`INSERT INTO key_policies (key_id, issuer_id, allowed_purposes, rate_limit, rate_limit_window, created_at, updated_at)
+				VALUES($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (key_id) DO
+				UPDATE SET allowed_purposes=$3, rate_limit=$4, rate_limit_window=$5, updated_at=$7`,
+		policy.KeyID, policy.IssuerDID.String(), purposes, policy.RateLimit, int64(policy.RateLimitWindow.Seconds()), policy.CreatedAt, policy.UpdatedAt)
+	return err
+}
+
+// GetByKeyID returns the policy of the given key
+func (k *keyPolicy) GetByKeyID(ctx context.Context, conn db.Querier, keyID string) (*domain.KeyPolicy, error) {
+	var policy domain.KeyPolicy
+	var issuerID string
+	var purposes []string
+	var window int64
+	err := conn.QueryRow(ctx,
+		`SELECT key_id, issuer_id, allowed_purposes, rate_limit, rate_limit_window, created_at, updated_at
+				FROM key_policies WHERE key_id = $1`, keyID).
+		Scan(&policy.KeyID, &issuerID, &purposes, &policy.RateLimit, &window, &policy.CreatedAt, &policy.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrKeyPolicyNotFound
+		}
+		return nil, err
+	}
+
+	did, err := w3c.ParseDID(issuerID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing issuer DID from key policy: %w", err)
+	}
+	policy.IssuerDID = *did
+	policy.RateLimitWindow = time.Duration(window) * time.Second
+	policy.AllowedPurposes = make([]kms.SignPurpose, 0, len(purposes))
+	for _, purpose := range purposes {
+		policy.AllowedPurposes = append(policy.AllowedPurposes, kms.SignPurpose(purpose))
+	}
+	return &policy, nil
+}
+
+// Delete removes the policy of the given key
+func (k *keyPolicy) Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, keyID string) error {
+	cmd, err := conn.Exec(ctx, `DELETE FROM key_policies WHERE issuer_id = $1 AND key_id = $2`, issuerDID.String(), keyID)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrKeyPolicyNotFound
+	}
+	return nil
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 )
 
@@ -93,3 +95,37 @@ func TestKey_GetByPublicKey(t *testing.T) {
 		assert.True(t, errors.Is(err, ErrKeyNotFound))
 	})
 }
+
+func TestKey_GetExpiring(t *testing.T) {
+	keyRepository := NewKey(*storage)
+	ctx := context.Background()
+	did := randomDID(t)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", did.String(), "BJJ")
+	assert.NoError(t, err)
+
+	now := time.Now()
+	expiring := domain.NewKey(did, "publicKey"+uuid.New().String(), "expiring"+uuid.New().String())
+	expiring.ExpiresAt = common.ToPointer(now.Add(time.Hour))
+	_, err = keyRepository.Save(ctx, storage.Pgx, expiring)
+	require.NoError(t, err)
+
+	processed := domain.NewKey(did, "publicKey"+uuid.New().String(), "processed"+uuid.New().String())
+	processed.ExpiresAt = common.ToPointer(now.Add(-time.Hour))
+	processed.ExpiredAt = common.ToPointer(now)
+	_, err = keyRepository.Save(ctx, storage.Pgx, processed)
+	require.NoError(t, err)
+
+	notExpiring := domain.NewKey(did, "publicKey"+uuid.New().String(), "notExpiring"+uuid.New().String())
+	_, err = keyRepository.Save(ctx, storage.Pgx, notExpiring)
+	require.NoError(t, err)
+
+	keys, err := keyRepository.GetExpiring(ctx, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	ids := make([]uuid.UUID, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, key.ID)
+	}
+	assert.Contains(t, ids, expiring.ID)
+	assert.NotContains(t, ids, processed.ID)
+	assert.NotContains(t, ids, notExpiring.ID)
+}
@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+type keyUsage struct{}
+
+// NewKeyUsage returns a new key usages repository
+func NewKeyUsage() ports.KeyUsageRepository {
+	return &keyUsage{}
+}
+
+// Save appends the given key usage. The table does not allow updates or deletes.
+func (k *keyUsage) Save(ctx context.Context, conn db.Querier, usage *domain.KeyUsage) error {
+	var issuerID *string
+	if usage.IssuerDID != nil {
+		did := usage.IssuerDID.String()
+		issuerID = &did
+	}
+	_, err := conn.Exec(ctx,
+		`INSERT INTO key_usages (id, key_id, key_type, issuer_id, purpose, digest, status, reason, created_at)
+				VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		usage.ID, usage.KeyID, string(usage.KeyType), issuerID, string(usage.Purpose), usage.Digest, string(usage.Status), usage.Reason, usage.CreatedAt)
+	return err
+}
+
+// CountSigned returns the number of signatures made by the key since the given time
+func (k *keyUsage) CountSigned(ctx context.Context, conn db.Querier, keyID string, since time.Time) (int, error) {
+	var count int
+	err := conn.QueryRow(ctx,
+		`SELECT COUNT(*) FROM key_usages WHERE key_id = $1 AND status = $2 AND created_at >= $3`,
+		keyID, string(kms.SignStatusSigned), since).Scan(&count)
+	return count, err
+}
+
+// GetAll returns the usages of the given key, newest first, and the total number of usages matching the filter
+func (k *keyUsage) GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID, keyID string, filter ports.KeyUsageFilter) ([]domain.KeyUsage, uint, error) {
+	where := ` WHERE issuer_id = $1 AND key_id = $2`
+	args := []any{issuerDID.String(), keyID}
+	if filter.Purpose != nil {
+		args = append(args, string(*filter.Purpose))
+		where += fmt.Sprintf(" AND purpose = $%d", len(args))
+	}
+	if filter.Status != nil {
+		args = append(args, string(*filter.Status))
+		where += fmt.Sprintf(" AND status = $%d", len(args))
+	}
+
+	var total uint
+	if err := conn.QueryRow(ctx, `SELECT COUNT(*) FROM key_usages`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	sql := `SELECT id, key_id, key_type, issuer_id, purpose, digest, status, reason, created_at FROM key_usages` + where +
+		fmt.Sprintf(" ORDER BY created_at DESC OFFSET %d LIMIT %d", (filter.Page-1)*filter.MaxResults, filter.MaxResults)
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	usages := make([]domain.KeyUsage, 0)
+	for rows.Next() {
+		var usage domain.KeyUsage
+		var keyType, purpose, status string
+		var issuerID *string
+		if err := rows.Scan(&usage.ID, &usage.KeyID, &keyType, &issuerID, &purpose, &usage.Digest, &status, &usage.Reason, &usage.CreatedAt); err != nil {
+			return nil, 0, err
+		}
+		usage.KeyType = kms.KeyType(keyType)
+		usage.Purpose = kms.SignPurpose(purpose)
+		usage.Status = kms.SignStatus(status)
+		if issuerID != nil {
+			if usage.IssuerDID, err = w3c.ParseDID(*issuerID); err != nil {
+				return nil, 0, fmt.Errorf("parsing issuer DID from key usage: %w", err)
+			}
+		}
+		usages = append(usages, usage)
+	}
+
+	return usages, total, rows.Err()
+}
@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+func TestKeyUsages(t *testing.T) {
+	ctx := context.Background()
+	usagesRepo := NewKeyUsage()
+	policiesRepo := NewKeyPolicy()
+
+	fixture := NewFixture(storage)
+	issuerDID := randomDID(t)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerDID.String()})
+	keyID := kms.KeyID{Type: kms.KeyTypeEthereum, ID: issuerDID.String() + "/ETH:0x0263"}
+
+	t.Run("should save and get the key usages", func(t *testing.T) {
+		signed := domain.NewKeyUsage(kms.SignRecord{KeyID: keyID, Purpose: kms.SignPurposePaymentRequest, Digest: []byte{1, 2}, Status: kms.SignStatusSigned})
+		require.NotNil(t, signed.IssuerDID)
+		assert.Equal(t, issuerDID.String(), signed.IssuerDID.String())
+		require.NoError(t, usagesRepo.Save(ctx, storage.Pgx, signed))
+		denied := domain.NewKeyUsage(kms.SignRecord{KeyID: keyID, Purpose: kms.SignPurposeStateTransition, Digest: []byte{3, 4}, Status: kms.SignStatusDenied, Reason: "not allowed"})
+		require.NoError(t, usagesRepo.Save(ctx, storage.Pgx, denied))
+
+		count, err := usagesRepo.CountSigned(ctx, storage.Pgx, keyID.ID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		usages, total, err := usagesRepo.GetAll(ctx, storage.Pgx, issuerDID, keyID.ID, ports.KeyUsageFilter{Page: 1, MaxResults: 10})
+		require.NoError(t, err)
+		assert.Equal(t, uint(2), total)
+		require.Len(t, usages, 2)
+		assert.Equal(t, denied.ID, usages[0].ID)
+		assert.Equal(t, "0304", usages[0].Digest)
+
+		usages, total, err = usagesRepo.GetAll(ctx, storage.Pgx, issuerDID, keyID.ID, ports.KeyUsageFilter{Purpose: common.ToPointer(kms.SignPurposePaymentRequest), Page: 1, MaxResults: 10})
+		require.NoError(t, err)
+		assert.Equal(t, uint(1), total)
+		require.Len(t, usages, 1)
+		assert.Equal(t, kms.SignStatusSigned, usages[0].Status)
+	})
+
+	t.Run("should not update or delete key usages", func(t *testing.T) {
+		_, err := storage.Pgx.Exec(ctx, `UPDATE key_usages SET status = 'signed' WHERE key_id = $1`, keyID.ID)
+		assert.Error(t, err)
+		_, err = storage.Pgx.Exec(ctx, `DELETE FROM key_usages WHERE key_id = $1`, keyID.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("should save, get and delete the key policy", func(t *testing.T) {
+		policy := domain.NewKeyPolicy(issuerDID, keyID.ID, []kms.SignPurpose{kms.SignPurposePaymentRequest}, 5, time.Hour)
+		require.NoError(t, policiesRepo.Save(ctx, storage.Pgx, policy))
+
+		got, err := policiesRepo.GetByKeyID(ctx, storage.Pgx, keyID.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []kms.SignPurpose{kms.SignPurposePaymentRequest}, got.AllowedPurposes)
+		assert.Equal(t, 5, got.RateLimit)
+		assert.Equal(t, time.Hour, got.RateLimitWindow)
+		assert.False(t, got.Allows(kms.SignPurposeStateTransition))
+
+		require.NoError(t, policiesRepo.Delete(ctx, storage.Pgx, issuerDID, keyID.ID))
+		_, err = policiesRepo.GetByKeyID(ctx, storage.Pgx, keyID.ID)
+		assert.ErrorIs(t, err, ErrKeyPolicyNotFound)
+		assert.ErrorIs(t, policiesRepo.Delete(ctx, storage.Pgx, issuerDID, keyID.ID), ErrKeyPolicyNotFound)
+	})
+}
@@ -395,10 +395,14 @@ func (p *payment) SavePaymentOption(ctx context.Context, opt *domain.PaymentOpti
 		INSERT INTO payment_options (id, issuer_did, name, description, configuration, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7)
  		ON CONFLICT (id) DO UPDATE SET name=$3, description=$4, configuration=$5, updated_at=NOW()
+		WHERE payment_options.issuer_did = EXCLUDED.issuer_did
 		RETURNING id;
 		`
 
-	_, err := p.conn.Pgx.Exec(ctx, query, opt.ID, opt.IssuerDID.String(), opt.Name, opt.Description, opt.Config, opt.CreatedAt, opt.UpdatedAt)
+	cmd, err := p.conn.Pgx.Exec(ctx, query, opt.ID, opt.IssuerDID.String(), opt.Name, opt.Description, opt.Config, opt.CreatedAt, opt.UpdatedAt)
+	if err == nil && cmd.RowsAffected() == 0 {
+		return uuid.Nil, ErrPaymentOptionDoesNotExists
+	}
 	if err != nil {
 		if strings.Contains(err.Error(), "violates foreign key constraint") {
 			return uuid.Nil, ErrIdentityNotFound
@@ -0,0 +1,260 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// ErrPendingActionDoesNotExist pending action does not exist
+	ErrPendingActionDoesNotExist = errors.New("pending action does not exist")
+	// ErrPendingActionAlreadyDecided the approver already approved or rejected the pending action
+	ErrPendingActionAlreadyDecided = errors.New("the approver already decided on the pending action")
+)
+
+const pendingActionColumns = `id, issuer_id, type, payload, status, required_approvals, request, qr_code_url, result, failure_reason, created_at, expires_at, completed_at, requested_by`
+
+type dbPendingAction struct {
+	ID                uuid.UUID
+	IssuerDID         string
+	Type              string
+	Payload           pgtype.JSONB
+	Status            string
+	RequiredApprovals int
+	Request           pgtype.JSONB
+	QRCodeURL         *string
+	Result            pgtype.JSONB
+	FailureReason     *string
+	CreatedAt         time.Time
+	ExpiresAt         time.Time
+	CompletedAt       *time.Time
+	RequestedBy       *string
+}
+
+type pendingAction struct{}
+
+// NewPendingAction returns a new pending actions repository
+func NewPendingAction() ports.PendingActionRepository {
+	return &pendingAction{}
+}
+
+// Save stores in the database the given pending action and updates its outcome in case already exists.
+// Approvals are stored with AddApproval.
+func (p *pendingAction) Save(ctx context.Context, conn db.Querier, action *domain.PendingAction) error {
+	var request []byte
+	if action.Request != nil {
+		var err error
+		if request, err = json.Marshal(action.Request); err != nil {
+			return err
+		}
+	}
+
+	var result []byte
+	if len(action.Result) > 0 {
+		result = action.Result
+	}
+
+	sql := `INSERT INTO pending_actions (` + pendingActionColumns + `)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) ON CONFLICT (id) DO
+			UPDATE SET status=$5, result=$9, failure_reason=$10, completed_at=$13`
+	_, err := conn.Exec(ctx, sql, action.ID, action.IssuerDID.String(), string(action.Type), []byte(action.Payload), string(action.Status),
+		action.RequiredApprovals, request, action.QRCodeURL, result, action.FailureReason, action.CreatedAt, action.ExpiresAt, action.CompletedAt,
+		action.RequestedBy)
+
+	return err
+}
+
+// AddApproval stores the decision of an approver. Every approver can only decide once.
+func (p *pendingAction) AddApproval(ctx context.Context, conn db.Querier, id uuid.UUID, approval domain.PendingActionApproval) error {
+	_, err := conn.Exec(ctx,
+		`INSERT INTO pending_action_approvals (pending_action_id, approver, decision, reason, created_at) VALUES($1, $2, $3, $4, $5)`,
+		id, approval.Approver, string(approval.Decision), approval.Reason, approval.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return ErrPendingActionAlreadyDecided
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByID returns the pending action with the given id and its approvals
+func (p *pendingAction) GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.PendingAction, error) {
+	return p.getByID(ctx, conn, `SELECT `+pendingActionColumns+` FROM pending_actions WHERE id = $1`, id)
+}
+
+// GetByIDForUpdate returns the pending action with the given id and locks it until the transaction ends,
+// so concurrent approvals are counted one after the other
+func (p *pendingAction) GetByIDForUpdate(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.PendingAction, error) {
+	return p.getByID(ctx, conn, `SELECT `+pendingActionColumns+` FROM pending_actions WHERE id = $1 FOR UPDATE`, id)
+}
+
+// GetAll returns the pending actions of the given issuer, newest first, optionally filtered by status
+func (p *pendingAction) GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID, status *domain.PendingActionStatus) ([]domain.PendingAction, error) {
+	sql := `SELECT ` + pendingActionColumns + ` FROM pending_actions WHERE issuer_id = $1`
+	args := []any{issuerDID.String()}
+	if status != nil {
+		sql += ` AND status = $2`
+		args = append(args, string(*status))
+	}
+	sql += ` ORDER BY created_at DESC`
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	actions := make([]domain.PendingAction, 0)
+	for rows.Next() {
+		var action dbPendingAction
+		if err := scanPendingAction(rows, &action); err != nil {
+			return nil, err
+		}
+		domainAction, err := toPendingActionDomain(&action)
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, *domainAction)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range actions {
+		if actions[i].Approvals, err = p.getApprovals(ctx, conn, actions[i].ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return actions, nil
+}
+
+// GetApproved returns the ids of the approved actions of all the issuers that were not executed yet, oldest first
+func (p *pendingAction) GetApproved(ctx context.Context, conn db.Querier) ([]uuid.UUID, error) {
+	rows, err := conn.Query(ctx, `SELECT id FROM pending_actions WHERE status = $1 ORDER BY created_at`, string(domain.PendingActionStatusApproved))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (p *pendingAction) getByID(ctx context.Context, conn db.Querier, sql string, id uuid.UUID) (*domain.PendingAction, error) {
+	var action dbPendingAction
+	if err := scanPendingAction(conn.QueryRow(ctx, sql, id), &action); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, ErrPendingActionDoesNotExist
+		}
+		return nil, err
+	}
+
+	domainAction, err := toPendingActionDomain(&action)
+	if err != nil {
+		return nil, err
+	}
+	if domainAction.Approvals, err = p.getApprovals(ctx, conn, id); err != nil {
+		return nil, err
+	}
+	return domainAction, nil
+}
+
+func (p *pendingAction) getApprovals(ctx context.Context, conn db.Querier, id uuid.UUID) ([]domain.PendingActionApproval, error) {
+	rows, err := conn.Query(ctx,
+		`SELECT approver, decision, reason, created_at FROM pending_action_approvals
+				WHERE pending_action_id = $1
+				ORDER BY created_at`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	approvals := make([]domain.PendingActionApproval, 0)
+	for rows.Next() {
+		var approval domain.PendingActionApproval
+		var decision string
+		if err := rows.Scan(&approval.Approver, &decision, &approval.Reason, &approval.CreatedAt); err != nil {
+			return nil, err
+		}
+		approval.Decision = domain.PendingActionDecision(decision)
+		approvals = append(approvals, approval)
+	}
+
+	return approvals, rows.Err()
+}
+
+func scanPendingAction(row pgx.Row, action *dbPendingAction) error {
+	return row.Scan(
+		&action.ID,
+		&action.IssuerDID,
+		&action.Type,
+		&action.Payload,
+		&action.Status,
+		&action.RequiredApprovals,
+		&action.Request,
+		&action.QRCodeURL,
+		&action.Result,
+		&action.FailureReason,
+		&action.CreatedAt,
+		&action.ExpiresAt,
+		&action.CompletedAt,
+		&action.RequestedBy,
+	)
+}
+
+func toPendingActionDomain(a *dbPendingAction) (*domain.PendingAction, error) {
+	issuerDID, err := w3c.ParseDID(a.IssuerDID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing issuer DID from pending action: %w", err)
+	}
+
+	action := &domain.PendingAction{
+		ID:                a.ID,
+		IssuerDID:         *issuerDID,
+		Type:              domain.PendingActionType(a.Type),
+		RequestedBy:       a.RequestedBy,
+		Payload:           a.Payload.Bytes,
+		Status:            domain.PendingActionStatus(a.Status),
+		RequiredApprovals: a.RequiredApprovals,
+		QRCodeURL:         a.QRCodeURL,
+		FailureReason:     a.FailureReason,
+		CreatedAt:         a.CreatedAt,
+		ExpiresAt:         a.ExpiresAt,
+		CompletedAt:       a.CompletedAt,
+	}
+
+	if a.Result.Status == pgtype.Present {
+		action.Result = a.Result.Bytes
+	}
+
+	if a.Request.Status == pgtype.Present {
+		if err := a.Request.AssignTo(&action.Request); err != nil {
+			return nil, fmt.Errorf("parsing request from pending action: %w", err)
+		}
+	}
+
+	return action, nil
+}
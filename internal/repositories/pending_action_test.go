@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+func TestPendingActions(t *testing.T) {
+	ctx := context.Background()
+	repo := NewPendingAction()
+
+	fixture := NewFixture(storage)
+	issuerDID := randomDID(t)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerDID.String()})
+
+	action, err := domain.NewPendingAction(issuerDID, domain.PendingActionTypeCreateKey, common.ToPointer("apikey:requester"), domain.CreateKeyPayload{KeyType: kms.KeyTypeEthereum, Name: "treasury"}, 2, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, storage.Pgx, action))
+
+	t.Run("should get the pending action", func(t *testing.T) {
+		got, err := repo.GetByID(ctx, storage.Pgx, action.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.PendingActionTypeCreateKey, got.Type)
+		assert.Equal(t, domain.PendingActionStatusPending, got.Status)
+		assert.Equal(t, 2, got.RequiredApprovals)
+		assert.Empty(t, got.Approvals)
+		require.NotNil(t, got.RequestedBy)
+		assert.Equal(t, "apikey:requester", *got.RequestedBy)
+
+		var payload domain.CreateKeyPayload
+		require.NoError(t, json.Unmarshal(got.Payload, &payload))
+		assert.Equal(t, "treasury", payload.Name)
+	})
+
+	t.Run("should fail for a non existing pending action", func(t *testing.T) {
+		_, err := repo.GetByID(ctx, storage.Pgx, uuid.New())
+		assert.ErrorIs(t, err, ErrPendingActionDoesNotExist)
+	})
+
+	t.Run("should add approvals only once per approver", func(t *testing.T) {
+		approval := domain.PendingActionApproval{Approver: "alice", Decision: domain.PendingActionDecisionApproved, CreatedAt: time.Now()}
+		require.NoError(t, repo.AddApproval(ctx, storage.Pgx, action.ID, approval))
+		assert.ErrorIs(t, repo.AddApproval(ctx, storage.Pgx, action.ID, approval), ErrPendingActionAlreadyDecided)
+
+		got, err := repo.GetByIDForUpdate(ctx, storage.Pgx, action.ID)
+		require.NoError(t, err)
+		require.Len(t, got.Approvals, 1)
+		assert.Equal(t, "alice", got.Approvals[0].Approver)
+		assert.Equal(t, 1, got.ApprovalsCount())
+	})
+
+	t.Run("should update the outcome and filter by status", func(t *testing.T) {
+		action.Executed(json.RawMessage(`{"id":"key"}`))
+		require.NoError(t, repo.Save(ctx, storage.Pgx, action))
+
+		rejected, err := domain.NewPendingAction(issuerDID, domain.PendingActionTypePublishState, nil, domain.PublishStatePayload{}, 2, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		rejected.Finished(domain.PendingActionStatusRejected, "not now")
+		require.NoError(t, repo.Save(ctx, storage.Pgx, rejected))
+
+		all, err := repo.GetAll(ctx, storage.Pgx, issuerDID, nil)
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+
+		executed, err := repo.GetAll(ctx, storage.Pgx, issuerDID, common.ToPointer(domain.PendingActionStatusExecuted))
+		require.NoError(t, err)
+		require.Len(t, executed, 1)
+		assert.Equal(t, action.ID, executed[0].ID)
+		assert.JSONEq(t, `{"id":"key"}`, string(executed[0].Result))
+		assert.NotNil(t, executed[0].CompletedAt)
+	})
+
+	t.Run("should get the approved actions", func(t *testing.T) {
+		approved, err := domain.NewPendingAction(issuerDID, domain.PendingActionTypePublishState, nil, domain.PublishStatePayload{}, 2, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		approved.Status = domain.PendingActionStatusApproved
+		require.NoError(t, repo.Save(ctx, storage.Pgx, approved))
+
+		ids, err := repo.GetApproved(ctx, storage.Pgx)
+		require.NoError(t, err)
+		assert.Contains(t, ids, approved.ID)
+		assert.NotContains(t, ids, action.ID)
+	})
+}
@@ -0,0 +1,215 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// ErrProofRequestDoesNotExist proof request does not exist
+	ErrProofRequestDoesNotExist = errors.New("proof request does not exist")
+	// ErrProofRequestResponseAlreadyExists the holder already answered the proof request
+	ErrProofRequestResponseAlreadyExists = errors.New("proof request response already exists")
+)
+
+const proofRequestColumns = `id, issuer_id, connection_id, user_id, reason, message, qr_code_url, status, results, failure_reason, created_at, verified_at`
+
+type dbProofRequest struct {
+	ID            uuid.UUID
+	IssuerDID     string
+	ConnectionID  *uuid.UUID
+	UserDID       *string
+	Reason        string
+	Message       pgtype.JSONB
+	QRCodeURL     *string
+	Status        string
+	Results       pgtype.JSONB
+	FailureReason *string
+	CreatedAt     time.Time
+	VerifiedAt    *time.Time
+}
+
+type proofRequest struct{}
+
+// NewProofRequest returns a new proof requests repository
+func NewProofRequest() ports.ProofRequestRepository {
+	return &proofRequest{}
+}
+
+// Save stores in the database the given proof request and updates its outcome in case already exists
+func (p *proofRequest) Save(ctx context.Context, conn db.Querier, request *domain.ProofRequest) error {
+	message, err := json.Marshal(request.Message)
+	if err != nil {
+		return err
+	}
+
+	var results []byte
+	if request.Results != nil {
+		if results, err = json.Marshal(request.Results); err != nil {
+			return err
+		}
+	}
+
+	sql := `INSERT INTO proof_requests (` + proofRequestColumns + `)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) ON CONFLICT (id) DO
+			UPDATE SET connection_id=$3, user_id=$4, status=$8, results=$9, failure_reason=$10, verified_at=$12`
+	_, err = conn.Exec(ctx, sql, request.ID, request.IssuerDID.String(), request.ConnectionID, request.UserDID, request.Reason,
+		message, request.QRCodeURL, string(request.Status), results, request.FailureReason, request.CreatedAt, request.VerifiedAt)
+
+	return err
+}
+
+// GetByID returns the proof request with the given id
+func (p *proofRequest) GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.ProofRequest, error) {
+	var request dbProofRequest
+	row := conn.QueryRow(ctx, `SELECT `+proofRequestColumns+` FROM proof_requests WHERE id = $1`, id)
+	if err := scanProofRequest(row, &request); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, ErrProofRequestDoesNotExist
+		}
+		return nil, err
+	}
+
+	return toProofRequestDomain(&request)
+}
+
+// GetAll returns the proof requests of the issuer, newest first. If connectionID is provided only the ones of that connection are returned.
+func (p *proofRequest) GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID, connectionID *uuid.UUID) ([]domain.ProofRequest, error) {
+	sql := `SELECT ` + proofRequestColumns + ` FROM proof_requests WHERE issuer_id = $1`
+	args := []interface{}{issuerDID.String()}
+	if connectionID != nil {
+		sql += ` AND connection_id = $2`
+		args = append(args, *connectionID)
+	}
+	sql += ` ORDER BY created_at DESC`
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	requests := make([]domain.ProofRequest, 0)
+	for rows.Next() {
+		var request dbProofRequest
+		if err := scanProofRequest(rows, &request); err != nil {
+			return nil, err
+		}
+		domainRequest, err := toProofRequestDomain(&request)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, *domainRequest)
+	}
+
+	return requests, rows.Err()
+}
+
+// SaveResponse stores the verified answer of a holder. A holder can only answer a proof request once.
+func (p *proofRequest) SaveResponse(ctx context.Context, conn db.Querier, response *domain.ProofRequestResponse) error {
+	results, err := json.Marshal(response.Results)
+	if err != nil {
+		return err
+	}
+
+	res, err := conn.Exec(ctx,
+		`INSERT INTO proof_request_responses (id, proof_request_id, user_id, connection_id, results, created_at)
+				VALUES($1, $2, $3, $4, $5, $6) ON CONFLICT (proof_request_id, user_id) DO NOTHING`,
+		response.ID, response.ProofRequestID, response.UserDID, response.ConnectionID, results, response.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrProofRequestResponseAlreadyExists
+	}
+	return nil
+}
+
+// GetResponses returns the answers to the given proof requests, oldest first
+func (p *proofRequest) GetResponses(ctx context.Context, conn db.Querier, proofRequestIDs []uuid.UUID) ([]domain.ProofRequestResponse, error) {
+	rows, err := conn.Query(ctx,
+		`SELECT id, proof_request_id, user_id, connection_id, results, created_at
+				FROM proof_request_responses
+				WHERE proof_request_id = ANY($1)
+				ORDER BY created_at`, proofRequestIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	responses := make([]domain.ProofRequestResponse, 0)
+	for rows.Next() {
+		var response domain.ProofRequestResponse
+		var results pgtype.JSONB
+		if err := rows.Scan(&response.ID, &response.ProofRequestID, &response.UserDID, &response.ConnectionID, &results, &response.CreatedAt); err != nil {
+			return nil, err
+		}
+		if err := results.AssignTo(&response.Results); err != nil {
+			return nil, fmt.Errorf("parsing results from proof request response: %w", err)
+		}
+		responses = append(responses, response)
+	}
+
+	return responses, rows.Err()
+}
+
+func scanProofRequest(row pgx.Row, request *dbProofRequest) error {
+	return row.Scan(
+		&request.ID,
+		&request.IssuerDID,
+		&request.ConnectionID,
+		&request.UserDID,
+		&request.Reason,
+		&request.Message,
+		&request.QRCodeURL,
+		&request.Status,
+		&request.Results,
+		&request.FailureReason,
+		&request.CreatedAt,
+		&request.VerifiedAt,
+	)
+}
+
+func toProofRequestDomain(r *dbProofRequest) (*domain.ProofRequest, error) {
+	issuerDID, err := w3c.ParseDID(r.IssuerDID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing issuer DID from proof request: %w", err)
+	}
+
+	request := &domain.ProofRequest{
+		ID:            r.ID,
+		IssuerDID:     *issuerDID,
+		ConnectionID:  r.ConnectionID,
+		UserDID:       r.UserDID,
+		Reason:        r.Reason,
+		QRCodeURL:     r.QRCodeURL,
+		Status:        domain.ProofRequestStatus(r.Status),
+		FailureReason: r.FailureReason,
+		CreatedAt:     r.CreatedAt,
+		VerifiedAt:    r.VerifiedAt,
+	}
+
+	if err := r.Message.AssignTo(&request.Message); err != nil {
+		return nil, fmt.Errorf("parsing message from proof request: %w", err)
+	}
+
+	if r.Results.Status == pgtype.Present {
+		if err := r.Results.AssignTo(&request.Results); err != nil {
+			return nil, fmt.Errorf("parsing results from proof request: %w", err)
+		}
+	}
+
+	return request, nil
+}
@@ -0,0 +1,144 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestProofRequests(t *testing.T) {
+	ctx := context.Background()
+	proofRequestsRepo := NewProofRequest()
+
+	fixture := NewFixture(storage)
+	issuerDID := randomDID(t)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerDID.String()})
+	userDID := randomDID(t)
+
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  issuerDID,
+		UserDID:    userDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	message := protocol.AuthorizationRequestMessage{
+		ID:   uuid.NewString(),
+		Type: protocol.AuthorizationRequestMessageType,
+		From: issuerDID.String(),
+		Body: protocol.AuthorizationRequestMessageBody{
+			Reason: "age check",
+			Scope:  []protocol.ZeroKnowledgeProofRequest{{ID: 1, CircuitID: "credentialAtomicQuerySigV2"}},
+		},
+	}
+
+	public := &domain.ProofRequest{
+		ID:        uuid.New(),
+		IssuerDID: issuerDID,
+		Reason:    "age check",
+		Message:   message,
+		QRCodeURL: common.ToPointer("iden3comm://?request_uri=https://issuer.com/qr"),
+		Status:    domain.ProofRequestStatusPending,
+		CreatedAt: time.Now().Add(-time.Minute),
+	}
+	direct := &domain.ProofRequest{
+		ID:           uuid.New(),
+		IssuerDID:    issuerDID,
+		ConnectionID: &connID,
+		Reason:       "age check",
+		Message:      message,
+		Status:       domain.ProofRequestStatusPending,
+		CreatedAt:    time.Now(),
+	}
+
+	t.Run("should save the proof requests as pending", func(t *testing.T) {
+		require.NoError(t, proofRequestsRepo.Save(ctx, storage.Pgx, public))
+		require.NoError(t, proofRequestsRepo.Save(ctx, storage.Pgx, direct))
+
+		request, err := proofRequestsRepo.GetByID(ctx, storage.Pgx, public.ID)
+		require.NoError(t, err)
+		assert.Nil(t, request.ConnectionID)
+		assert.Equal(t, domain.ProofRequestStatusPending, request.Status)
+		assert.Equal(t, message.ID, request.Message.ID)
+		require.Len(t, request.Message.Body.Scope, 1)
+		assert.Equal(t, *public.QRCodeURL, *request.QRCodeURL)
+		assert.Nil(t, request.Results)
+		assert.Nil(t, request.VerifiedAt)
+	})
+
+	t.Run("should update the outcome of the proof request", func(t *testing.T) {
+		direct.Verified(userDID.String(), &connID, []domain.ProofRequestResult{
+			{ID: 1, CircuitID: "credentialAtomicQuerySigV2", Outputs: map[string]interface{}{"merklized": "1"}},
+		})
+		require.NoError(t, proofRequestsRepo.Save(ctx, storage.Pgx, direct))
+
+		request, err := proofRequestsRepo.GetByID(ctx, storage.Pgx, direct.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ProofRequestStatusVerified, request.Status)
+		require.NotNil(t, request.ConnectionID)
+		assert.Equal(t, connID, *request.ConnectionID)
+		assert.Equal(t, userDID.String(), *request.UserDID)
+		require.Len(t, request.Results, 1)
+		assert.Equal(t, "1", request.Results[0].Outputs["merklized"])
+		assert.NotNil(t, request.VerifiedAt)
+	})
+
+	t.Run("should keep the answer of each holder", func(t *testing.T) {
+		otherDID := randomDID(t)
+		first := public.Verified(userDID.String(), &connID, []domain.ProofRequestResult{{ID: 1, CircuitID: "credentialAtomicQuerySigV2"}})
+		second := public.Verified(otherDID.String(), nil, []domain.ProofRequestResult{{ID: 1, CircuitID: "credentialAtomicQuerySigV2"}})
+		require.NoError(t, proofRequestsRepo.SaveResponse(ctx, storage.Pgx, first))
+		require.NoError(t, proofRequestsRepo.SaveResponse(ctx, storage.Pgx, second))
+		require.NoError(t, proofRequestsRepo.Save(ctx, storage.Pgx, public))
+
+		again := *first
+		again.ID = uuid.New()
+		assert.ErrorIs(t, proofRequestsRepo.SaveResponse(ctx, storage.Pgx, &again), ErrProofRequestResponseAlreadyExists)
+
+		responses, err := proofRequestsRepo.GetResponses(ctx, storage.Pgx, []uuid.UUID{public.ID, direct.ID})
+		require.NoError(t, err)
+		require.Len(t, responses, 2)
+		assert.Equal(t, userDID.String(), responses[0].UserDID)
+		assert.Equal(t, connID, *responses[0].ConnectionID)
+		assert.Equal(t, otherDID.String(), responses[1].UserDID)
+		assert.Nil(t, responses[1].ConnectionID)
+		require.Len(t, responses[1].Results, 1)
+
+		request, err := proofRequestsRepo.GetByID(ctx, storage.Pgx, public.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ProofRequestStatusVerified, request.Status)
+		assert.Nil(t, request.ConnectionID)
+		assert.Nil(t, request.UserDID)
+	})
+
+	t.Run("should filter the proof requests by connection", func(t *testing.T) {
+		all, err := proofRequestsRepo.GetAll(ctx, storage.Pgx, issuerDID, nil)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		assert.Equal(t, direct.ID, all[0].ID)
+
+		byConnection, err := proofRequestsRepo.GetAll(ctx, storage.Pgx, issuerDID, &connID)
+		require.NoError(t, err)
+		require.Len(t, byConnection, 1)
+		assert.Equal(t, direct.ID, byConnection[0].ID)
+
+		other := uuid.New()
+		filtered, err := proofRequestsRepo.GetAll(ctx, storage.Pgx, issuerDID, &other)
+		require.NoError(t, err)
+		assert.Empty(t, filtered)
+	})
+
+	t.Run("should return an error if the proof request does not exist", func(t *testing.T) {
+		_, err := proofRequestsRepo.GetByID(ctx, storage.Pgx, uuid.New())
+		assert.ErrorIs(t, err, ErrProofRequestDoesNotExist)
+	})
+}
@@ -92,7 +92,7 @@ func (r *schema) Update(ctx context.Context, schema *domain.Schema) error {
 	const updateSchema = `
 	UPDATE schemas 
 	SET issuer_id=$2, url=$3, type=$4, context_url=$5, hash=$6,  words=$7, created_at=$8, version=$9, title=$10, description=$11, display_method_id=$12
-	WHERE schemas.id = $1;`
+	WHERE schemas.id = $1 AND schemas.issuer_id = $2;`
 	hash, err := schema.Hash.MarshalText()
 	if err != nil {
 		return err
@@ -2,40 +2,44 @@ package repositories
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"time"
 
-	"github.com/iden3/iden3comm/v2/protocol"
-
 	"github.com/polygonid/sh-id-platform/internal/cache"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 )
 
-const (
-	defaultTTL = 5 * time.Minute
-)
+// ErrAuthSessionNotFound authentication session not found
+var ErrAuthSessionNotFound = errors.New("authorization request not found")
 
 type cached struct {
-	cache cache.Cache
+	cache     cache.Cache
+	retention time.Duration
 }
 
-// NewSessionCached returns a new cached manager
-func NewSessionCached(c cache.Cache) ports.SessionRepository {
-	return &cached{cache: c}
+// NewSessionCached returns a new cached manager. Sessions are kept in the cache for the retention time after they expire,
+// so their final status can still be queried.
+func NewSessionCached(c cache.Cache, retention time.Duration) ports.SessionRepository {
+	return &cached{cache: c, retention: retention}
 }
 
 // Get returns the cached session
-func (c *cached) Get(ctx context.Context, key string) (protocol.AuthorizationRequestMessage, error) {
-	var message protocol.AuthorizationRequestMessage
-	found := c.cache.Get(ctx, key, &message)
+func (c *cached) Get(ctx context.Context, key string) (*domain.AuthSession, error) {
+	var session domain.AuthSession
+	found := c.cache.Get(ctx, key, &session)
 	if !found {
-		return message, fmt.Errorf("authorization request not found")
+		return nil, ErrAuthSessionNotFound
 	}
 
-	return message, nil
+	return &session, nil
 }
 
 // Set stores the given session information
-func (c *cached) Set(ctx context.Context, key string, value protocol.AuthorizationRequestMessage) error {
-	return c.cache.Set(ctx, key, value, defaultTTL)
+func (c *cached) Set(ctx context.Context, key string, value *domain.AuthSession) error {
+	ttl := time.Until(value.ExpiresAt) + c.retention
+	if ttl <= 0 {
+		return c.cache.Delete(ctx, key)
+	}
+	return c.cache.Set(ctx, key, value, ttl)
 }
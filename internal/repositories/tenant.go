@@ -0,0 +1,161 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// ErrTenantNotFound tenant not found
+	ErrTenantNotFound = errors.New("tenant not found")
+	// ErrTenantNameDuplicated a tenant with the same name already exists
+	ErrTenantNameDuplicated = errors.New("tenant name already exists")
+	// ErrIdentityInAnotherTenant the identity already belongs to another tenant
+	ErrIdentityInAnotherTenant = errors.New("the identity belongs to another tenant")
+)
+
+type tenant struct{}
+
+// NewTenant returns a new tenants repository
+func NewTenant() ports.TenantRepository {
+	return &tenant{}
+}
+
+// Save stores the tenant or updates its name and quotas
+func (t *tenant) Save(ctx context.Context, conn db.Querier, tenant *domain.Tenant) error {
+	_, err := conn.Exec(ctx,
+		`INSERT INTO tenants (id, name, max_identities, max_credentials_per_month, created_at)
+				VALUES($1, $2, $3, $4, $5) ON CONFLICT (id) DO
+				UPDATE SET name=$2, max_identities=$3, max_credentials_per_month=$4`,
+		tenant.ID, tenant.Name, tenant.MaxIdentities, tenant.MaxCredentialsPerMonth, tenant.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return ErrTenantNameDuplicated
+		}
+	}
+	return err
+}
+
+// GetByID returns the tenant with the given id
+func (t *tenant) GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.Tenant, error) {
+	row := conn.QueryRow(ctx,
+		`SELECT id, name, max_identities, max_credentials_per_month, created_at FROM tenants WHERE id = $1`, id)
+	return scanTenant(row)
+}
+
+// GetByIDForUpdate returns the tenant with the given id and locks it until the transaction ends, so the quotas of
+// the tenant are checked one after the other
+func (t *tenant) GetByIDForUpdate(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.Tenant, error) {
+	row := conn.QueryRow(ctx,
+		`SELECT id, name, max_identities, max_credentials_per_month, created_at FROM tenants WHERE id = $1 FOR UPDATE`, id)
+	return scanTenant(row)
+}
+
+// GetAll returns all the tenants sorted by name
+func (t *tenant) GetAll(ctx context.Context, conn db.Querier) ([]domain.Tenant, error) {
+	rows, err := conn.Query(ctx,
+		`SELECT id, name, max_identities, max_credentials_per_month, created_at FROM tenants ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tenants := make([]domain.Tenant, 0)
+	for rows.Next() {
+		tenant, err := scanTenant(rows)
+		if err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, *tenant)
+	}
+	return tenants, rows.Err()
+}
+
+// GetByIdentity returns the tenant of the identity, or ErrTenantNotFound if the identity has no tenant
+func (t *tenant) GetByIdentity(ctx context.Context, conn db.Querier, identifier string) (*domain.Tenant, error) {
+	row := conn.QueryRow(ctx,
+		`SELECT tenants.id, tenants.name, tenants.max_identities, tenants.max_credentials_per_month, tenants.created_at
+				FROM tenants JOIN identities ON identities.tenant_id = tenants.id
+				WHERE identities.identifier = $1`, identifier)
+	return scanTenant(row)
+}
+
+// GetIdentities returns the identifiers of the identities of the tenant
+func (t *tenant) GetIdentities(ctx context.Context, conn db.Querier, id uuid.UUID) ([]string, error) {
+	rows, err := conn.Query(ctx, `SELECT identifier FROM identities WHERE tenant_id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	identities := make([]string, 0)
+	for rows.Next() {
+		var identifier string
+		if err := rows.Scan(&identifier); err != nil {
+			return nil, err
+		}
+		identities = append(identities, identifier)
+	}
+	return identities, rows.Err()
+}
+
+// AddIdentity assigns an identity without tenant to the tenant. Adding an identity of the tenant does nothing.
+func (t *tenant) AddIdentity(ctx context.Context, conn db.Querier, id uuid.UUID, identifier string) error {
+	res, err := conn.Exec(ctx, `UPDATE identities SET tenant_id = $1 WHERE identifier = $2 AND tenant_id IS NULL`, id, identifier)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() > 0 {
+		return nil
+	}
+	var tenantID *uuid.UUID
+	if err := conn.QueryRow(ctx, `SELECT tenant_id FROM identities WHERE identifier = $1`, identifier).Scan(&tenantID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrIdentityNotFound
+		}
+		return err
+	}
+	if tenantID == nil || *tenantID != id {
+		return ErrIdentityInAnotherTenant
+	}
+	return nil
+}
+
+// CountIdentities returns the number of identities of the tenant
+func (t *tenant) CountIdentities(ctx context.Context, conn db.Querier, id uuid.UUID) (int, error) {
+	var count int
+	err := conn.QueryRow(ctx, `SELECT count(*) FROM identities WHERE tenant_id = $1`, id).Scan(&count)
+	return count, err
+}
+
+// CountCredentialsSince returns the number of credentials issued by the identities of the tenant since the given
+// time. Auth credentials are not counted.
+func (t *tenant) CountCredentialsSince(ctx context.Context, conn db.Querier, id uuid.UUID, since time.Time) (int, error) {
+	var count int
+	err := conn.QueryRow(ctx,
+		`SELECT count(*) FROM claims JOIN identities ON identities.identifier = claims.issuer
+				WHERE identities.tenant_id = $1 AND claims.created_at >= $2 AND claims.schema_type <> $3`,
+		id, since, domain.AuthBJJCredentialSchemaType).Scan(&count)
+	return count, err
+}
+
+func scanTenant(row pgx.Row) (*domain.Tenant, error) {
+	var tenant domain.Tenant
+	if err := row.Scan(&tenant.ID, &tenant.Name, &tenant.MaxIdentities, &tenant.MaxCredentialsPerMonth, &tenant.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTenantNotFound
+		}
+		return nil, err
+	}
+	return &tenant, nil
+}
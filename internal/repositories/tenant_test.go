@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestTenants(t *testing.T) {
+	ctx := context.Background()
+	fixture := NewFixture(storage)
+	repo := NewTenant()
+
+	did := randomDID(t)
+	identity := &domain.Identity{Identifier: did.String()}
+	fixture.CreateIdentity(t, identity)
+
+	tenant := domain.NewTenant("tenant "+uuid.NewString(), common.ToPointer(1), nil)
+	other := domain.NewTenant("tenant "+uuid.NewString(), nil, nil)
+
+	t.Run("should save and get the tenants", func(t *testing.T) {
+		require.NoError(t, repo.Save(ctx, storage.Pgx, tenant))
+		require.NoError(t, repo.Save(ctx, storage.Pgx, other))
+
+		got, err := repo.GetByID(ctx, storage.Pgx, tenant.ID)
+		require.NoError(t, err)
+		assert.Equal(t, tenant.Name, got.Name)
+		require.NotNil(t, got.MaxIdentities)
+		assert.Equal(t, 1, *got.MaxIdentities)
+		assert.Nil(t, got.MaxCredentialsPerMonth)
+
+		tenants, err := repo.GetAll(ctx, storage.Pgx)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, len(tenants), 2)
+	})
+
+	t.Run("should get an error - duplicated name", func(t *testing.T) {
+		err := repo.Save(ctx, storage.Pgx, domain.NewTenant(tenant.Name, nil, nil))
+		assert.ErrorIs(t, err, ErrTenantNameDuplicated)
+	})
+
+	t.Run("should add an identity to the tenant", func(t *testing.T) {
+		require.NoError(t, repo.AddIdentity(ctx, storage.Pgx, tenant.ID, identity.Identifier))
+
+		identities, err := repo.GetIdentities(ctx, storage.Pgx, tenant.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{identity.Identifier}, identities)
+
+		count, err := repo.CountIdentities(ctx, storage.Pgx, tenant.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		got, err := repo.GetByIdentity(ctx, storage.Pgx, identity.Identifier)
+		require.NoError(t, err)
+		assert.Equal(t, tenant.ID, got.ID)
+
+		credentials, err := repo.CountCredentialsSince(ctx, storage.Pgx, tenant.ID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 0, credentials)
+	})
+
+	t.Run("should get an error - identity in another tenant", func(t *testing.T) {
+		err := repo.AddIdentity(ctx, storage.Pgx, other.ID, identity.Identifier)
+		assert.ErrorIs(t, err, ErrIdentityInAnotherTenant)
+	})
+
+	t.Run("should return not found", func(t *testing.T) {
+		_, err := repo.GetByID(ctx, storage.Pgx, uuid.New())
+		assert.ErrorIs(t, err, ErrTenantNotFound)
+		unknown := randomDID(t)
+		_, err = repo.GetByIdentity(ctx, storage.Pgx, unknown.String())
+		assert.ErrorIs(t, err, ErrTenantNotFound)
+	})
+}
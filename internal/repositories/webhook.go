@@ -0,0 +1,160 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/pagination"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrWebhookNotFound webhook not found
+var ErrWebhookNotFound = errors.New("webhook not found")
+
+const (
+	webhookFields         = `id, identifier, url, secret, events, description, created_at`
+	webhookDeliveryFields = `id, webhook_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, created_at, delivered_at`
+)
+
+type webhook struct{}
+
+// NewWebhook returns a new webhooks repository
+func NewWebhook() ports.WebhookRepository {
+	return &webhook{}
+}
+
+// Save stores the webhook
+func (wh *webhook) Save(ctx context.Context, conn db.Querier, webhook *domain.Webhook) error {
+	events := make([]string, 0, len(webhook.Events))
+	for _, ev := range webhook.Events {
+		events = append(events, string(ev))
+	}
+	_, err := conn.Exec(ctx,
+		`INSERT INTO webhooks (`+webhookFields+`) VALUES($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (id) DO
+				UPDATE SET url=$3, events=$5, description=$6`,
+		webhook.ID, webhook.Identifier, webhook.URL, webhook.Secret, events, webhook.Description, webhook.CreatedAt)
+	return err
+}
+
+// GetByID returns the webhook of the identity
+func (wh *webhook) GetByID(ctx context.Context, conn db.Querier, identifier w3c.DID, id uuid.UUID) (*domain.Webhook, error) {
+	return scanWebhook(conn.QueryRow(ctx, `SELECT `+webhookFields+` FROM webhooks WHERE identifier = $1 AND id = $2`, identifier.String(), id))
+}
+
+// GetWebhook returns the webhook with the given id
+func (wh *webhook) GetWebhook(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.Webhook, error) {
+	return scanWebhook(conn.QueryRow(ctx, `SELECT `+webhookFields+` FROM webhooks WHERE id = $1`, id))
+}
+
+// GetAll returns the webhooks of the identity sorted by creation date
+func (wh *webhook) GetAll(ctx context.Context, conn db.Querier, identifier w3c.DID) ([]domain.Webhook, error) {
+	rows, err := conn.Query(ctx, `SELECT `+webhookFields+` FROM webhooks WHERE identifier = $1 ORDER BY created_at`, identifier.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	webhooks := make([]domain.Webhook, 0)
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, *webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+// Delete removes the webhook of the identity and its deliveries
+func (wh *webhook) Delete(ctx context.Context, conn db.Querier, identifier w3c.DID, id uuid.UUID) error {
+	res, err := conn.Exec(ctx, `DELETE FROM webhooks WHERE identifier = $1 AND id = $2`, identifier.String(), id)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+// SaveDelivery stores the delivery or updates the result of its attempts
+func (wh *webhook) SaveDelivery(ctx context.Context, conn db.Querier, delivery *domain.WebhookDelivery) error {
+	_, err := conn.Exec(ctx,
+		`INSERT INTO webhook_deliveries (`+webhookDeliveryFields+`) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) ON CONFLICT (id) DO
+				UPDATE SET status=$5, attempts=$6, next_attempt_at=$7, last_status_code=$8, last_error=$9, delivered_at=$11`,
+		delivery.ID, delivery.WebhookID, delivery.EventType, delivery.Payload, delivery.Status, delivery.Attempts,
+		delivery.NextAttemptAt, delivery.LastStatusCode, delivery.LastError, delivery.CreatedAt, delivery.DeliveredAt)
+	return err
+}
+
+// GetDeliveries returns the deliveries of the webhook from the newest to the oldest and the total number of them
+func (wh *webhook) GetDeliveries(ctx context.Context, conn db.Querier, webhookID uuid.UUID, filter pagination.Filter) ([]domain.WebhookDelivery, uint, error) {
+	var total uint
+	if err := conn.QueryRow(ctx, `SELECT count(*) FROM webhook_deliveries WHERE webhook_id = $1`, webhookID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	rows, err := conn.Query(ctx,
+		`SELECT `+webhookDeliveryFields+` FROM webhook_deliveries WHERE webhook_id = $1 ORDER BY created_at DESC OFFSET $2 LIMIT $3`,
+		webhookID, filter.GetOffset(), filter.GetLimit())
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	deliveries, err := scanWebhookDeliveries(rows)
+	return deliveries, total, err
+}
+
+// ClaimDueDeliveries returns the pending deliveries due at now and postpones them until leaseUntil
+func (wh *webhook) ClaimDueDeliveries(ctx context.Context, conn db.Querier, now, leaseUntil time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	rows, err := conn.Query(ctx,
+		`UPDATE webhook_deliveries SET next_attempt_at = $2
+				WHERE id IN (
+					SELECT id FROM webhook_deliveries WHERE status = $3 AND next_attempt_at <= $1
+					ORDER BY next_attempt_at LIMIT $4 FOR UPDATE SKIP LOCKED)
+				RETURNING `+webhookDeliveryFields,
+		now, leaseUntil, domain.WebhookDeliveryPending, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanWebhookDeliveries(rows)
+}
+
+func scanWebhook(row pgx.Row) (*domain.Webhook, error) {
+	var webhook domain.Webhook
+	var events []string
+	if err := row.Scan(&webhook.ID, &webhook.Identifier, &webhook.URL, &webhook.Secret, &events, &webhook.Description, &webhook.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	webhook.Events = make([]domain.WebhookEventType, 0, len(events))
+	for _, ev := range events {
+		webhook.Events = append(webhook.Events, domain.WebhookEventType(ev))
+	}
+	return &webhook, nil
+}
+
+func scanWebhookDeliveries(rows pgx.Rows) ([]domain.WebhookDelivery, error) {
+	deliveries := make([]domain.WebhookDelivery, 0)
+	for rows.Next() {
+		var delivery domain.WebhookDelivery
+		var eventType, status string
+		if err := rows.Scan(&delivery.ID, &delivery.WebhookID, &eventType, &delivery.Payload, &status, &delivery.Attempts,
+			&delivery.NextAttemptAt, &delivery.LastStatusCode, &delivery.LastError, &delivery.CreatedAt, &delivery.DeliveredAt); err != nil {
+			return nil, err
+		}
+		delivery.EventType = domain.WebhookEventType(eventType)
+		delivery.Status = domain.WebhookDeliveryStatus(status)
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, rows.Err()
+}
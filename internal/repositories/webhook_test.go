@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/pagination"
+)
+
+func TestWebhooks(t *testing.T) {
+	ctx := context.Background()
+	fixture := NewFixture(storage)
+	repo := NewWebhook()
+
+	did := randomDID(t)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+	other := randomDID(t)
+
+	webhook := domain.NewWebhook(did.String(), "https://issuer.example.com/webhooks", "whsec_secret", []domain.WebhookEventType{domain.WebhookEventCredentialCreated}, nil)
+
+	t.Run("should save and get the webhooks", func(t *testing.T) {
+		require.NoError(t, repo.Save(ctx, storage.Pgx, webhook))
+
+		got, err := repo.GetByID(ctx, storage.Pgx, did, webhook.ID)
+		require.NoError(t, err)
+		assert.Equal(t, webhook.URL, got.URL)
+		assert.Equal(t, webhook.Secret, got.Secret)
+		assert.Equal(t, webhook.Events, got.Events)
+
+		webhooks, err := repo.GetAll(ctx, storage.Pgx, did)
+		require.NoError(t, err)
+		require.Len(t, webhooks, 1)
+		assert.Equal(t, webhook.ID, webhooks[0].ID)
+	})
+
+	t.Run("should not get the webhook of another identity", func(t *testing.T) {
+		_, err := repo.GetByID(ctx, storage.Pgx, other, webhook.ID)
+		assert.ErrorIs(t, err, ErrWebhookNotFound)
+		assert.ErrorIs(t, repo.Delete(ctx, storage.Pgx, other, webhook.ID), ErrWebhookNotFound)
+	})
+
+	t.Run("should claim the due deliveries once", func(t *testing.T) {
+		delivery := domain.NewWebhookDelivery(uuid.New(), webhook.ID, domain.WebhookEventCredentialCreated, []byte(`{"type":"credential.created"}`))
+		require.NoError(t, repo.SaveDelivery(ctx, storage.Pgx, delivery))
+
+		now := time.Now().Add(time.Second)
+		claimed, err := repo.ClaimDueDeliveries(ctx, storage.Pgx, now, now.Add(time.Minute), 1000)
+		require.NoError(t, err)
+		assert.Contains(t, deliveryIDs(claimed), delivery.ID)
+
+		claimed, err = repo.ClaimDueDeliveries(ctx, storage.Pgx, now, now.Add(time.Minute), 1000)
+		require.NoError(t, err)
+		assert.NotContains(t, deliveryIDs(claimed), delivery.ID)
+
+		delivery.Attempted(nil, errors.New("connection refused"), 1, now)
+		require.NoError(t, repo.SaveDelivery(ctx, storage.Pgx, delivery))
+
+		deliveries, total, err := repo.GetDeliveries(ctx, storage.Pgx, webhook.ID, *pagination.NewFilter(nil, nil))
+		require.NoError(t, err)
+		assert.Equal(t, uint(1), total)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, domain.WebhookDeliveryFailed, deliveries[0].Status)
+		assert.Equal(t, 1, deliveries[0].Attempts)
+		require.NotNil(t, deliveries[0].LastError)
+		assert.Equal(t, "connection refused", *deliveries[0].LastError)
+	})
+
+	t.Run("should delete the webhook with its deliveries", func(t *testing.T) {
+		delivery := domain.NewWebhookDelivery(uuid.New(), webhook.ID, domain.WebhookEventStateConfirmed, []byte(`{}`))
+		code := http.StatusOK
+		delivery.Attempted(&code, nil, 1, time.Now())
+		require.NoError(t, repo.SaveDelivery(ctx, storage.Pgx, delivery))
+
+		require.NoError(t, repo.Delete(ctx, storage.Pgx, did, webhook.ID))
+		_, err := repo.GetWebhook(ctx, storage.Pgx, webhook.ID)
+		assert.ErrorIs(t, err, ErrWebhookNotFound)
+		_, total, err := repo.GetDeliveries(ctx, storage.Pgx, webhook.ID, *pagination.NewFilter(nil, nil))
+		require.NoError(t, err)
+		assert.Zero(t, total)
+	})
+}
+
+func deliveryIDs(deliveries []domain.WebhookDelivery) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(deliveries))
+	for _, d := range deliveries {
+		ids = append(ids, d.ID)
+	}
+	return ids
+}
@@ -116,7 +116,7 @@ func (f *factory) initOnChainRHSCli(ctx context.Context, resolverPrefix string,
 		return nil, err
 	}
 
-	txOpts, err := ethClient.CreateTxOpts(ctx, *kmsKey)
+	txOpts, err := ethClient.CreateTxOpts(ctx, *kmsKey, kms.SignPurposeRHSPublish)
 	if err != nil {
 		log.Error(ctx, "failed to create tx opts", "err", err)
 		return nil, err
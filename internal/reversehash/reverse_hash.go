@@ -7,9 +7,12 @@ import (
 
 	"github.com/iden3/go-merkletree-sql/v2"
 	proof "github.com/iden3/merkletree-proof"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/tracing"
 )
 
 // DefaultRHSTimeOut - default timeout for reverse hash service requests.
@@ -93,7 +96,7 @@ func (rhsp *rhsPublisher) PushHashesToRHS(ctx context.Context, newState, prevSta
 
 	if nb.numberOfNodes() > 0 {
 		log.Info(ctx, "new state nodes", nb.nodes)
-		err = rhsp.rhsCli.SaveNodes(ctx, nb.nodes)
+		err = rhsp.saveNodes(ctx, nb.nodes)
 	}
 	return err
 }
@@ -107,7 +110,7 @@ func (rhsp *rhsPublisher) PublishNodesToRHS(ctx context.Context, nodes []proof.N
 	}
 	if len(nodes) > 0 {
 		log.Info(ctx, "new state nodes", "nodes", nodes)
-		err := rhsp.rhsCli.SaveNodes(ctx, nodes)
+		err := rhsp.saveNodes(ctx, nodes)
 		if err != nil {
 			if rhsp.ignoreRHSErrors {
 				log.Error(ctx, "failed to push nodes to RHS", "err", err)
@@ -119,6 +122,14 @@ func (rhsp *rhsPublisher) PublishNodesToRHS(ctx context.Context, nodes []proof.N
 	return nil
 }
 
+// saveNodes sends the nodes to the reverse hash service, in a span
+func (rhsp *rhsPublisher) saveNodes(ctx context.Context, nodes []proof.Node) error {
+	ctx, span := tracing.Start(ctx, "RHS.SaveNodes", trace.WithAttributes(attribute.Int("rhs.nodes", len(nodes))))
+	err := rhsp.rhsCli.SaveNodes(ctx, nodes)
+	tracing.End(span, err)
+	return err
+}
+
 func newStateHashesFromModel(inState *domain.IdentityState) (stateHashes, error) {
 	if *inState.State == merkletree.HashZero.Hex() {
 		return stateHashes{
@@ -0,0 +1,97 @@
+// Package tracing configures the OpenTelemetry tracer of the services and creates their spans.
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/polygonid/sh-id-platform"
+
+// Config installs the global tracer provider of the service and the W3C trace context propagator. Spans are
+// exported with OTLP over http to the endpoint url, sampling sampleRatio of the traces started by the service.
+// An empty endpoint disables tracing. The returned function flushes the pending spans and must be called before
+// the service exits.
+func Config(ctx context.Context, service, endpoint string, sampleRatio float64) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(attribute.String("service.name", service)))
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	// Libraries like the schema loaders and the reverse hash service client use the default client
+	http.DefaultClient.Transport = Transport(http.DefaultClient.Transport)
+	return provider.Shutdown, nil
+}
+
+// Start starts a span named name, child of the span of ctx if any, and returns it with a context that contains it
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, opts...)
+}
+
+// End records err in the span, when it is not nil, and ends the span
+func End(span trace.Span, err error, opts ...trace.SpanEndOption) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End(opts...)
+}
+
+// Middleware returns an http middleware that starts a span for each request, continuing the trace of the caller.
+// The spans are named after the chi route pattern, so it must be used in a chi router.
+func Middleware(service string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		routed := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r)
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				span := trace.SpanFromContext(r.Context())
+				span.SetName(spanName("", r))
+				span.SetAttributes(attribute.String("http.route", rctx.RoutePattern()))
+			}
+		})
+		return otelhttp.NewHandler(routed, service,
+			otelhttp.WithSpanNameFormatter(spanName),
+			otelhttp.WithFilter(func(r *http.Request) bool { return r.URL.Path != "/metrics" && r.URL.Path != "/status" }),
+		)
+	}
+}
+
+// spanName names the server spans with the method and the route pattern, once the request is routed
+func spanName(_ string, r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+		return r.Method + " " + rctx.RoutePattern()
+	}
+	return r.Method
+}
+
+// Transport returns an http transport that creates a span for each request and sends the trace context to the
+// server. A nil base uses http.DefaultTransport.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(base)
+}
@@ -0,0 +1,97 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// newExporter installs a tracer provider that keeps the spans in memory
+func newExporter(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+	_, err := Config(context.Background(), "test", "", 1)
+	require.NoError(t, err)
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	return exporter
+}
+
+func TestStartEnd(t *testing.T) {
+	exporter := newExporter(t)
+
+	ctx, parent := Start(context.Background(), "parent")
+	_, child := Start(ctx, "child")
+	End(child, errors.New("sign failed"))
+	End(parent, nil)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "child", spans[0].Name)
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	assert.Equal(t, "sign failed", spans[0].Status.Description)
+	assert.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+	assert.Equal(t, "parent", spans[1].Name)
+	assert.Equal(t, codes.Unset, spans[1].Status.Code)
+}
+
+func TestMiddleware(t *testing.T) {
+	exporter := newExporter(t)
+
+	mux := chi.NewRouter()
+	mux.Use(Middleware("test"))
+	mux.Get("/v2/identities/{identifier}/credentials", func(w http.ResponseWriter, r *http.Request) {
+		_, span := Start(r.Context(), "ClaimService.GetAll")
+		End(span, nil)
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.Get("/metrics", func(w http.ResponseWriter, r *http.Request) {})
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	req := httptest.NewRequest(http.MethodGet, "/v2/identities/did:iden3:issuer/credentials", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	mux.ServeHTTP(httptest.NewRecorder(), req)
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	service, server := spans[0], spans[1]
+	assert.Equal(t, "ClaimService.GetAll", service.Name)
+	assert.Equal(t, "GET /v2/identities/{identifier}/credentials", server.Name)
+	assert.Equal(t, traceID, server.SpanContext.TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", server.Parent.SpanID().String())
+	assert.Equal(t, server.SpanContext.SpanID(), service.Parent.SpanID())
+}
+
+func TestTransport(t *testing.T) {
+	exporter := newExporter(t)
+
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+	}))
+	defer server.Close()
+
+	ctx, span := Start(context.Background(), "RHS.SaveNodes")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, http.NoBody)
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: Transport(nil)}).Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	End(span, nil)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	client := spans[0]
+	assert.Equal(t, span.SpanContext().SpanID(), client.Parent.SpanID())
+	assert.Equal(t, "00-"+span.SpanContext().TraceID().String()+"-"+client.SpanContext.SpanID().String()+"-01", traceparent)
+}
@@ -69,6 +69,23 @@ func (l *Circuits) LoadWasm(circuitID circuits.CircuitID) ([]byte, error) {
 	return l.getPathToFile(circuitID, wasmFile)
 }
 
+// Check returns an error when any of the files of the circuits is missing or cannot be read.
+func (l *Circuits) Check(circuitIDs ...circuits.CircuitID) error {
+	for _, circuitID := range circuitIDs {
+		for _, fileName := range []string{wasmFile, proofingKeyFile, verificationKeyFile} {
+			path := filepath.Join(l.basePath, string(circuitID), fileName)
+			info, err := os.Stat(filepath.Clean(path))
+			if err != nil {
+				return fmt.Errorf("failed stat file '%s' by path '%s': %v", fileName, path, err)
+			}
+			if info.Size() == 0 {
+				return fmt.Errorf("empty file '%s' by path '%s'", fileName, path)
+			}
+		}
+	}
+	return nil
+}
+
 func (l *Circuits) getPathToFile(circuitID circuits.CircuitID, fileName string) ([]byte, error) {
 	path := filepath.Join(l.basePath, string(circuitID), fileName)
 	f, err := os.Open(filepath.Clean(path))